@@ -0,0 +1,123 @@
+// Команда rzd-codes импортирует таблицы соответствия кодов станций (Экспресс-3, ЕСР, UIC)
+// в формат, который загружает сервис (параметр RZD.STATION_CODES_FILE).
+//
+// Пример: таблица партнёра с разделителем ";" и своими названиями колонок
+//
+//	rzd-codes -out codes.csv -merge -comma ";" -express3 "Код ЭКСПРЕСС" -esr "Код ЕСР" -name "Наименование" partner.csv
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/stationcodes"
+)
+
+func main() {
+	var (
+		out    string
+		merge  bool
+		comma  string
+		format = stationcodes.DefaultFormat
+	)
+	flag.StringVar(&out, "out", "", "Файл результата; пустой - стандартный вывод")
+	flag.BoolVar(&merge, "merge", false, "Дополнить существующий файл результата, а не перезаписать его")
+	flag.StringVar(&comma, "comma", ",", `Разделитель полей входных таблиц ("tab" - табуляция)`)
+	flag.StringVar(&format.Express3, "express3", format.Express3, "Колонка кода Экспресс-3")
+	flag.StringVar(&format.ESR, "esr", format.ESR, "Колонка кода ЕСР (пустая - нет в таблице)")
+	flag.StringVar(&format.UIC, "uic", format.UIC, "Колонка кода UIC (пустая - нет в таблице)")
+	flag.StringVar(&format.Name, "name", format.Name, "Колонка названия станции (пустая - нет в таблице)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Использование: %s [флаги] таблица.csv...\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	separator, err := parseComma(comma)
+	if err != nil {
+		log.Fatalf("invalid -comma: %v", err)
+	}
+	format.Comma = separator
+
+	registry := stationcodes.NewRegistry()
+	if merge && out != "" {
+		if err := loadExisting(registry, out); err != nil {
+			log.Fatalf("failed to merge %s: %v", out, err)
+		}
+	}
+	for _, path := range flag.Args() {
+		records, err := readTable(path, format)
+		if err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+		registry.Add(records...)
+		log.Printf("Imported %d stations from %s", len(records), path)
+	}
+
+	if err := writeResult(out, registry); err != nil {
+		log.Fatalf("failed to write %s: %v", out, err)
+	}
+	log.Printf("Station codes table has %d stations", registry.Len())
+}
+
+// parseComma разделитель полей из флага
+func parseComma(comma string) (rune, error) {
+	if comma == "tab" || comma == `\t` {
+		return '\t', nil
+	}
+	separator, size := utf8.DecodeRuneInString(comma)
+	if separator == utf8.RuneError || size != len(comma) {
+		return 0, fmt.Errorf("separator must be a single character, got %q", comma)
+	}
+	return separator, nil
+}
+
+// loadExisting дополняет реестр существующим файлом результата; файла ещё может не быть
+func loadExisting(registry *stationcodes.Registry, out string) error {
+	if err := registry.LoadFile(out); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// readTable читает одну входную таблицу
+func readTable(path string, format stationcodes.Format) ([]domain.StationCodes, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return stationcodes.Read(file, format)
+}
+
+// writeResult записывает таблицу в файл через временный файл, чтобы сервис не прочитал её недописанной
+func writeResult(out string, registry *stationcodes.Registry) error {
+	if out == "" {
+		return stationcodes.Write(os.Stdout, registry.Records())
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(out), filepath.Base(out)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := stationcodes.Write(tmp, registry.Records()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), out)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/stationcodes"
+)
+
+func TestParseComma(t *testing.T) {
+	for comma, expected := range map[string]rune{",": ',', ";": ';', "tab": '\t', `\t`: '\t', "|": '|'} {
+		separator, err := parseComma(comma)
+		require.NoError(t, err, comma)
+		require.Equal(t, expected, separator, comma)
+	}
+	for _, comma := range []string{"", ";;", "\xff"} {
+		_, err := parseComma(comma)
+		require.Error(t, err, comma)
+	}
+}
+
+func TestMergeResult(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "codes.csv")
+	moscow := domain.StationCodes{Express3: 2006004, ESR: "060073", Name: "МОСКВА ОКТЯБРЬСКАЯ"}
+
+	// Файла результата ещё нет: -merge начинает с пустой таблицы
+	registry := stationcodes.NewRegistry()
+	require.NoError(t, loadExisting(registry, out))
+	require.Zero(t, registry.Len())
+	registry.Add(moscow)
+	require.NoError(t, writeResult(out, registry))
+
+	// Повторный импорт дополняет записанную таблицу
+	registry = stationcodes.NewRegistry()
+	require.NoError(t, loadExisting(registry, out))
+	registry.Add(domain.StationCodes{Express3: 2000003, ESR: "194013", Name: "МОСКВА КАЗАНСКАЯ"})
+	require.NoError(t, writeResult(out, registry))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, "express3,esr,uic,name\n2000003,194013,,МОСКВА КАЗАНСКАЯ\n2006004,060073,,МОСКВА ОКТЯБРЬСКАЯ\n", string(data))
+
+	// Испорченный файл не перезаписывается молча
+	require.NoError(t, os.WriteFile(out, []byte("name\nМОСКВА\n"), 0o644))
+	require.Error(t, loadExisting(stationcodes.NewRegistry(), out))
+}
@@ -3,24 +3,54 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/geodata"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/sink"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/stationcodes"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/service"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc"
 	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// overrideFlags повторяемый флаг -set SECTION.KEY=value
+type overrideFlags []string
+
+func (f *overrideFlags) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *overrideFlags) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 func main() {
 	var (
-		configPath string
+		configPath    string
+		overrides     overrideFlags
+		watchInterval time.Duration
 	)
 	// Флаг для опционального пути к файлу конфигурации
 	flag.StringVar(&configPath, "config", "", "Путь к YAML файлу конфигурации")
+	flag.Var(&overrides, "set", "Переопределение параметра SECTION.KEY=value поверх файла и окружения (можно повторять)")
+	flag.DurationVar(&watchInterval, "watch-interval", 10*time.Second, "Период проверки изменений файла конфигурации (0 - только по SIGHUP)")
 	flag.Parse()
 
 	// Обработка сигналов для graceful shutdown
@@ -33,32 +63,128 @@ func main() {
 		cancel()
 	}()
 
-	// Загрузка конфигурации (если configPath не пустой, значения берутся из YAML файла)
-	cfg, err := config.LoadConfig(configPath)
+	// Загрузка конфигурации: значения по умолчанию, YAML файл, окружение, флаги -set
+	loadOptions := config.Options{Path: configPath, Overrides: overrides}
+	cfg, err := config.Load(loadOptions)
 	if err != nil {
 		log.Fatalf("failed to load configuration: %v", err)
 	}
+	watcher := config.NewWatcher(loadOptions, cfg)
+
+	// Трассировка OpenTelemetry (экспорт по OTLP, если задан коллектор)
+	shutdownTracing, err := tracing.Setup(ctx, &cfg.Tracing)
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Tracing.Timeout)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			log.Printf("failed to flush traces: %v", err)
+		}
+	}()
+
+	// Хранилище состояния: общее для реплик (Redis) или в памяти процесса
+	store, err := state.New(&cfg.State)
+	if err != nil {
+		log.Fatalf("failed to create state store: %v", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.Printf("failed to close state store: %v", err)
+		}
+	}()
+	var sharedStore state.Store
+	if cfg.State.Addr != "" {
+		sharedStore = store
+		log.Println("Sharing RID, session, cache and rate limit state via Redis")
+	}
 
 	// Инициализация клиента RZD
-	client, err := rzd.NewRzdClient(&cfg.RZD)
+	client, err := rzd.NewRzdClient(&cfg.RZD, store)
 	if err != nil {
 		log.Fatalf("failed to create RZD client: %v", err)
 	}
 
-	// Создаем сервисный слой и эндпоинты для gRPC
-	svc := service.New(client)
+	// Создаем сервисный слой поверх выбранного источника данных и эндпоинты для gRPC
+	provider, jsonClient, err := newProvider(&cfg.RZD, client)
+	if err != nil {
+		log.Fatalf("failed to create data provider: %v", err)
+	}
+	log.Printf("Using data provider %s", provider.Name())
+	watcher.OnReload(func(cfg *config.Config) {
+		if err := client.ApplyConfig(&cfg.RZD); err != nil {
+			log.Printf("failed to apply RZD configuration: %v", err)
+		}
+		if jsonClient != nil {
+			if err := jsonClient.ApplyConfig(&cfg.RZD); err != nil {
+				log.Printf("failed to apply ticket.rzd.ru configuration: %v", err)
+			}
+		}
+	})
+	svc := service.New(provider)
+
+	// Географические данные станций: координаты в ответах и поиск станций рядом с точкой
+	geo := geodata.NewRegistry()
+	if cfg.RZD.GeoDataFile != "" {
+		if err := geo.LoadFile(cfg.RZD.GeoDataFile); err != nil {
+			log.Fatalf("failed to load geodata: %v", err)
+		}
+		log.Printf("Loaded geodata for %d stations", geo.Len())
+	}
+	svc = service.NewGeoService(svc, geo)
+
+	// Соответствие кодов станций: коды ЕСР и UIC в запросах
+	codes := stationcodes.NewRegistry()
+	if cfg.RZD.StationCodesFile != "" {
+		if err := codes.LoadFile(cfg.RZD.StationCodesFile); err != nil {
+			log.Fatalf("failed to load station codes: %v", err)
+		}
+		log.Printf("Loaded station codes for %d stations", codes.Len())
+	}
+	svc = service.NewCodesService(svc, codes)
+
+	// Экспорт результатов во внешние системы (если настроен)
+	exportSink, err := sink.NewFromConfig(&cfg.Sinks)
+	if err != nil {
+		log.Fatalf("failed to create export sinks: %v", err)
+	}
+	if exportSink != nil {
+		defer func() {
+			if err := exportSink.Close(); err != nil {
+				log.Printf("failed to close export sinks: %v", err)
+			}
+		}()
+		svc = service.NewPublishingService(svc, exportSink)
+		go service.RunScrapeJobs(ctx, svc, scrapeJobsFromConfig(cfg.Sinks.Jobs))
+	}
+	svc = service.NewTracingService(svc)
 	eps := grpc.MakeEndpoints(svc)
 	grpcServer := grpc.NewGRPCServer(eps)
 
-	// Запуск gRPC сервера (ожидается, что функция StartGRPCServer возвращает сервер и listener)
-	server, listener, err := grpc.StartGRPCServer(":"+cfg.GRPC.Port, grpcServer)
+	// Запуск gRPC сервера
+	server, err := grpc.StartGRPCServer(&cfg.GRPC, grpcServer, sharedStore)
 	if err != nil {
 		log.Fatalf("failed to start gRPC server: %v", err)
 	}
+	watcher.OnReload(func(cfg *config.Config) {
+		server.ApplyConfig(&cfg.GRPC)
+	})
+
+	// Статус grpc.health.v1 отражает доступность РЖД
+	go grpc.RunHealthMonitor(ctx, server.Health, client, cfg.GRPC.Health.Interval, cfg.GRPC.Health.Timeout)
+
+	// Метрики Prometheus (состояние предохранителей РЖД и др.)
+	if cfg.Metrics.Addr != "" {
+		go serveMetrics(ctx, cfg.Metrics.Addr)
+	}
+
+	// Перезагрузка конфигурации по SIGHUP и при изменении файла
+	go watcher.Run(ctx, watchInterval)
 
 	// Запуск сервера в отдельной горутине
 	go func() {
-		if err := server.Serve(listener); err != nil {
+		if err := server.Server.Serve(server.Listener); err != nil {
 			log.Fatalf("failed to serve gRPC server: %v", err)
 		}
 	}()
@@ -67,6 +193,59 @@ func main() {
 	// Ожидание отмены контекста (сигнала завершения)
 	<-ctx.Done()
 	log.Println("Shutting down gRPC server...")
-	server.GracefulStop()
+	server.Server.GracefulStop()
 	log.Println("Server stopped gracefully.")
 }
+
+// serveMetrics отдаёт метрики Prometheus по HTTP на /metrics до отмены контекста
+func serveMetrics(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	log.Printf("Metrics server listening on %s", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("metrics server failed: %v", err)
+	}
+}
+
+// newProvider выбирает источник данных по конфигурации. Клиент ticket.rzd.ru возвращается
+// отдельно (nil, если не используется), чтобы применять к нему перезагруженную конфигурацию.
+func newProvider(cfg *config.RZD, legacy *rzd.Client) (service.Provider, *ticketrzd.Client, error) {
+	switch cfg.Provider {
+	case "", config.ProviderLegacy:
+		return legacy, nil, nil
+	case config.ProviderJSON:
+		jsonClient, err := ticketrzd.NewClient(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return jsonClient, jsonClient, nil
+	case config.ProviderFallback:
+		jsonClient, err := ticketrzd.NewClient(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return service.NewFallbackProvider(legacy, jsonClient), jsonClient, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown provider %q", cfg.Provider)
+	}
+}
+
+// scrapeJobsFromConfig преобразует задания из конфигурации в задания сервиса
+func scrapeJobsFromConfig(jobs []config.SinkJob) []service.ScrapeJob {
+	result := make([]service.ScrapeJob, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, service.ScrapeJob{
+			FromCode:  job.FromCode,
+			ToCode:    job.ToCode,
+			TrainType: domain.TrainSearchType(job.TrainType),
+			Days:      job.Days,
+			Interval:  job.Interval,
+		})
+	}
+	return result
+}
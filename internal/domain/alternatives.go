@@ -0,0 +1,49 @@
+// internal/domain/alternatives.go
+package domain
+
+import "fmt"
+
+// AlternativeReason почему предложен альтернативный поезд
+type AlternativeReason int32
+
+const (
+	AlternativeAdjacentDate AlternativeReason = iota + 1 // Соседняя дата отправления
+	AlternativeCityStation                               // Другая станция того же города
+	AlternativeWithChange                                // Маршрут с пересадкой
+)
+
+// String название причины для логов
+func (r AlternativeReason) String() string {
+	switch r {
+	case AlternativeAdjacentDate:
+		return "adjacent date"
+	case AlternativeCityStation:
+		return "city station"
+	case AlternativeWithChange:
+		return "with change"
+	default:
+		return fmt.Sprintf("AlternativeReason(%d)", int32(r))
+	}
+}
+
+// AlternativesParams параметры поиска маршрутов с альтернативами
+type AlternativesParams struct {
+	Routes     GetTrainRoutesParams // Исходный запрос маршрутов; места проверяются всегда
+	SeatTypes  []CarSeatType        // Нужные типы мест; пустой - любые
+	DateRange  int                  // На сколько дней раньше и позже искать; 0 - по умолчанию
+	MaxResults int                  // Сколько альтернатив вернуть; 0 - по умолчанию
+}
+
+// RouteAlternative альтернативный поезд и причина, по которой он предложен
+type RouteAlternative struct {
+	Route     TrainRoute
+	Reason    AlternativeReason
+	DaysShift int // Сдвиг даты отправления относительно запрошенной, дни (отрицательный - раньше)
+}
+
+// RoutesWithAlternatives маршруты на запрошенную дату с местами нужных типов,
+// а если таких нет - альтернативы от лучшей к худшей
+type RoutesWithAlternatives struct {
+	Routes       []TrainRoute
+	Alternatives []RouteAlternative
+}
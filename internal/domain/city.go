@@ -0,0 +1,23 @@
+// internal/domain/city.go
+package domain
+
+// CityLevel уровень станции, начиная с которого она обозначает город и объединяет его вокзалы
+// (например, МОСКВА 2000000 объединяет Казанский, Ленинградский и другие вокзалы)
+const CityLevel = 5
+
+// IsCity сообщает, что код обозначает город, а не отдельную станцию
+func (s Station) IsCity() bool {
+	return s.Level >= CityLevel
+}
+
+// City город и известные станции, которые он объединяет
+type City struct {
+	Station  Station   // Город
+	Stations []Station // Станции города
+}
+
+// CityStationsParams параметры запроса станций города
+type CityStationsParams struct {
+	Query    string // Название города, например "Москва"
+	Language string // Язык ответа; пустой - язык по умолчанию
+}
@@ -0,0 +1,97 @@
+// internal/domain/codes.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StationCodeType система кодирования станций
+type StationCodeType int32
+
+const (
+	CodeExpress3 StationCodeType = iota // Код Экспресс-3, его используют запросы к РЖД
+	CodeESR                             // Код ЕСР (Единая сетевая разметка), шесть цифр с контрольной
+	CodeUIC                             // Код UIC: код страны и номер станции
+)
+
+// String название системы кодирования для сообщений об ошибках
+func (t StationCodeType) String() string {
+	switch t {
+	case CodeExpress3:
+		return "express3"
+	case CodeESR:
+		return "esr"
+	case CodeUIC:
+		return "uic"
+	default:
+		return fmt.Sprintf("StationCodeType(%d)", int32(t))
+	}
+}
+
+// StationCodes коды одной станции в разных системах
+type StationCodes struct {
+	Express3 int    // Код Экспресс-3
+	ESR      string // Код ЕСР; пустой - неизвестен
+	UIC      string // Код UIC; пустой - неизвестен
+	Name     string // Название станции из таблицы соответствия
+}
+
+// StationCodeParams параметры поиска кодов станции
+type StationCodeParams struct {
+	Type StationCodeType // Система, в которой задан код
+	Code string          // Код станции
+}
+
+// NormalizeStationCode приводит код к виду, в котором он хранится в таблице соответствия:
+// без пробелов и ведущих нулей, код ЕСР - ровно шесть цифр (пятизначный дополняется контрольной цифрой).
+// Код ЕСР с неверной контрольной цифрой отклоняется.
+func NormalizeStationCode(codeType StationCodeType, code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return "", fmt.Errorf("empty %s code", codeType)
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("%s code %q must contain only digits", codeType, code)
+		}
+	}
+	if codeType != CodeESR {
+		return strings.TrimLeft(code, "0"), nil
+	}
+	switch len(code) {
+	case 5:
+		return code + string(rune('0'+esrCheckDigit(code))), nil
+	case 6:
+		if check := esrCheckDigit(code); int(code[5]-'0') != check {
+			return "", fmt.Errorf("esr code %q has invalid check digit, expected %d", code, check)
+		}
+		return code, nil
+	default:
+		return "", fmt.Errorf("esr code %q must have 5 or 6 digits", code)
+	}
+}
+
+// StationCodeFromNumber код из числового поля запроса: ведущие нули кода ЕСР при этом теряются
+// (060073 приходит как 60073), поэтому код ЕСР всегда считается шестизначным
+func StationCodeFromNumber(codeType StationCodeType, code int) string {
+	if codeType == CodeESR {
+		return fmt.Sprintf("%06d", code)
+	}
+	return fmt.Sprint(code)
+}
+
+// esrCheckDigit контрольная цифра кода ЕСР: сумма цифр с весами 1..5 по модулю 11,
+// при остатке 10 - с весами 3..7, при повторном остатке 10 контрольная цифра 0
+func esrCheckDigit(digits string) int {
+	for _, first := range []int{1, 3} {
+		sum := 0
+		for i := 0; i < 5; i++ {
+			sum += int(digits[i]-'0') * (first + i)
+		}
+		if rest := sum % 11; rest != 10 {
+			return rest
+		}
+	}
+	return 0
+}
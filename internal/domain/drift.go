@@ -0,0 +1,30 @@
+// internal/domain/drift.go
+package domain
+
+import "time"
+
+// SchemaDriftParams параметры запроса последних расхождений ответов upstream с контрактом
+type SchemaDriftParams struct {
+	Endpoint string // Эндпоинт upstream (routes, carriages, suggester); пустой - все
+	Limit    int    // Максимальное количество событий; 0 - все сохранённые
+}
+
+// SchemaDriftEvent расхождение ответов эндпоинта upstream с контрактом схем.
+// Одинаковые расхождения объединяются в одно событие со счётчиком.
+type SchemaDriftEvent struct {
+	Provider      string              // Провайдер данных, например "rzd"
+	Endpoint      string              // Эндпоинт upstream
+	FirstSeen     time.Time           // Первый ответ с такими расхождениями
+	LastSeen      time.Time           // Последний ответ с такими расхождениями
+	Count         int                 // Количество ответов с такими расхождениями
+	UnknownFields []string            // Поля ответа, которых нет в схеме
+	MissingFields []string            // Обязательные поля, которых нет в ответе
+	ChangedFields []SchemaFieldChange // Поля, значение которых не соответствует типу в схеме
+	Sample        string              // Начало последнего ответа с расхождениями
+}
+
+// SchemaFieldChange поле ответа, тип значения которого изменился
+type SchemaFieldChange struct {
+	Path   string // Путь поля, например "tp[].list[].cars[].tariff"
+	Reason string // Описание несоответствия
+}
@@ -30,12 +30,17 @@ const (
 	Lux                            // Люкс
 )
 
-// TrainType представляет тип поезда (поезд или электричка)
+// TrainType представляет категорию поезда.
+// Значения Train и Suburban сохраняют прежние коды, новые категории добавлены после них.
 type TrainType int32
 
 const (
-	Train    TrainType = iota // Поезд
-	Suburban                  // Электричка TODO удостовериться
+	Train           TrainType = iota // Поезд дальнего следования
+	Suburban                         // Пригородный поезд (электричка)
+	HighSpeed                        // Скоростной или высокоскоростной поезд (например, "Сапсан")
+	ExpressSuburban                  // Пригородный экспресс (скорый пригородный поезд)
+	Bus                              // Автобус в составе мультимодальной перевозки
+	Ferry                            // Паром в составе мультимодальной перевозки
 )
 
 type CarNumeration int32
@@ -0,0 +1,42 @@
+// internal/domain/errors.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUpstreamUnavailable источник данных временно недоступен, запрос не выполнялся
+// (например, разомкнут предохранитель). Клиенту стоит повторить запрос позже.
+var ErrUpstreamUnavailable = errors.New("upstream is temporarily unavailable")
+
+// ErrTrainNotFound поезд с указанным номером не найден на указанную дату
+var ErrTrainNotFound = errors.New("train not found")
+
+// ErrStationNotOnRoute станция не найдена среди остановок поезда
+var ErrStationNotOnRoute = errors.New("station is not on the train route")
+
+// ErrNotSupported источник данных не поддерживает запрошенную операцию
+var ErrNotSupported = errors.New("operation is not supported by the data provider")
+
+// ErrStationNotFound по названию или коду станции ничего не найдено
+var ErrStationNotFound = errors.New("station not found")
+
+// ErrInvalidStationCode код станции не соответствует формату своей системы (например, неверная контрольная цифра ЕСР)
+var ErrInvalidStationCode = errors.New("invalid station code")
+
+// AmbiguousStationError название станции подходит к нескольким станциям одинаково хорошо
+type AmbiguousStationError struct {
+	Field      string    // Что искали: "from", "to" (станции запроса) или "city"
+	Query      string    // Название из запроса
+	Candidates []Station // Подходящие станции, от лучшей к худшей
+}
+
+func (e *AmbiguousStationError) Error() string {
+	names := make([]string, 0, len(e.Candidates))
+	for _, candidate := range e.Candidates {
+		names = append(names, fmt.Sprintf("%s (%d)", candidate.Name, candidate.Code))
+	}
+	return fmt.Sprintf("station %q is ambiguous, candidates: %s", e.Query, strings.Join(names, ", "))
+}
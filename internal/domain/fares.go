@@ -0,0 +1,38 @@
+// internal/domain/fares.go
+package domain
+
+import "time"
+
+// FareSummaryParams представляет параметры для сводки тарифов по направлению на дату
+type FareSummaryParams struct {
+	FromCode       int             // Код станции отправления
+	ToCode         int             // Код станции прибытия
+	Date           time.Time       // Дата отправления (календарная, как GetTrainRoutesParams.FromDate)
+	TrainType      TrainSearchType // Тип поезда
+	TrainTypes     []TrainType     // Фильтр по категориям поездов; пустой - без фильтра
+	WithSeatPrices bool            // Уточнять минимальные цены нижних и верхних мест по списку вагонов
+	Language       string          // Язык ответа; пустой - язык по умолчанию
+	CodeType       StationCodeType // Система кодов FromCode и ToCode
+}
+
+// FareSummary сводка тарифов по одному типу мест среди всех поездов направления
+type FareSummary struct {
+	SeatType  CarSeatType // Тип мест
+	MinTariff int         // Минимальный тариф
+	MaxTariff int         // Максимальный тариф
+	MinTrains []FareTrain // Поезда с минимальным тарифом
+	MaxTrains []FareTrain // Поезда с максимальным тарифом
+	FreeSeats int         // Свободных мест во всех поездах
+	Trains    int         // Количество поездов с местами этого типа
+
+	// Минимальные тарифы нижних и верхних мест (0 - неизвестно).
+	// Заполняются только при FareSummaryParams.WithSeatPrices.
+	LowerSeatMinTariff int
+	UpperSeatMinTariff int
+}
+
+// FareTrain поезд, предлагающий тариф из сводки
+type FareTrain struct {
+	TrainNumber string    // Номер поезда
+	Departure   time.Time // Время отправления в часовом поясе станции отправления
+}
@@ -0,0 +1,40 @@
+// internal/domain/geo.go
+package domain
+
+import "math"
+
+// earthRadiusKm средний радиус Земли
+const earthRadiusKm = 6371.0
+
+// GeoPoint географические координаты в градусах (WGS 84)
+type GeoPoint struct {
+	Lat float64 // Широта
+	Lon float64 // Долгота
+}
+
+// IsZero сообщает, что координаты не заданы
+func (p GeoPoint) IsZero() bool {
+	return p.Lat == 0 && p.Lon == 0
+}
+
+// DistanceKm расстояние по поверхности Земли до точки q в километрах (формула гаверсинусов)
+func (p GeoPoint) DistanceKm(q GeoPoint) float64 {
+	lat1, lat2 := p.Lat*math.Pi/180, q.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (q.Lon - p.Lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// NearbyStationsParams параметры поиска станций рядом с точкой
+type NearbyStationsParams struct {
+	Point    GeoPoint // Центр поиска
+	RadiusKm float64  // Радиус поиска, км
+	Limit    int      // Максимальное количество станций; 0 - по умолчанию
+}
+
+// NearbyStation станция рядом с точкой
+type NearbyStation struct {
+	Station    Station // Станция с координатами
+	DistanceKm float64 // Расстояние от центра поиска, км
+}
@@ -8,16 +8,28 @@ import (
 // TrainRoute представляет маршрут поезда, полученный при запросе рейсов.
 // Содержит общую информацию о поезде и список типов вагонов (агрегированные данные).
 type TrainRoute struct {
-	TrainNumber string        // Номер поезда
-	TrainType   TrainType     // Тип поезда (например, поезд или электричка)
-	Duration    time.Duration // Время в пути
-	Brand       string        // Бренд поезда
-	Carrier     Carrier       // Перевозчик
+	TrainNumber     string        // Номер поезда
+	TrainNumber2    string        // Второй (отображаемый) номер поезда, например "159*А"
+	TrainName       string        // Собственное название поезда, например "Аврора"
+	TrainType       TrainType     // Тип поезда (например, поезд или электричка)
+	Duration        time.Duration // Время в пути
+	Brand           string        // Бренд поезда, например "САПСАН"
+	Carrier         Carrier       // Перевозчик
+	Firm            bool          // Фирменный поезд
+	ElReg           bool          // Доступна электронная регистрация
+	VarPrice        bool          // Динамическое ценообразование
+	DeferredPayment bool          // Доступна отложенная оплата
+	CarNumeration   CarNumeration // Нумерация вагонов (с головы или с хвоста)
+	SaleDepth       int           // Глубина продажи билетов в сутках
 
 	From      Station   // Станция отправления
 	To        Station   // Станция прибытия
-	Departure time.Time // Время отправления (дата и время)
-	Arrival   time.Time // Время прибытия (дата и время)
+	FromCity  Station   // Город из запроса, к которому относится станция отправления; пустой, если запрошена станция
+	ToCity    Station   // Город из запроса, к которому относится станция прибытия; пустой, если запрошена станция
+	Departure time.Time // Время отправления в часовом поясе станции отправления
+	Arrival   time.Time // Время прибытия в часовом поясе станции прибытия
+
+	OriginDeparture time.Time // Время отправления поезда с начальной станции маршрута
 
 	CarTypes []CarriageType // Список типов вагонов поезда (агрегированные данные)
 
@@ -26,15 +38,21 @@ type TrainRoute struct {
 
 // Station представляет железнодорожную станцию.
 type Station struct {
-	Name      string // Полное название станции, например "САНКТ-ПЕТЕРБУРГ-ГЛАВН. (МОСКОВСКИЙ ВОКЗАЛ)"
-	RouteName string // Краткое название станции в маршруте, например "С-ПЕТЕР-ГЛ"
-	Code      int    // Код станции (например, 2004000, 2000000)
-	Level     int    // Уровень станции (0-5, 5 - самый высокий)
-	Score     int    // Значение сортировки (0-5, 5 - самое высокое)
+	Name      string   // Полное название станции, например "САНКТ-ПЕТЕРБУРГ-ГЛАВН. (МОСКОВСКИЙ ВОКЗАЛ)"
+	RouteName string   // Краткое название станции в маршруте, например "С-ПЕТЕР-ГЛ"
+	Code      int      // Код станции (например, 2004000, 2000000)
+	Level     int      // Уровень станции (0-5, 5 - самый высокий)
+	Score     int      // Значение сортировки (0-5, 5 - самое высокое)
+	TimeZone  string   // Часовой пояс станции (IANA), например "Asia/Yekaterinburg"
+	ESRCode   string   // Код ЕСР станции; пустой, если неизвестен
+	Region    string   // Регион (субъект), в котором находится станция
+	Location  GeoPoint // Координаты станции; нулевые, если неизвестны
 }
 
 // CarriageType представляет агрегированные данные о типах вагонов, полученные из запроса маршрутов.
 // Используется для отображения общего состава поезда (без привязки к конкретным вагонам).
+// Для сидячих вагонов, кроме сводки по типу, бывают ценовые ступени (DataType = CarDataSeatTier):
+// они детализируют сводку, их свободные места уже учтены в ней.
 type CarriageType struct {
 	Type           CarSeatType // Тип посадочных мест в вагоне (например, плацкарт, купе, люкс)
 	TypeShortLabel string      // Краткое наименование типа вагона
@@ -44,8 +62,20 @@ type CarriageType struct {
 	TariffExtra    int         // Дополнительный тариф (если имеется)
 	FreeSeats      int         // Общее количество свободных мест в вагонах этого типа
 	Disabled       bool        // Флаг: есть ли специальные места для инвалидов
+	DataType       CarDataType // Сводка по типу вагона или ценовая ступень
+	BonusPoints    int         // Баллы «РЖД Бонус» за билет; 0 - неизвестно
+	LastSeats      bool        // Осталось мало мест
 }
 
+// CarDataType вид записи о типе вагона в ответе маршрутов
+type CarDataType int32
+
+const (
+	CarDataUnknown  CarDataType = iota // Не указан источником
+	CarDataSummary                     // Сводка по вагонам типа
+	CarDataSeatTier                    // Ценовая ступень мест сидячих вагонов, детализирует сводку
+)
+
 // Car представляет один конкретный вагон поезда с детальной информацией.
 type Car struct {
 	CarNumber          string        // Номер вагона
@@ -55,6 +85,7 @@ type Car struct {
 	CategoryCode       string        // Код категории вагона
 	CarTypeID          int           // Идентификатор категории вагона
 	CarType            int           // Тип вагона, обычно также код
+	SeatType           CarSeatType   // Тип мест вагона, одинаковый у всех источников
 	Letter             string        // Буква вагона
 	ClassType          string        // Тип класса вагона (например, "2Ш")
 	Services           []Service     // Список услуг, предоставляемых в вагоне
@@ -62,9 +93,25 @@ type Car struct {
 	Tariff2            int           // Дополнительный тариф (если имеется)
 	Carrier            Carrier       // Перевозчик
 	CarNumeration      CarNumeration // Нумерация вагона // TODO почему это в вагоне а не в поезде?
+	Seats              []SeatGroup   // Группы свободных мест (нижние, верхние и т.д.), если источник их сообщает
+}
 
+// SeatGroup представляет группу свободных мест одного вида в вагоне
+type SeatGroup struct {
+	Type    string // Вид места: "dn" (нижнее), "up" (верхнее) и др.
+	Label   string // Наименование, например "Нижнее"
+	Tariff  int    // Стоимость места
+	Tariff2 int    // Дополнительный тариф (если имеется)
+	Free    int    // Количество свободных мест
+	Places  string // Номера мест, например "002,010,030-032"
 }
 
+// Виды мест SeatGroup.Type
+const (
+	SeatLower = "dn" // Нижнее
+	SeatUpper = "up" // Верхнее
+)
+
 type Service struct {
 	ID          string // Идентификатор услуги
 	Name        string // Название услуги (с иконкой)
@@ -76,28 +123,42 @@ type Carrier struct {
 	Name string // Название перевозчика
 }
 
-// GetTrainRoutesParams представляет параметры для запроса маршрутов
+// GetTrainRoutesParams представляет параметры для запроса маршрутов.
+// Вместо кодов станций можно передать их названия: они определяются поиском станций.
 type GetTrainRoutesParams struct {
-	FromCode   int             // Код станции отправления
-	ToCode     int             // Код станции прибытия
-	Direction  Direction       // Направление
-	TrainType  TrainSearchType // Тип поезда
-	CheckSeats bool            // Проверка наличия мест
-	FromDate   time.Time       // Дата отправления
-	WithChange bool            // С пересадками
+	FromCode    int             // Код станции отправления
+	ToCode      int             // Код станции прибытия
+	Direction   Direction       // Направление
+	TrainType   TrainSearchType // Тип поезда
+	CheckSeats  bool            // Проверка наличия мест
+	FromDate    time.Time       // Дата отправления: берутся год, месяц и день, часовой пояс не учитывается
+	WithChange  bool            // С пересадками
+	TrainTypes  []TrainType     // Фильтр по категориям поездов; пустой - без фильтра
+	Language    string          // Язык ответа; пустой - язык по умолчанию
+	FromStation string          // Название станции отправления, если FromCode не задан
+	ToStation   string          // Название станции прибытия, если ToCode не задан
+	CodeType    StationCodeType // Система кодов FromCode и ToCode
 }
 
-// GetTrainCarriagesParams представляет параметры для запроса вагонов
+// GetTrainCarriagesParams представляет параметры для запроса вагонов.
+// Вместо кодов станций можно передать их названия: тогда сегмент ищется среди остановок поезда,
+// а FromTime задаёт дату отправления поезда с начальной станции маршрута. Если источник не умеет
+// искать поезд по номеру, названия определяются поиском станций, а FromTime остаётся временем отправления.
 type GetTrainCarriagesParams struct {
-	TrainNumber string    // Номер поезда
-	Direction   Direction // Направление
-	FromCode    int       // Код станции отправления
-	FromTime    time.Time // Время отправления
-	ToCode      int       // Код станции прибытия
+	TrainNumber string          // Номер поезда
+	Direction   Direction       // Направление
+	FromCode    int             // Код станции отправления
+	FromTime    time.Time       // Время отправления
+	ToCode      int             // Код станции прибытия
+	Language    string          // Язык ответа; пустой - язык по умолчанию
+	FromStation string          // Название станции отправления, если FromCode не задан
+	ToStation   string          // Название станции прибытия, если ToCode не задан
+	CodeType    StationCodeType // Система кодов FromCode и ToCode
 }
 
 // SearchStationParams представляет параметры для поиска станций по части названия.
 type SearchStationParams struct {
 	Query       string // Поисковый запрос, например "ЧЕБ"
 	CompactMode bool   // Флаг компактного режима
+	Language    string // Язык ответа; пустой - язык по умолчанию
 }
@@ -0,0 +1,25 @@
+// internal/domain/route_carriages.go
+package domain
+
+import "time"
+
+// RoutesWithCarriagesParams параметры получения маршрутов вместе с вагонами каждого поезда
+type RoutesWithCarriagesParams struct {
+	Routes       GetTrainRoutesParams // Запрос маршрутов
+	TrainNumbers []string             // Поезда, для которых нужны вагоны; пустой - все
+	Concurrency  int                  // Сколько запросов вагонов выполнять одновременно; 0 - по умолчанию
+}
+
+// CarriagesFailure ошибка получения вагонов одного поезда
+type CarriagesFailure struct {
+	TrainNumber string
+	Departure   time.Time
+	Err         error
+}
+
+// RoutesWithCarriages маршруты с заполненными вагонами (TrainRoute.Cars)
+// и поезда, вагоны которых получить не удалось
+type RoutesWithCarriages struct {
+	Routes   []TrainRoute
+	Failures []CarriagesFailure
+}
@@ -0,0 +1,26 @@
+// internal/domain/schedule.go
+package domain
+
+import "time"
+
+// FindTrainParams параметры поиска поезда по номеру
+type FindTrainParams struct {
+	TrainNumber string    // Номер поезда, например "119А"
+	Date        time.Time // Дата отправления поезда с начальной станции маршрута
+	Language    string    // Язык ответа; пустой - язык по умолчанию
+}
+
+// TrainSchedule поезд, найденный по номеру: маршрут от начальной до конечной станции и остановки
+type TrainSchedule struct {
+	Route TrainRoute  // From/To - начальная и конечная станции, Departure/Arrival - время на них
+	Stops []TrainStop // Остановки по порядку следования, включая начальную и конечную
+}
+
+// TrainStop остановка поезда на маршруте
+type TrainStop struct {
+	Station   Station       // Станция
+	Arrival   time.Time     // Прибытие в часовом поясе станции; нулевое для начальной станции
+	Departure time.Time     // Отправление в часовом поясе станции; нулевое для конечной станции
+	Stay      time.Duration // Стоянка
+	Distance  int           // Расстояние от начальной станции, км
+}
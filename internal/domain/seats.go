@@ -0,0 +1,149 @@
+// internal/domain/seats.go
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Виды боковых мест SeatGroup.Type в плацкартных вагонах
+const (
+	SeatSideLower = "ldn" // Нижнее боковое
+	SeatSideUpper = "lup" // Верхнее боковое
+)
+
+// RecommendSeatsParams представляет параметры подбора мест для группы пассажиров
+type RecommendSeatsParams struct {
+	FromCode    int             // Код станции отправления
+	ToCode      int             // Код станции прибытия
+	Date        time.Time       // Дата отправления (календарная, как GetTrainRoutesParams.FromDate)
+	TrainNumber string          // Номер поезда; пустой - все поезда направления
+	SeatTypes   []CarSeatType   // Допустимые типы вагонов; пустой - любые
+	PartySize   int             // Количество пассажиров
+	Preferences SeatPreferences // Пожелания к местам
+	MaxResults  int             // Сколько вариантов вернуть для каждого поезда
+	Language    string          // Язык ответа; пустой - язык по умолчанию
+	CodeType    StationCodeType // Система кодов FromCode и ToCode
+}
+
+// SeatPreferences пожелания к местам группы
+type SeatPreferences struct {
+	LowerOnly       bool // Только нижние места
+	SameCompartment bool // Все места в одном купе
+	AvoidToilet     bool // Не у туалета (крайние купе вагона)
+	AvoidSide       bool // Без боковых мест
+	SameCar         bool // Все места в одном вагоне
+}
+
+// SeatPlace представляет конкретное свободное место в вагоне
+type SeatPlace struct {
+	CarNumber   string      // Номер вагона
+	CarType     CarSeatType // Тип вагона
+	Number      int         // Номер места
+	Label       string      // Обозначение места от РЖД, например "014С"
+	Compartment int         // Номер купе (блока мест) в вагоне
+	Lower       bool        // Нижнее место
+	Side        bool        // Боковое место
+	NearToilet  bool        // Место в крайнем купе, рядом с туалетом
+	Tariff      int         // Стоимость места
+}
+
+// SeatCombination вариант размещения группы
+type SeatCombination struct {
+	Seats        []SeatPlace // Места группы
+	TotalTariff  int         // Суммарная стоимость
+	Compartments int         // Сколько купе занимает группа
+	Cars         int         // В скольких вагонах находятся места
+}
+
+// TrainSeatRecommendations варианты размещения группы в одном поезде, от лучшего к худшему
+type TrainSeatRecommendations struct {
+	TrainNumber  string            // Номер поезда
+	Departure    time.Time         // Время отправления в часовом поясе станции отправления
+	Combinations []SeatCombination // Варианты размещения
+}
+
+// Число мест в купе и купе в вагоне типовых схем
+const (
+	berthsPerCompartment = 4 // Плацкарт, купе; блок соседних мест в сидячих и общих вагонах
+	luxPerCompartment    = 2 // СВ и мягкие вагоны
+	compartmentsPerCar   = 9
+	mainBerths           = compartmentsPerCar * berthsPerCompartment // Места 1-36, дальше боковые
+)
+
+// LayoutSeat определяет положение места по типовой схеме вагона РЖД.
+// Плацкарт и купе: купе по 4 места (1-4, 5-8, ...), нечётные - нижние; в плацкарте места 37-54 -
+// боковые, 37-38 напротив девятого купе, 53-54 - первого. СВ и мягкие: купе по 2 места.
+// Сидячие и общие вагоны делятся на блоки по 4 соседних места. Рядом с туалетом
+// считаются первое и девятое купе. groupType (SeatGroup.Type) уточняет положение, когда
+// схема его не определяет.
+func LayoutSeat(carType CarSeatType, number int, groupType string) SeatPlace {
+	place := SeatPlace{CarType: carType, Number: number}
+	switch carType {
+	case Platz, Coupe:
+		if carType == Platz && number > mainBerths {
+			place.Side = true
+			place.Compartment = compartmentsPerCar - (number-mainBerths-1)/2
+		} else {
+			place.Compartment = (number-1)/berthsPerCompartment + 1
+		}
+		place.Lower = number%2 == 1
+		place.NearToilet = place.Compartment == 1 || place.Compartment == compartmentsPerCar
+	case Lux, Soft:
+		place.Compartment = (number-1)/luxPerCompartment + 1
+		place.Lower = groupType != SeatUpper
+		place.NearToilet = place.Compartment == 1 || place.Compartment == compartmentsPerCar
+	default:
+		place.Compartment = (number-1)/berthsPerCompartment + 1
+		place.Side = groupType == SeatSideLower || groupType == SeatSideUpper
+		place.Lower = groupType != SeatUpper && groupType != SeatSideUpper
+	}
+	return place
+}
+
+// ParsePlaces разбирает список мест РЖД, например "002,010,030-032,014С", в номера
+// и исходные обозначения. Буквенный суффикс (пол купе: М, Ж, С, Ц) сохраняется в обозначении.
+func ParsePlaces(places string) ([]SeatPlace, error) {
+	var result []SeatPlace
+	for _, item := range strings.Split(places, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(item, "-")
+		from, suffix, err := parsePlaceNumber(first)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			if to, suffix, err = parsePlaceNumber(last); err != nil {
+				return nil, err
+			}
+		}
+		if to < from {
+			return nil, fmt.Errorf("invalid place range %q", item)
+		}
+		for number := from; number <= to; number++ {
+			result = append(result, SeatPlace{Number: number, Label: fmt.Sprintf("%03d%s", number, suffix)})
+		}
+	}
+	return result, nil
+}
+
+// parsePlaceNumber отделяет номер места от буквенного суффикса
+func parsePlaceNumber(value string) (int, string, error) {
+	value = strings.TrimSpace(value)
+	end := strings.IndexFunc(value, func(r rune) bool { return !unicode.IsDigit(r) })
+	if end < 0 {
+		end = len(value)
+	}
+	number, err := strconv.Atoi(value[:end])
+	if err != nil || number <= 0 {
+		return 0, "", fmt.Errorf("invalid place number %q", value)
+	}
+	return number, value[end:], nil
+}
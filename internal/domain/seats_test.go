@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlaces(t *testing.T) {
+	places, err := ParsePlaces("002, 030-032,014С")
+	require.NoError(t, err)
+	require.Equal(t, []SeatPlace{
+		{Number: 2, Label: "002"},
+		{Number: 30, Label: "030"},
+		{Number: 31, Label: "031"},
+		{Number: 32, Label: "032"},
+		{Number: 14, Label: "014С"},
+	}, places)
+
+	_, err = ParsePlaces("010-002")
+	require.Error(t, err)
+	_, err = ParsePlaces("abc")
+	require.Error(t, err)
+}
+
+func TestLayoutSeat(t *testing.T) {
+	// Плацкарт: 37-38 - боковые напротив девятого купе, 53-54 - первого
+	require.Equal(t, SeatPlace{CarType: Platz, Number: 5, Compartment: 2, Lower: true}, LayoutSeat(Platz, 5, SeatLower))
+	require.Equal(t, SeatPlace{CarType: Platz, Number: 38, Compartment: 9, Side: true, NearToilet: true}, LayoutSeat(Platz, 38, ""))
+	require.Equal(t, SeatPlace{CarType: Platz, Number: 53, Compartment: 1, Lower: true, Side: true, NearToilet: true}, LayoutSeat(Platz, 53, ""))
+	require.Equal(t, SeatPlace{CarType: Coupe, Number: 36, Compartment: 9, NearToilet: true}, LayoutSeat(Coupe, 36, ""))
+	require.Equal(t, SeatPlace{CarType: Lux, Number: 4, Compartment: 2, Lower: true}, LayoutSeat(Lux, 4, SeatLower))
+}
@@ -0,0 +1,60 @@
+// internal/domain/train_type.go
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// highSpeedBrands бренды, однозначно определяющие скоростной поезд
+var highSpeedBrands = []string{"САПСАН", "СТРИЖ", "АЛЛЕГРО", "НЕВСКИЙ ЭКСПРЕСС"}
+
+// IsHighSpeedBrand сообщает, относится ли бренд поезда к скоростным
+func IsHighSpeedBrand(brand string) bool {
+	brand = strings.ToUpper(strings.TrimSpace(brand))
+	for _, b := range highSpeedBrands {
+		if brand == b {
+			return true
+		}
+	}
+	return false
+}
+
+// TrainTypeByNumber определяет категорию поезда по диапазону номера согласно правилам нумерации РЖД.
+// Второе значение false, если номер не разобран или не попадает ни в один известный диапазон.
+func TrainTypeByNumber(number string) (TrainType, bool) {
+	value, ok := parseTrainNumber(number)
+	if !ok {
+		return Train, false
+	}
+	switch {
+	case value >= 701 && value <= 788:
+		return HighSpeed, true // 701-750 скоростные, 751-788 высокоскоростные
+	case value >= 801 && value <= 898:
+		return ExpressSuburban, true // Скорые пригородные поезда повышенной комфортности
+	case value >= 6001 && value <= 6999:
+		return Suburban, true
+	case value >= 7001 && value <= 7999:
+		return ExpressSuburban, true
+	case value >= 1 && value <= 698:
+		return Train, true
+	}
+	return Train, false
+}
+
+// parseTrainNumber извлекает числовую часть номера поезда, например 119 из "119А" или 7001 из "7001*"
+func parseTrainNumber(number string) (int, bool) {
+	end := strings.IndexFunc(number, func(r rune) bool { return !unicode.IsDigit(r) })
+	if end == -1 {
+		end = len(number)
+	}
+	if end == 0 {
+		return 0, false
+	}
+	value, err := strconv.Atoi(number[:end])
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
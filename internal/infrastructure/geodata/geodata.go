@@ -0,0 +1,173 @@
+// internal/infrastructure/geodata/geodata.go
+package geodata
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// Колонки набора данных. Обязательны code, lat и lon, остальные можно опустить.
+const (
+	columnCode     = "code"     // Код Экспресс-3, например 2006004
+	columnESR      = "esr"      // Код ЕСР
+	columnName     = "name"     // Название станции
+	columnLat      = "lat"      // Широта, градусы
+	columnLon      = "lon"      // Долгота, градусы
+	columnRegion   = "region"   // Регион
+	columnTimeZone = "timezone" // Часовой пояс (IANA)
+)
+
+// Registry географические данные станций по коду Экспресс-3.
+// Загружается из CSV с заголовком, новые записи заменяют старые с тем же кодом.
+type Registry struct {
+	mutex    sync.RWMutex
+	stations map[int]domain.Station
+}
+
+// NewRegistry создаёт пустой реестр
+func NewRegistry() *Registry {
+	return &Registry{stations: make(map[int]domain.Station)}
+}
+
+// LoadFile дополняет реестр из CSV файла
+func (r *Registry) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open geodata: %w", err)
+	}
+	defer file.Close()
+	return r.Load(file)
+}
+
+// Load дополняет реестр из CSV. Первая строка - названия колонок (code, esr, name, lat, lon,
+// region, timezone) в любом порядке; неизвестные колонки пропускаются. Строки, начинающиеся с '#', - комментарии.
+func (r *Registry) Load(source io.Reader) error {
+	reader := csv.NewReader(source)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read geodata header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{columnCode, columnLat, columnLon} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("geodata header: column %q is required", required)
+		}
+	}
+
+	loaded := make(map[int]domain.Station)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read geodata: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		station, err := parseRecord(record, columns)
+		if err != nil {
+			return fmt.Errorf("geodata line %d: %w", line, err)
+		}
+		loaded[station.Code] = station
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for code, station := range loaded {
+		r.stations[code] = station
+	}
+	return nil
+}
+
+// parseRecord разбирает строку набора данных
+func parseRecord(record []string, columns map[string]int) (domain.Station, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	code, err := strconv.Atoi(field(columnCode))
+	if err != nil || code <= 0 {
+		return domain.Station{}, fmt.Errorf("invalid station code %q", field(columnCode))
+	}
+	lat, err := strconv.ParseFloat(field(columnLat), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return domain.Station{}, fmt.Errorf("invalid latitude %q of station %d", field(columnLat), code)
+	}
+	lon, err := strconv.ParseFloat(field(columnLon), 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return domain.Station{}, fmt.Errorf("invalid longitude %q of station %d", field(columnLon), code)
+	}
+	zone := field(columnTimeZone)
+	if zone != "" {
+		if _, err := time.LoadLocation(zone); err != nil {
+			return domain.Station{}, fmt.Errorf("unknown time zone %q of station %d", zone, code)
+		}
+	}
+	return domain.Station{
+		Name:     field(columnName),
+		Code:     code,
+		ESRCode:  field(columnESR),
+		Region:   field(columnRegion),
+		TimeZone: zone,
+		Location: domain.GeoPoint{Lat: lat, Lon: lon},
+	}, nil
+}
+
+// Len возвращает количество станций в реестре
+func (r *Registry) Len() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.stations)
+}
+
+// Lookup возвращает географические данные станции по коду Экспресс-3
+func (r *Registry) Lookup(code int) (domain.Station, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	station, ok := r.stations[code]
+	return station, ok
+}
+
+// Nearby возвращает до limit станций не дальше radiusKm от точки, от ближайшей к дальней
+func (r *Registry) Nearby(point domain.GeoPoint, radiusKm float64, limit int) []domain.NearbyStation {
+	r.mutex.RLock()
+	var nearby []domain.NearbyStation
+	for _, station := range r.stations {
+		if distance := point.DistanceKm(station.Location); distance <= radiusKm {
+			nearby = append(nearby, domain.NearbyStation{Station: station, DistanceKm: distance})
+		}
+	}
+	r.mutex.RUnlock()
+
+	sort.Slice(nearby, func(i, j int) bool {
+		if nearby[i].DistanceKm != nearby[j].DistanceKm {
+			return nearby[i].DistanceKm < nearby[j].DistanceKm
+		}
+		return nearby[i].Station.Code < nearby[j].Station.Code
+	})
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby
+}
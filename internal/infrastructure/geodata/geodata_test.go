@@ -0,0 +1,52 @@
+package geodata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+const sample = `# Вокзалы Москвы и Петербурга
+lat,lon,code,name,esr,region,timezone
+55.7764,37.6553,2006004,МОСКВА ОКТЯБРЬСКАЯ,060073,Москва,Europe/Moscow
+55.7735,37.6571,2000003,МОСКВА КАЗАНСКАЯ,194013,Москва,Europe/Moscow
+59.9300,30.3620,2004001,САНКТ-ПЕТЕРБУРГ-ГЛАВН.,030004,Санкт-Петербург,Europe/Moscow
+`
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Load(strings.NewReader(sample)))
+	require.Equal(t, 3, registry.Len())
+
+	station, ok := registry.Lookup(2006004)
+	require.True(t, ok)
+	require.Equal(t, "060073", station.ESRCode)
+	require.Equal(t, "Москва", station.Region)
+	require.Equal(t, domain.GeoPoint{Lat: 55.7764, Lon: 37.6553}, station.Location)
+	_, ok = registry.Lookup(2000000)
+	require.False(t, ok)
+
+	// У Казанского вокзала: оба московских вокзала, ближайший первым, Петербург далеко
+	nearby := registry.Nearby(domain.GeoPoint{Lat: 55.7738, Lon: 37.6568}, 5, 0)
+	require.Len(t, nearby, 2)
+	require.Equal(t, 2000003, nearby[0].Station.Code)
+	require.Less(t, nearby[0].DistanceKm, nearby[1].DistanceKm)
+	require.Len(t, registry.Nearby(domain.GeoPoint{Lat: 55.7738, Lon: 37.6568}, 1000, 1), 1)
+	require.Len(t, registry.Nearby(domain.GeoPoint{Lat: 55.7738, Lon: 37.6568}, 1000, 0), 3)
+}
+
+func TestRegistryInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"missing column": "code,lat\n2006004,55.7\n",
+		"latitude":       "code,lat,lon\n2006004,95,37.6\n",
+		"code":           "code,lat,lon\nабв,55.7,37.6\n",
+		"time zone":      "code,lat,lon,timezone\n2006004,55.7,37.6,Europe/Atlantis\n",
+	} {
+		registry := NewRegistry()
+		require.Error(t, registry.Load(strings.NewReader(data)), name)
+		require.Zero(t, registry.Len(), name)
+	}
+}
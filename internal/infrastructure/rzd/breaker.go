@@ -0,0 +1,155 @@
+package rzd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// BreakerState состояние предохранителя эндпоинта
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // Запросы проходят
+	BreakerHalfOpen                     // Пропускается один пробный запрос
+	BreakerOpen                         // Запросы отклоняются без обращения к РЖД
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("BreakerState(%d)", int(s))
+	}
+}
+
+// Эндпоинты РЖД, для которых ведутся отдельные предохранители
+const (
+	breakerRoutes    = "routes"
+	breakerCarriages = "carriages"
+	breakerSuggester = "suggester"
+	breakerSchedule  = "schedule"
+)
+
+// CircuitBreaker размыкается после threshold неудачных попыток подряд и отклоняет запросы
+// в течение openTimeout. Затем пропускает один пробный запрос (half-open): успех замыкает
+// предохранитель, неудача снова размыкает его.
+type CircuitBreaker struct {
+	name        string
+	mutex       sync.Mutex
+	state       BreakerState
+	failures    int
+	threshold   int
+	openTimeout time.Duration
+	openedAt    time.Time
+	probing     bool
+	now         func() time.Time
+	onChange    func(name string, state BreakerState)
+}
+
+// NewCircuitBreaker создаёт замкнутый предохранитель. onChange (может быть nil)
+// вызывается при каждой смене состояния.
+func NewCircuitBreaker(name string, threshold int, openTimeout time.Duration, onChange func(string, BreakerState)) *CircuitBreaker {
+	b := &CircuitBreaker{name: name, now: time.Now, onChange: onChange}
+	b.Configure(threshold, openTimeout)
+	return b
+}
+
+// Configure меняет порог и время размыкания на лету
+func (b *CircuitBreaker) Configure(threshold int, openTimeout time.Duration) {
+	if threshold < 1 {
+		threshold = 1
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.threshold = threshold
+	b.openTimeout = openTimeout
+}
+
+// Allow сообщает, можно ли выполнить попытку запроса. Для разомкнутого предохранителя
+// возвращает ошибку, оборачивающую domain.ErrUpstreamUnavailable.
+func (b *CircuitBreaker) Allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return b.openError()
+		}
+		b.setState(BreakerHalfOpen)
+		b.probing = true
+		return nil
+	case BreakerHalfOpen:
+		if b.probing {
+			return b.openError()
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success отмечает успешную попытку
+func (b *CircuitBreaker) Success() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures = 0
+	b.probing = false
+	if b.state != BreakerClosed {
+		b.setState(BreakerClosed)
+	}
+}
+
+// Failure отмечает неудачную попытку (сетевая ошибка, таймаут или 5xx)
+func (b *CircuitBreaker) Failure() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		if b.state != BreakerOpen {
+			b.setState(BreakerOpen)
+		}
+	}
+}
+
+// Release освобождает разрешение без оценки результата (например, запрос отменён клиентом)
+func (b *CircuitBreaker) Release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.probing = false
+}
+
+// State возвращает текущее состояние
+func (b *CircuitBreaker) State() BreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state
+}
+
+func (b *CircuitBreaker) setState(state BreakerState) {
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(b.name, state)
+	}
+}
+
+func (b *CircuitBreaker) openError() error {
+	retryIn := b.openTimeout - b.now().Sub(b.openedAt)
+	if retryIn < 0 {
+		retryIn = 0
+	}
+	return fmt.Errorf("%w: circuit breaker for %s is open, retry in %s", domain.ErrUpstreamUnavailable, b.name, retryIn.Round(time.Second))
+}
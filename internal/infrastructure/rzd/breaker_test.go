@@ -0,0 +1,133 @@
+package rzd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+	var states []BreakerState
+	breaker := NewCircuitBreaker("routes", 2, time.Minute, func(_ string, state BreakerState) {
+		states = append(states, state)
+	})
+	breaker.now = func() time.Time { return now }
+
+	require.NoError(t, breaker.Allow())
+	breaker.Failure()
+	require.Equal(t, BreakerClosed, breaker.State())
+	breaker.Failure()
+	require.Equal(t, BreakerOpen, breaker.State())
+
+	// Пока предохранитель разомкнут, попытки отклоняются сразу
+	err := breaker.Allow()
+	require.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+
+	// По истечении таймаута пропускается ровно один пробный запрос
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow())
+	require.Equal(t, BreakerHalfOpen, breaker.State())
+	require.ErrorIs(t, breaker.Allow(), domain.ErrUpstreamUnavailable)
+
+	// Неудачная проба снова размыкает, удачная - замыкает
+	breaker.Failure()
+	require.Equal(t, BreakerOpen, breaker.State())
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow())
+	breaker.Success()
+	require.Equal(t, BreakerClosed, breaker.State())
+
+	require.Equal(t, []BreakerState{BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen, BreakerClosed}, states)
+}
+
+func TestClientBreakerServesStaleResponse(t *testing.T) {
+	var down atomic.Bool
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"n":"МОСКВА","c":2000000,"S":5,"L":0}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  10,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute, StaleTTL: time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	params := domain.SearchStationParams{Query: "МОСК"}
+	stations, err := client.SearchStation(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, stations, 1)
+
+	// РЖД недоступен: после трёх неудач повторы прекращаются и отдаётся сохранённый ответ
+	down.Store(true)
+	requests.Store(0)
+	stale, err := client.SearchStation(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, stations, stale)
+	require.EqualValues(t, 3, requests.Load())
+	require.Equal(t, "open", client.BreakerStates()[breakerSuggester])
+
+	// Для запроса без сохранённого ответа - быстрый отказ без обращения к РЖД
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{Query: "ПЕТ"})
+	require.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	require.EqualValues(t, 3, requests.Load())
+	require.Equal(t, "closed", client.BreakerStates()[breakerRoutes])
+}
+
+// Исчерпанные повторы из-за сетевых ошибок и ответов 5xx - недоступность РЖД, а не неизвестная ошибка
+func TestClientReportsUpstreamUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	cfg := &config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  2,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 5, OpenTimeout: time.Minute},
+	}
+	client, err := NewRzdClient(cfg, nil)
+	require.NoError(t, err)
+
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+	require.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	require.ErrorContains(t, err, "failed after 2 attempts")
+
+	// Сервер не отвечает: адрес запроса не попадает в текст ошибки
+	server.Close()
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{Query: "ПЕТ"})
+	require.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	require.NotContains(t, err.Error(), "suggester")
+
+	// Ответ 4xx - не недоступность
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	cfg.BasePath = server.URL + "/"
+	client, err = NewRzdClient(cfg, nil)
+	require.NoError(t, err)
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, domain.ErrUpstreamUnavailable)
+}
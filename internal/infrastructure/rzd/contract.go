@@ -0,0 +1,127 @@
+package rzd
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+const (
+	// maxDriftEvents сколько различных расхождений хранится для диагностики
+	maxDriftEvents = 100
+	// driftSampleSize сколько байт ответа сохраняется в образце
+	driftSampleSize = 1024
+)
+
+// ContractMonitor учитывает расхождения ответов РЖД с контрактом схем по эндпоинтам:
+// обновляет метрики, логирует образец ответа при первом появлении расхождения
+// и хранит последние события для диагностики.
+type ContractMonitor struct {
+	mutex  sync.Mutex
+	events map[string]*domain.SchemaDriftEvent // По эндпоинту и описанию расхождений
+	now    func() time.Time
+}
+
+// NewContractMonitor создаёт пустой монитор контракта
+func NewContractMonitor() *ContractMonitor {
+	return &ContractMonitor{
+		events: make(map[string]*domain.SchemaDriftEvent),
+		now:    time.Now,
+	}
+}
+
+// Record учитывает проверку ответа эндпоинта
+func (m *ContractMonitor) Record(endpoint string, drift schemas.Drift, body []byte) {
+	contractChecks.WithLabelValues(endpoint).Inc()
+	if drift.Empty() {
+		return
+	}
+	contractDriftResponses.WithLabelValues(endpoint).Inc()
+	contractFields.WithLabelValues(endpoint, "unknown").Add(float64(len(drift.Unknown)))
+	contractFields.WithLabelValues(endpoint, "missing").Add(float64(len(drift.Missing)))
+	contractFields.WithLabelValues(endpoint, "changed").Add(float64(len(drift.Changed)))
+
+	report := drift.String()
+	sample := truncateSample(body, driftSampleSize)
+	now := m.now()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	key := endpoint + "\x00" + report
+	if event, ok := m.events[key]; ok {
+		event.LastSeen = now
+		event.Count++
+		event.Sample = sample
+		return
+	}
+
+	log.Printf("RZD %s response does not match schema: %s; sample: %s", endpoint, report, sample)
+	changed := make([]domain.SchemaFieldChange, 0, len(drift.Changed))
+	for _, change := range drift.Changed {
+		changed = append(changed, domain.SchemaFieldChange{Path: change.Path, Reason: change.Reason})
+	}
+	m.events[key] = &domain.SchemaDriftEvent{
+		Provider:      "rzd",
+		Endpoint:      endpoint,
+		FirstSeen:     now,
+		LastSeen:      now,
+		Count:         1,
+		UnknownFields: drift.Unknown,
+		MissingFields: drift.Missing,
+		ChangedFields: changed,
+		Sample:        sample,
+	}
+	if len(m.events) > maxDriftEvents {
+		m.evictOldest()
+	}
+}
+
+// Events возвращает события эндпоинта (пустой - всех) от последнего к первому.
+// limit <= 0 означает все сохранённые события.
+func (m *ContractMonitor) Events(endpoint string, limit int) []domain.SchemaDriftEvent {
+	m.mutex.Lock()
+	events := make([]domain.SchemaDriftEvent, 0, len(m.events))
+	for _, event := range m.events {
+		if endpoint == "" || event.Endpoint == endpoint {
+			events = append(events, *event)
+		}
+	}
+	m.mutex.Unlock()
+
+	sort.Slice(events, func(i, j int) bool { return events[i].LastSeen.After(events[j].LastSeen) })
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events
+}
+
+// evictOldest удаляет событие, которое дольше всех не повторялось
+func (m *ContractMonitor) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, event := range m.events {
+		if oldestKey == "" || event.LastSeen.Before(oldest) {
+			oldestKey, oldest = key, event.LastSeen
+		}
+	}
+	delete(m.events, oldestKey)
+}
+
+// truncateSample возвращает начало ответа не длиннее limit байт, не разрывая символы UTF-8
+func truncateSample(body []byte, limit int) string {
+	sample := strings.TrimSpace(string(body))
+	if len(sample) <= limit {
+		return sample
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(sample[cut]) {
+		cut--
+	}
+	return sample[:cut] + "..."
+}
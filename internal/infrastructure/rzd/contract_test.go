@@ -0,0 +1,82 @@
+package rzd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func TestContractMonitor(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+	monitor := NewContractMonitor()
+	monitor.now = func() time.Time { return now }
+
+	unknown := schemas.Drift{Unknown: []string{"tp[].list[].newField"}}
+	monitor.Record(breakerRoutes, unknown, []byte(`{"result":"OK"}`))
+	monitor.Record(breakerRoutes, schemas.Drift{}, []byte(`{}`))
+	now = now.Add(time.Minute)
+	monitor.Record(breakerSuggester, schemas.Drift{Missing: []string{"c"}}, []byte(`[{"n":"МОСКВА"}]`))
+	now = now.Add(time.Minute)
+	monitor.Record(breakerRoutes, unknown, []byte(`{"result":"OK","tp":[]}`))
+
+	// Одинаковые расхождения объединяются, последние - первыми
+	events := monitor.Events("", 0)
+	require.Len(t, events, 2)
+	require.Equal(t, breakerRoutes, events[0].Endpoint)
+	require.Equal(t, 2, events[0].Count)
+	require.Equal(t, now.Add(-2*time.Minute), events[0].FirstSeen)
+	require.Equal(t, now, events[0].LastSeen)
+	require.Equal(t, `{"result":"OK","tp":[]}`, events[0].Sample)
+	require.Equal(t, []string{"c"}, events[1].MissingFields)
+
+	require.Len(t, monitor.Events(breakerSuggester, 0), 1)
+	require.Len(t, monitor.Events("", 1), 1)
+	require.Empty(t, monitor.Events(breakerCarriages, 0))
+}
+
+func TestTruncateSample(t *testing.T) {
+	require.Equal(t, "МО...", truncateSample([]byte("МОСКВА"), 5))
+	require.Equal(t, "ok", truncateSample([]byte(" ok\n"), 5))
+}
+
+func TestClientReportsSchemaDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"n":"МОСКВА","c":"2000000","S":5,"L":0,"region":"Москва"}]`))
+	}))
+	defer server.Close()
+
+	cfg := &config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  1,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+		DecodeMode:  config.DecodeLenient,
+	}
+	client, err := NewRzdClient(cfg, nil)
+	require.NoError(t, err)
+
+	// В лёгком режиме ответ разбирается, расхождение сохраняется для диагностики
+	stations, err := client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+	require.NoError(t, err)
+	require.Equal(t, 2000000, stations[0].Code)
+	events := client.SchemaDrift(breakerSuggester, 0)
+	require.Len(t, events, 1)
+	require.Equal(t, []string{"[].region"}, events[0].UnknownFields)
+
+	strict := *cfg
+	strict.DecodeMode = config.DecodeStrict
+	require.NoError(t, client.ApplyConfig(&strict))
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+	require.ErrorIs(t, err, schemas.ErrSchemaDrift)
+	require.Equal(t, 2, client.SchemaDrift("", 0)[0].Count)
+}
@@ -12,6 +12,7 @@ const (
 	RoutesLayer         = 5827 // Для запроса маршрутов
 	CarriagesLayer      = 5764
 	StationsStructureID = 704
+	BasicRoutePageID    = 4819 // refererPageId для маршрута следования поезда
 )
 
 // Endpoints содержит все пути эндпоинтов относительно базового пути
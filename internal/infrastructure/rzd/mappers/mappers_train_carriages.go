@@ -35,28 +35,18 @@ func MapTrainCarriagesResponse(resp schemas.TrainCarriagesResponse) ([]domain.Ca
 				})
 			}
 
-			// Преобразуем тарифы (из строки в int)
-			tariff, err := strconv.Atoi(carSchema.Tariff)
-			if err != nil {
-				// Если конвертация не удалась, тариф считаем равным 0
-				tariff = 0
-			}
-			tariff2, err := strconv.Atoi(carSchema.Tariff2)
-			if err != nil {
-				tariff2 = 0
-			}
-
 			// Маппинг перевозчика: в доменной модели Carrier имеет поля ID и Name,
 			// где ID – строка. В схеме перевозчика передаётся как "carrier" (имя) и "carrierId" (число).
 			carrier := domain.Carrier{
-				ID:   strconv.Itoa(carSchema.CarrierId),
+				ID:   strconv.Itoa(carSchema.CarrierId.Int()),
 				Name: carSchema.Carrier,
 			}
 
 			// Маппинг нумерации вагона: поле CarNumeration может быть nil, если отсутствует.
 			var carNumeration = mapCarNumeration(carSchema.CarNumeration)
 
-			// Маппинг списка мест в вагоне не происходит!
+			// Группы мест (нижние, верхние и т.д.) с их тарифами
+			seats := mapSeats(carSchema.Seats)
 
 			// Собираем данные о конкретном вагоне в доменную модель.
 			// Поля, которые не используются в доменной модели (например, AddSigns, IntServiceClass и др.) игнорируются.
@@ -66,15 +56,17 @@ func MapTrainCarriagesResponse(resp schemas.TrainCarriagesResponse) ([]domain.Ca
 				CategoryLabelLocal: carSchema.CatLabelLoc,
 				TypeLabel:          carSchema.TypeLoc,
 				CategoryCode:       carSchema.CatCode,
-				CarTypeID:          carSchema.Ctypei,
-				CarType:            carSchema.Ctype,
+				CarTypeID:          carSchema.Ctypei.Int(),
+				CarType:            carSchema.Ctype.Int(),
+				SeatType:           mapCarSeatType(carSchema.Ctype.Int(), carSchema.Ctypei.Int()),
 				Letter:             carSchema.Letter,
 				ClassType:          carSchema.ClsType,
 				Services:           serviceList,
-				Tariff:             tariff,
-				Tariff2:            tariff2,
+				Tariff:             carSchema.Tariff.Rubles(), // Тарифы округляются до целых рублей
+				Tariff2:            carSchema.Tariff2.Rubles(),
 				Carrier:            carrier,
 				CarNumeration:      carNumeration,
+				Seats:              seats,
 			}
 
 			cars = append(cars, car)
@@ -84,13 +76,59 @@ func MapTrainCarriagesResponse(resp schemas.TrainCarriagesResponse) ([]domain.Ca
 	return cars, nil
 }
 
+// ctypeSeatTypes типы мест по коду ctype ответа вагонов. Коды ctype отличаются от itype
+// ответа маршрутов и от domain.CarSeatType: плацкарт здесь 3, а не 1.
+var ctypeSeatTypes = map[int]domain.CarSeatType{
+	1: domain.General,
+	2: domain.Side,
+	3: domain.Platz,
+	4: domain.Coupe,
+	5: domain.Soft,
+	6: domain.Lux,
+}
+
+// mapCarSeatType определяет тип мест вагона по ctype; если код неизвестен - по ctypei,
+// который совпадает с itype ответа маршрутов
+func mapCarSeatType(ctype, ctypei int) domain.CarSeatType {
+	if seatType, ok := ctypeSeatTypes[ctype]; ok {
+		return seatType
+	}
+	if seatType := domain.CarSeatType(ctypei); seatType >= domain.Platz && seatType <= domain.Lux {
+		return seatType
+	}
+	return 0
+}
+
+// mapSeats преобразует группы мест вагона
+func mapSeats(seats []schemas.Seat) []domain.SeatGroup {
+	if len(seats) == 0 {
+		return nil
+	}
+	result := make([]domain.SeatGroup, 0, len(seats))
+	for _, seat := range seats {
+		result = append(result, domain.SeatGroup{
+			Type:    seat.Type,
+			Label:   seat.Label,
+			Tariff:  seat.Tariff.Rubles(),
+			Tariff2: seat.Tariff2.Rubles(),
+			Free:    seat.Free.Int(),
+			Places:  seat.Places,
+		})
+	}
+	return result
+}
+
 // mapCarNumeration преобразует строковое представление нумерации вагона в CarNumeration.
 func mapCarNumeration(value *string) domain.CarNumeration {
 	if value == nil {
 		return domain.Unknown
 	}
+	return parseCarNumeration(*value)
+}
 
-	switch *value {
+// parseCarNumeration преобразует значение "FromHead"/"FromTail" в CarNumeration.
+func parseCarNumeration(value string) domain.CarNumeration {
+	switch value {
 	case "FromHead":
 		return domain.Head
 	case "FromTail":
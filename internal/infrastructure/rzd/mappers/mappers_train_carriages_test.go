@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+// В образце ответа вагонов плацкарт приходит с ctype 3 и ctypei 1, купе - 4/4, люкс - 6/6.
+// CarType сохраняет исходный ctype, тип мест приводится к domain.CarSeatType.
+func TestMapTrainCarriagesSeatTypes(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "docs", "data_templates", "GetTrainCarriages.json"))
+	require.NoError(t, err)
+	var response schemas.TrainCarriagesResponse
+	_, err = schemas.Decode(data, &response, schemas.DecodeStrict)
+	require.NoError(t, err)
+
+	cars, err := MapTrainCarriagesResponse(response)
+	require.NoError(t, err)
+	expected := map[string]struct {
+		ctype    int
+		seatType domain.CarSeatType
+	}{
+		"Купе": {ctype: 4, seatType: domain.Coupe},
+		"Плац": {ctype: 3, seatType: domain.Platz},
+		"Люкс": {ctype: 6, seatType: domain.Lux},
+	}
+	for _, car := range cars {
+		require.Equal(t, expected[car.Type].ctype, car.CarType, "car %s (%s)", car.CarNumber, car.Type)
+		require.Equal(t, expected[car.Type].seatType, car.SeatType, "car %s (%s)", car.CarNumber, car.Type)
+	}
+}
+
+func TestMapCarSeatType(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctype  int
+		ctypei int
+		want   domain.CarSeatType
+	}{
+		{name: "плацкарт", ctype: 3, ctypei: 1, want: domain.Platz},
+		{name: "купе", ctype: 4, ctypei: 4, want: domain.Coupe},
+		{name: "люкс", ctype: 6, ctypei: 6, want: domain.Lux},
+		{name: "неизвестный ctype", ctype: 0, ctypei: 5, want: domain.Soft},
+		{name: "неизвестные коды", ctype: 9, ctypei: 9, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, mapCarSeatType(tt.ctype, tt.ctypei))
+		})
+	}
+}
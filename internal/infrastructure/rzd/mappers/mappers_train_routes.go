@@ -2,17 +2,24 @@ package mappers
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
 )
 
-// MapTrainRouteResponse маппит ответ API маршрутов в доменную модель
-func MapTrainRouteResponse(response schemas.TrainRouteResponse) ([]domain.TrainRoute, error) {
+// Биты поля flMsk: станция находится в московском часовом поясе
+const (
+	flMskDeparture = 1 << iota // Станция отправления
+	flMskArrival               // Станция прибытия
+)
+
+// MapTrainRouteResponse маппит ответ API маршрутов в доменную модель.
+// Время отправления и прибытия переводится в часовые пояса станций с помощью zones.
+func MapTrainRouteResponse(response schemas.TrainRouteResponse, zones *timezone.Resolver) ([]domain.TrainRoute, error) {
 	var routes []domain.TrainRoute
 
 	// Перебор всех TP в ответе
@@ -25,44 +32,60 @@ func MapTrainRouteResponse(response schemas.TrainRouteResponse) ([]domain.TrainR
 				return nil, fmt.Errorf("failed to parse timeInWay: %v", err)
 			}
 
-			// Парсинг времени отправления и прибытия
-			departure, err := parseDateTime(train.Date0, train.Time0)
+			// Парсинг времени отправления и прибытия в часовых поясах станций
+			depLocation, arrLocation, originLocation := routeLocations(tp.DefShowTime, train, zones)
+			departure, err := parseDateTime(train.Date0, train.Time0, depLocation)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse departure time: %v", err)
 			}
 
-			arrival, err := parseDateTime(train.Date1, train.Time1)
+			arrival, err := parseDateTime(train.Date1, train.Time1, arrLocation)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse arrival time: %v", err)
 			}
 
+			// Время отправления с начальной станции маршрута поезда может отсутствовать
+			var originDeparture time.Time
+			if train.TrDate0 != "" && train.TrTime0 != "" {
+				originDeparture, err = parseDateTime(train.TrDate0, train.TrTime0, originLocation)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse origin departure time: %v", err)
+				}
+			}
+
 			// Маппинг маршрута
 			route := domain.TrainRoute{
-				TrainNumber: train.Number,
-				Duration:    duration,
-				Brand:       train.Brand,
-				Carrier: domain.Carrier{
-					Name: train.Carrier,
-				},
+				TrainNumber:     train.Number,
+				TrainNumber2:    train.Number2,
+				TrainName:       train.TrainName,
+				TrainType:       mapTrainType(train),
+				Duration:        duration,
+				Brand:           train.Brand,
+				Carrier:         domain.Carrier{Name: train.Carrier},
+				Firm:            train.BFirm,
+				ElReg:           train.ElReg,
+				VarPrice:        train.VarPrice,
+				DeferredPayment: train.DeferredPayment,
+				CarNumeration:   parseCarNumeration(train.CarNumeration),
+				SaleDepth:       train.Depth,
 				From: domain.Station{
 					Name:      train.Station0,
 					RouteName: train.Route0,
-					Code:      train.Code0,
+					Code:      train.Code0.Int(),
+					TimeZone:  zones.Resolve(train.Code0.Int()).String(),
 				},
 				To: domain.Station{
 					Name:      train.Station1,
 					RouteName: train.Route1,
-					Code:      train.Code1,
+					Code:      train.Code1.Int(),
+					TimeZone:  zones.Resolve(train.Code1.Int()).String(),
 				},
-				Departure: departure,
-				Arrival:   arrival,
-				CarTypes:  mapTrainCarriages(train.Cars),
-			}
-
-			// Обработка seatCars (если они есть)
-			if len(train.SeatCars) > 0 {
-				seatCarriages := mapTrainSeatCarriages(train.SeatCars)
-				route.CarTypes = append(route.CarTypes, seatCarriages...)
+				FromCity:        requestedCity(tp.From, tp.FromCode.Int(), train.Code0.Int(), zones),
+				ToCity:          requestedCity(tp.Where, tp.WhereCode.Int(), train.Code1.Int(), zones),
+				Departure:       departure,
+				Arrival:         arrival,
+				OriginDeparture: originDeparture,
+				CarTypes:        mapCarriageTypes(train.Cars, train.SeatCars),
 			}
 
 			routes = append(routes, route)
@@ -72,65 +95,102 @@ func MapTrainRouteResponse(response schemas.TrainRouteResponse) ([]domain.TrainR
 	return routes, nil
 }
 
-// mapTrainCarriages маппит список вагонов
-func mapTrainCarriages(carriages []schemas.CarriageType) []domain.CarriageType {
-	var result []domain.CarriageType
-
-	for _, car := range carriages {
-		carriage := domain.CarriageType{
-			Type:           domain.CarSeatType(car.Itype),
-			TypeShortLabel: car.Type,
-			TypeLabel:      car.TypeLoc,
-			Class:          car.ServCls,
-			Tariff:         car.Tariff,
-			Disabled:       car.DisabledPerson,
-		}
-
-		result = append(result, carriage)
+// requestedCity возвращает станцию из запроса (fromCode/whereCode), если поезд идёт
+// не от самой запрошенной станции, а от одной из станций её группы. Уровень станции
+// в ответе не передаётся, поэтому Level не заполняется: город ли это, определяет сервис.
+func requestedCity(name string, code, stationCode int, zones *timezone.Resolver) domain.Station {
+	if code == 0 || code == stationCode {
+		return domain.Station{}
+	}
+	return domain.Station{
+		Name:     name,
+		Code:     code,
+		TimeZone: zones.Resolve(code).String(),
 	}
+}
 
-	return result
+// carDataTypes значения carDataType ответа маршрутов
+var carDataTypes = map[int]domain.CarDataType{
+	1: domain.CarDataSummary,  // Список cars
+	2: domain.CarDataSeatTier, // Список seatCars
 }
 
-func mapTrainSeatCarriages(carriages []schemas.SeatCarriageType) []domain.CarriageType {
+// mapCarriageTypes маппит сводку по типам вагонов (cars) и ценовые ступени сидячих мест (seatCars)
+// в один список: сначала сводка, затем ступени
+func mapCarriageTypes(cars []schemas.CarriageType, seatCars []schemas.SeatCarriageType) []domain.CarriageType {
 	var result []domain.CarriageType
+	for _, car := range cars {
+		// Схема seatCars - те же поля, что у cars, и ещё tariff2 и lastPlaces
+		result = append(result, mapCarriageType(schemas.SeatCarriageType{
+			CarDataType:    car.CarDataType,
+			Itype:          car.Itype,
+			Type:           car.Type,
+			TypeLoc:        car.TypeLoc,
+			FreeSeats:      car.FreeSeats,
+			Pt:             car.Pt,
+			Tariff:         car.Tariff,
+			ServCls:        car.ServCls,
+			DisabledPerson: car.DisabledPerson,
+		}, domain.CarDataSummary))
+	}
+	for _, car := range seatCars {
+		result = append(result, mapCarriageType(car, domain.CarDataSeatTier))
+	}
+	return result
+}
 
-	for _, car := range carriages {
-
-		tariff, err := strconv.Atoi(car.Tariff)
-		if err != nil {
-			log.Printf("failed to parse tariff for car type %s (defaulting to 0): %v", car.Type, err)
-			tariff = 0
-		}
-		tariff2 := 0
-		if car.Tariff2 != "" {
-			tariff2, err = strconv.Atoi(car.Tariff2)
-			if err != nil {
-				log.Printf("failed to parse tariff2 for car type %s (defaulting to 0): %v", car.Type, err)
-				tariff2 = 0
-			}
-		}
-
-		carriage := domain.CarriageType{
-			Type:        domain.CarSeatType(car.Itype),
-			TypeLabel:   car.TypeLoc,
-			Class:       car.ServCls,
-			Tariff:      tariff,
-			TariffExtra: tariff2,
-			Disabled:    false, // Как понимаю SeatCarriageType сделано для бизнес-класса, где нет мест для инвалидов
-			FreeSeats:   car.FreeSeats,
-		}
+// mapCarriageType маппит запись о типе вагона. Тарифы приходят то числами, то строками;
+// ошибки разбора попадают в отчёт о расхождениях схемы. Если carDataType не пришёл, вид записи
+// определяется списком, в котором она пришла.
+func mapCarriageType(car schemas.SeatCarriageType, fallback domain.CarDataType) domain.CarriageType {
+	dataType, ok := carDataTypes[car.CarDataType]
+	if !ok {
+		dataType = fallback
+	}
+	return domain.CarriageType{
+		Type:           domain.CarSeatType(car.Itype),
+		TypeShortLabel: car.Type,
+		TypeLabel:      car.TypeLoc,
+		Class:          car.ServCls,
+		Tariff:         car.Tariff.Rubles(),
+		TariffExtra:    car.Tariff2.Rubles(),
+		FreeSeats:      car.FreeSeats.Int(),
+		Disabled:       car.DisabledPerson,
+		DataType:       dataType,
+		BonusPoints:    car.Pt.Int(),
+		LastSeats:      car.LastPlaces,
+	}
+}
 
-		result = append(result, carriage)
+// routeLocations определяет, в каких часовых поясах указаны время отправления, прибытия
+// и отправления с начальной станции маршрута.
+// При defShowTime = "msk" РЖД отдаёт все времена по Москве, иначе - по местному времени станций.
+// Биты flMsk подтверждают, что станция живёт по московскому времени, и имеют приоритет над таблицей поясов.
+func routeLocations(defShowTime string, train schemas.TrainList, zones *timezone.Resolver) (dep, arr, origin *time.Location) {
+	moscow := zones.Moscow()
+	if strings.EqualFold(defShowTime, "msk") {
+		return moscow, moscow, moscow
 	}
 
-	return result
+	dep = zones.Resolve(train.Code0.Int())
+	if train.FlMsk&flMskDeparture != 0 {
+		dep = moscow
+	}
+	arr = zones.Resolve(train.Code1.Int())
+	if train.FlMsk&flMskArrival != 0 {
+		arr = moscow
+	}
+	origin = dep
+	if train.RouteCode0 != 0 && train.RouteCode0 != train.Code0 {
+		origin = zones.Resolve(train.RouteCode0.Int())
+	}
+	return dep, arr, origin
 }
 
-// parseDateTime парсит дату и время из строки
-func parseDateTime(dateStr, timeStr string) (time.Time, error) {
+// parseDateTime парсит дату и время из строки в заданном часовом поясе
+func parseDateTime(dateStr, timeStr string, loc *time.Location) (time.Time, error) {
 	layout := "02.01.2006 15:04"
-	return time.Parse(layout, dateStr+" "+timeStr)
+	return time.ParseInLocation(layout, dateStr+" "+timeStr, loc)
 }
 
 // parseDuration преобразует строку времени в пути формата HH:mm в time.Duration
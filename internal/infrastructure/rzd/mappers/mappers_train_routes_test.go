@@ -0,0 +1,125 @@
+package mappers
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+)
+
+var update = flag.Bool("update", false, "перезаписать эталонные файлы в testdata")
+
+// trainCarTypes типы вагонов одного поезда в эталонном файле
+type trainCarTypes struct {
+	TrainNumber string                `json:"trainNumber"`
+	CarTypes    []domain.CarriageType `json:"carTypes"`
+}
+
+// Типы вагонов из образца ответа маршрутов сверяются с эталоном:
+// go test ./internal/infrastructure/rzd/mappers -update перезаписывает его после намеренных изменений
+func TestMapTrainRouteCarTypesGolden(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "docs", "data_templates", "GetTrainRoutes.json"))
+	require.NoError(t, err)
+	var response schemas.TrainRouteResponse
+	_, err = schemas.Decode(data, &response, schemas.DecodeStrict)
+	require.NoError(t, err)
+	zones, err := timezone.NewResolver()
+	require.NoError(t, err)
+
+	routes, err := MapTrainRouteResponse(response, zones)
+	require.NoError(t, err)
+	var trains []trainCarTypes
+	for _, route := range routes {
+		trains = append(trains, trainCarTypes{TrainNumber: route.TrainNumber, CarTypes: route.CarTypes})
+	}
+	actual, err := json.MarshalIndent(trains, "", "  ")
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "train_routes_car_types.golden.json")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, append(actual, '\n'), 0o644))
+	}
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+func TestMapCarriageTypes(t *testing.T) {
+	cars := []schemas.CarriageType{
+		{CarDataType: 1, Itype: 3, Type: "Сид", TypeLoc: "Сидячий", FreeSeats: 8, Pt: 3121, Tariff: 10426, ServCls: "2С"},
+	}
+	seatCars := []schemas.SeatCarriageType{
+		{CarDataType: 2, Itype: 3, Type: "Сид", TypeLoc: "Сидячий", FreeSeats: 2, Pt: 8723, Tariff: 29138, Tariff2: 31170, ServCls: "1Р"},
+		{Itype: 3, Type: "Сид", FreeSeats: 1, Tariff: 3024, DisabledPerson: true, LastPlaces: true},
+	}
+	// Обе схемы дают одну модель; без carDataType вид записи берётся из списка
+	require.Equal(t, []domain.CarriageType{
+		{Type: domain.Side, TypeShortLabel: "Сид", TypeLabel: "Сидячий", Class: "2С", Tariff: 10426, FreeSeats: 8, DataType: domain.CarDataSummary, BonusPoints: 3121},
+		{Type: domain.Side, TypeShortLabel: "Сид", TypeLabel: "Сидячий", Class: "1Р", Tariff: 29138, TariffExtra: 31170, FreeSeats: 2, DataType: domain.CarDataSeatTier, BonusPoints: 8723},
+		{Type: domain.Side, TypeShortLabel: "Сид", Tariff: 3024, FreeSeats: 1, Disabled: true, DataType: domain.CarDataSeatTier, LastSeats: true},
+	}, mapCarriageTypes(cars, seatCars))
+}
+
+func TestRouteLocations(t *testing.T) {
+	zones, err := timezone.NewResolver()
+	require.NoError(t, err)
+	// Москва - Екатеринбург, поезд идёт из Санкт-Петербурга
+	train := schemas.TrainList{Code0: 2000000, Code1: 2030000, RouteCode0: 2004000}
+
+	dep, arr, origin := routeLocations("local", train, zones)
+	require.Equal(t, timezone.MoscowZone, dep.String())
+	require.Equal(t, "Asia/Yekaterinburg", arr.String())
+	require.Equal(t, timezone.MoscowZone, origin.String())
+
+	// defShowTime=msk: все времена московские
+	dep, arr, origin = routeLocations("MSK", train, zones)
+	require.Equal(t, timezone.MoscowZone, dep.String())
+	require.Equal(t, timezone.MoscowZone, arr.String())
+	require.Equal(t, timezone.MoscowZone, origin.String())
+
+	// Обратный поезд: биты flMsk важнее таблицы поясов
+	back := schemas.TrainList{Code0: 2030000, Code1: 2034130, RouteCode0: 2030000}
+	dep, arr, origin = routeLocations("local", back, zones)
+	require.Equal(t, "Asia/Yekaterinburg", dep.String())
+	require.Equal(t, "Asia/Vladivostok", arr.String())
+	require.Equal(t, "Asia/Yekaterinburg", origin.String(), "origin is the departure station")
+
+	back.FlMsk = flMskDeparture
+	dep, arr, _ = routeLocations("local", back, zones)
+	require.Equal(t, timezone.MoscowZone, dep.String())
+	require.Equal(t, "Asia/Vladivostok", arr.String())
+
+	back.FlMsk = flMskDeparture | flMskArrival
+	_, arr, _ = routeLocations("local", back, zones)
+	require.Equal(t, timezone.MoscowZone, arr.String())
+
+	// Одно и то же время на часах - разные моменты в разных поясах
+	local, err := parseDateTime("14.04.2025", "10:00", zones.Resolve(2030000))
+	require.NoError(t, err)
+	require.Equal(t, "2025-04-14T05:00:00Z", local.UTC().Format(time.RFC3339))
+	moscow, err := parseDateTime("14.04.2025", "10:00", zones.Moscow())
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, moscow.Sub(local))
+}
+
+func TestRequestedCity(t *testing.T) {
+	zones, err := timezone.NewResolver()
+	require.NoError(t, err)
+
+	// Поезд идёт от самой запрошенной станции или код не передан: города нет
+	require.Zero(t, requestedCity("САНКТ-ПЕТЕРБУРГ", 2004001, 2004001, zones))
+	require.Zero(t, requestedCity("", 0, 2004001, zones))
+
+	// Поезд идёт от другой станции: уровень запрошенной станции в ответе не передаётся и не выдумывается
+	city := requestedCity("ЕКАТЕРИНБУРГ", 2030000, 2030001, zones)
+	require.Equal(t, domain.Station{Name: "ЕКАТЕРИНБУРГ", Code: 2030000, TimeZone: "Asia/Yekaterinburg"}, city)
+	require.False(t, city.IsCity())
+}
@@ -0,0 +1,91 @@
+package mappers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+)
+
+// MapTrainScheduleResponse маппит ответ basicRoute в маршрут поезда с остановками.
+// basicRoute отдаёт только время по Москве без дат, поэтому даты восстанавливаются от date
+// (дата отправления с начальной станции): каждый переход времени через полночь - следующие сутки.
+// Время остановок переводится в часовые пояса станций с помощью zones.
+func MapTrainScheduleResponse(response schemas.TrainScheduleResponse, trainNumber string, date time.Time, zones *timezone.Resolver) (domain.TrainSchedule, error) {
+	stops := response.Data.Routes
+	if len(stops) == 0 {
+		return domain.TrainSchedule{}, fmt.Errorf("%w: %s on %s", domain.ErrTrainNotFound, trainNumber, date.Format("02.01.2006"))
+	}
+
+	moscow := zones.Moscow()
+	year, month, day := date.Date()
+	clock := time.Date(year, month, day, 0, 0, 0, 0, moscow)
+	var last time.Time
+	// next возвращает ближайший момент не раньше предыдущего с временем суток HH:MM
+	next := func(value string) (time.Time, error) {
+		parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q: %v", value, err)
+		}
+		moment := time.Date(clock.Year(), clock.Month(), clock.Day(), parsed.Hour(), parsed.Minute(), 0, 0, moscow)
+		if !last.IsZero() && moment.Before(last) {
+			moment = moment.AddDate(0, 0, 1)
+		}
+		clock, last = moment, moment
+		return moment, nil
+	}
+
+	schedule := domain.TrainSchedule{Stops: make([]domain.TrainStop, 0, len(stops))}
+	for i, s := range stops {
+		location := zones.Resolve(s.Code.Int())
+		stop := domain.TrainStop{
+			Station: domain.Station{
+				Name:     s.Station,
+				Code:     s.Code.Int(),
+				TimeZone: location.String(),
+			},
+			Stay:     time.Duration(s.WaitingTime.Int()) * time.Minute,
+			Distance: s.Distance.Int(),
+		}
+		if s.ArvTime != "" && i > 0 {
+			arrival, err := next(s.ArvTime)
+			if err != nil {
+				return domain.TrainSchedule{}, fmt.Errorf("failed to parse arrival at %s: %v", s.Station, err)
+			}
+			stop.Arrival = arrival.In(location)
+		}
+		if s.DepTime != "" && i < len(stops)-1 {
+			departure, err := next(s.DepTime)
+			if err != nil {
+				return domain.TrainSchedule{}, fmt.Errorf("failed to parse departure from %s: %v", s.Station, err)
+			}
+			stop.Departure = departure.In(location)
+		}
+		if stop.Stay == 0 && !stop.Arrival.IsZero() && !stop.Departure.IsZero() {
+			stop.Stay = stop.Departure.Sub(stop.Arrival)
+		}
+		schedule.Stops = append(schedule.Stops, stop)
+	}
+
+	origin, terminus := schedule.Stops[0], schedule.Stops[len(schedule.Stops)-1]
+	number := response.Data.TrainInfo.Number.String()
+	if number == "" {
+		number = trainNumber
+	}
+	schedule.Route = domain.TrainRoute{
+		TrainNumber:     number,
+		From:            origin.Station,
+		To:              terminus.Station,
+		Departure:       origin.Departure,
+		Arrival:         terminus.Arrival,
+		OriginDeparture: origin.Departure,
+		CarNumeration:   domain.Unknown,
+	}
+	if !origin.Departure.IsZero() && !terminus.Arrival.IsZero() {
+		schedule.Route.Duration = terminus.Arrival.Sub(origin.Departure)
+	}
+	return schedule, nil
+}
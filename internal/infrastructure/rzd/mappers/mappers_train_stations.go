@@ -13,9 +13,9 @@ func MapStationCodeResponse(resp schemas.StationCodeResponse) ([]domain.Station,
 	for _, s := range resp {
 		stations = append(stations, domain.Station{
 			Name:  s.N,
-			Code:  s.C,
-			Level: s.L,
-			Score: s.S,
+			Code:  s.C.Int(),
+			Level: s.L.Int(),
+			Score: s.S.Int(),
 		})
 	}
 
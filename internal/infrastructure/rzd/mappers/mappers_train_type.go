@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+// mapTrainType определяет категорию поезда по данным из списка маршрутов
+func mapTrainType(train schemas.TrainList) domain.TrainType {
+	switch {
+	case train.Boat:
+		return domain.Ferry
+	case train.Bus:
+		return domain.Bus
+	}
+
+	if domain.IsHighSpeedBrand(train.Brand) {
+		return domain.HighSpeed
+	}
+
+	// Основной признак - диапазон номера поезда
+	if trainType, ok := domain.TrainTypeByNumber(train.Number); ok {
+		return trainType
+	}
+
+	// type != 0 в ответе РЖД означает пригородный поезд, typeEx уточняет экспресс
+	if train.Type != 0 {
+		if train.TypeEx != 0 {
+			return domain.ExpressSuburban
+		}
+		return domain.Suburban
+	}
+	return domain.Train
+}
@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+func TestMapTrainType(t *testing.T) {
+	tests := []struct {
+		name  string
+		train schemas.TrainList
+		want  domain.TrainType
+	}{
+		{"long distance", schemas.TrainList{Number: "119А"}, domain.Train},
+		{"high speed by number", schemas.TrainList{Number: "752А"}, domain.HighSpeed},
+		{"high speed by brand", schemas.TrainList{Number: "020У", Brand: "Сапсан"}, domain.HighSpeed},
+		{"suburban by number", schemas.TrainList{Number: "6001"}, domain.Suburban},
+		{"express suburban by number", schemas.TrainList{Number: "7001*"}, domain.ExpressSuburban},
+		{"comfort express suburban", schemas.TrainList{Number: "817М"}, domain.ExpressSuburban},
+		{"suburban by type", schemas.TrainList{Number: "Э", Type: 1}, domain.Suburban},
+		{"express suburban by typeEx", schemas.TrainList{Number: "Э", Type: 1, TypeEx: 1}, domain.ExpressSuburban},
+		{"unknown number", schemas.TrainList{Number: "999"}, domain.Train},
+		{"bus", schemas.TrainList{Number: "119А", Bus: true}, domain.Bus},
+		{"ferry", schemas.TrainList{Number: "752А", Boat: true}, domain.Ferry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, mapTrainType(tt.train))
+		})
+	}
+}
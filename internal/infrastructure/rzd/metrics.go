@@ -0,0 +1,49 @@
+package rzd
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	breakerStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rzd_circuit_breaker_state",
+		Help: "State of the RZD upstream circuit breaker: 0 - closed, 1 - half-open, 2 - open.",
+	}, []string{"endpoint"})
+
+	breakerTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rzd_circuit_breaker_transitions_total",
+		Help: "Number of RZD upstream circuit breaker state changes.",
+	}, []string{"endpoint", "state"})
+
+	staleResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rzd_stale_responses_total",
+		Help: "Number of cached responses served while the RZD upstream was unavailable.",
+	}, []string{"endpoint"})
+
+	contractChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rzd_contract_checks_total",
+		Help: "Number of RZD responses checked against the schema contract.",
+	}, []string{"endpoint"})
+
+	contractDriftResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rzd_contract_drift_responses_total",
+		Help: "Number of RZD responses that do not match the schema contract.",
+	}, []string{"endpoint"})
+
+	contractFields = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rzd_contract_drift_fields_total",
+		Help: "Number of unknown, missing required and changed fields in RZD responses.",
+	}, []string{"endpoint", "kind"})
+
+	budgetWaits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rzd_budget_waits_total",
+		Help: "Number of times an RZD request waited for the shared request budget.",
+	})
+)
+
+// recordBreakerState обновляет метрики при смене состояния предохранителя
+func recordBreakerState(endpoint string, state BreakerState) {
+	breakerStateGauge.WithLabelValues(endpoint).Set(float64(state))
+	breakerTransitions.WithLabelValues(endpoint, state.String()).Inc()
+}
@@ -1,15 +1,37 @@
 package rzd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
 )
 
-// RIDCache кэш RID для запросов к API РЖД
+// RIDCache кэш RID для запросов к API РЖД.
+// RID выдаётся под конкретный запрос (эндпоинт, язык и параметры), поэтому
+// хранится отдельно для каждого ключа, а не один на весь клиент.
+// При общем хранилище RID, полученный одной репликой, используют и остальные.
 type RIDCache struct {
-	RID       string
-	ExpiresAt time.Time
+	store state.Store
+}
+
+// NewRIDCache создаёт кэш RID поверх хранилища состояния
+func NewRIDCache(store state.Store) *RIDCache {
+	return &RIDCache{store: store}
+}
+
+// ridCacheKey формирует ключ кэша из метода, URL (включающего язык) и параметров запроса.
+// Параметр rid в ключ не входит.
+func ridCacheKey(req *http.Request, body []byte) string {
+	u := *req.URL
+	q := u.Query()
+	q.Del("rid")
+	u.RawQuery = q.Encode()
+	return fmt.Sprintf("%s %s\n%s", req.Method, u.String(), body)
 }
 
 // extractRID извлекает RID из ответа API
@@ -25,31 +47,26 @@ func extractRID(apiResponse map[string]interface{}) (string, error) {
 	return "", errors.New("rid not found in response")
 }
 
-// getCachedRID возвращает RID из кэша
-func (c *Client) getCachedRID() (string, bool) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
-	if c.RIDCache != nil && time.Now().Before(c.RIDCache.ExpiresAt) {
-		return c.RIDCache.RID, true
+// Get возвращает RID для ключа, если он ещё не истёк
+func (c *RIDCache) Get(ctx context.Context, key string) (string, bool) {
+	rid, ok, err := c.store.Get(ctx, state.HashKey("rid:", key))
+	if err != nil {
+		log.Printf("Failed to get RID from state store: %v", err)
+		return "", false
 	}
-	return "", false
+	return string(rid), ok
 }
 
-// updateRID обновляет RID в кэше c TTL
-func (c *Client) updateRID(rid string, ttl time.Duration) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
-	c.RIDCache = &RIDCache{
-		RID:       rid,
-		ExpiresAt: time.Now().Add(ttl),
+// Set сохраняет RID для ключа c TTL
+func (c *RIDCache) Set(ctx context.Context, key, rid string, ttl time.Duration) {
+	if err := c.store.Set(ctx, state.HashKey("rid:", key), []byte(rid), ttl); err != nil {
+		log.Printf("Failed to save RID to state store: %v", err)
 	}
 }
 
-func (c *Client) expireRID() {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
-	c.RIDCache = nil
+// Expire удаляет RID для ключа
+func (c *RIDCache) Expire(ctx context.Context, key string) {
+	if err := c.store.Delete(ctx, state.HashKey("rid:", key)); err != nil {
+		log.Printf("Failed to expire RID in state store: %v", err)
+	}
 }
@@ -0,0 +1,98 @@
+package rzd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func TestClientsShareState(t *testing.T) {
+	// РЖД выдаёт RID и cookie сессии, результат отдаёт только с ними
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("rid") == "" {
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "session", Path: "/"})
+			_, _ = w.Write([]byte(`{"result":"RID","RID":123456}`))
+			return
+		}
+		if cookie, err := r.Cookie("JSESSIONID"); err != nil || cookie.Value != "session" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"OK","tp":[{"list":[]}]}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  3,
+		RetryDelay:  time.Millisecond,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+	}
+
+	// Первая реплика получает RID, но попытки у неё заканчиваются раньше, чем она его использует
+	store := state.NewMemoryStore()
+	single := *cfg
+	single.MaxRetries = 1
+	first, err := NewRzdClient(&single, store)
+	require.NoError(t, err)
+	second, err := NewRzdClient(cfg, store)
+	require.NoError(t, err)
+
+	params := domain.GetTrainRoutesParams{
+		FromCode: 2004000,
+		ToCode:   2000000,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	}
+	_, err = first.GetTrainRoutes(context.Background(), params)
+	require.Error(t, err)
+
+	// Вторая реплика сразу идёт с RID и cookie первой
+	_, err = second.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, requests.Load())
+}
+
+func TestClientWaitsForBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"n":"МОСКВА","c":2000000,"S":5,"L":0}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  1,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+		Budget:      config.RZDBudget{RPS: 10, Burst: 1},
+	}, nil)
+	require.NoError(t, err)
+
+	// Бюджет 10 запросов в секунду: третий запрос ждёт не меньше двух окон по 100 мс
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.SearchStation(ctx, domain.SearchStationParams{Query: "МОСК"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
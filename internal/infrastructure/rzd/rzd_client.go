@@ -10,55 +10,60 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"net/http/cookiejar"
 	"net/http/httputil"
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/utils"
 
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/mappers"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/upstream"
 	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
 )
 
+var tracer = tracing.Tracer("rzd")
+
 // Client структура клиента
 type Client struct {
-	config     *config.RZD
-	HTTPClient *http.Client
-	Endpoints  Endpoints
+	config     atomic.Pointer[config.RZD]
+	HTTPClient *upstream.HTTPClient
+	Endpoints  Endpoints // Эндпоинты для языка по умолчанию
 	RIDCache   *RIDCache
+	TimeZones  *timezone.Resolver
 	mutex      sync.Mutex
+	endpoints  map[string]Endpoints // Эндпоинты по языкам, создаются по первому запросу
+	breakers   map[string]*CircuitBreaker
+	stale      *staleCache
+	budget     *state.Limiter
+	contract   *ContractMonitor
 }
 
-// NewRzdClient инициализирует новый экземпляр клиента RzdClient с конфигурацией
-func NewRzdClient(cfg *config.RZD) (*Client, error) {
-	transport := &http.Transport{}
-
-	if cfg.Proxy != "" {
-		proxyURL, err := url.Parse(cfg.Proxy)
-		if err != nil {
-			return nil, fmt.Errorf("invalid proxy URL: %v", err)
-		}
-		transport.Proxy = http.ProxyURL(proxyURL)
+// NewRzdClient инициализирует новый экземпляр клиента RzdClient с конфигурацией.
+// RID, cookie сессии, сохранённые ответы и бюджет запросов хранятся в store
+// (nil - в памяти процесса), чтобы реплики с общим хранилищем работали согласованно.
+func NewRzdClient(cfg *config.RZD, store state.Store) (*Client, error) {
+	if store == nil {
+		store = state.NewMemoryStore()
 	}
-
-	// Установка уровня логирования
-	// TODO добавить уровень логирования к запросам
-
-	// Создание CookieJar
-	jar, err := cookiejar.New(nil)
+	// Создание CookieJar, общего для реплик
+	jar, err := state.NewCookieJar(store, "cookies:")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create CookieJar: %v", err)
+		return nil, err
 	}
 
-	httpClient := &http.Client{
-		Timeout:   time.Duration(cfg.Timeout) * time.Second,
-		Transport: transport,
-		Jar:       jar,
+	httpClient, err := upstream.NewHTTPClient(cfg.Proxy, jar)
+	if err != nil {
+		return nil, err
 	}
 
 	endpoints, err := NewEndpoints(cfg.BasePath, cfg.Language)
@@ -66,77 +71,232 @@ func NewRzdClient(cfg *config.RZD) (*Client, error) {
 		return nil, fmt.Errorf("failed to create endpoints: %v", err)
 	}
 
+	// Таблица часовых поясов станций: встроенная и, при наличии, из файла
+	zones, err := timezone.NewResolver()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create time zone resolver: %v", err)
+	}
+	if cfg.TimeZonesFile != "" {
+		if err := zones.LoadFile(cfg.TimeZonesFile); err != nil {
+			return nil, fmt.Errorf("failed to load time zones: %v", err)
+		}
+	}
+
 	// Инициализация клиента
 	client := &Client{
-		config:     cfg,
 		HTTPClient: httpClient,
 		Endpoints:  endpoints,
+		RIDCache:   NewRIDCache(store),
+		TimeZones:  zones,
+		endpoints:  map[string]Endpoints{cfg.Language: endpoints},
+		breakers:   make(map[string]*CircuitBreaker),
+		stale:      newStaleCache(store),
+		budget:     state.NewLimiter(store, "budget:"),
+		contract:   NewContractMonitor(),
+	}
+	for _, name := range []string{breakerRoutes, breakerCarriages, breakerSuggester, breakerSchedule} {
+		client.breakers[name] = NewCircuitBreaker(name, cfg.Breaker.FailureThreshold, cfg.Breaker.OpenTimeout, onBreakerStateChange)
+		breakerStateGauge.WithLabelValues(name).Set(float64(BreakerClosed))
 	}
+	client.config.Store(cfg)
 
 	return client, nil
 }
 
+// ApplyConfig применяет новую конфигурацию без пересоздания клиента.
+// На лету меняются прокси, таймауты, повторы, время жизни RID, предохранители, бюджет запросов и режим отладки;
+// базовый адрес, язык по умолчанию и таблица поясов остаются прежними.
+func (c *Client) ApplyConfig(cfg *config.RZD) error {
+	if err := c.HTTPClient.SetProxy(cfg.Proxy); err != nil {
+		return err
+	}
+	current := *c.cfg()
+	current.Proxy = cfg.Proxy
+	current.Timeout = cfg.Timeout
+	current.RetryDelay = cfg.RetryDelay
+	current.MaxRetries = cfg.MaxRetries
+	current.RIDLifetime = cfg.RIDLifetime
+	current.DebugMode = cfg.DebugMode
+	current.UserAgent = cfg.UserAgent
+	current.Breaker = cfg.Breaker
+	current.DecodeMode = cfg.DecodeMode
+	c.config.Store(&current)
+	for _, breaker := range c.breakers {
+		breaker.Configure(cfg.Breaker.FailureThreshold, cfg.Breaker.OpenTimeout)
+	}
+	return nil
+}
+
+// onBreakerStateChange логирует смену состояния предохранителя и обновляет метрики
+func onBreakerStateChange(endpoint string, state BreakerState) {
+	log.Printf("RZD %s circuit breaker is %s", endpoint, state)
+	recordBreakerState(endpoint, state)
+}
+
+// BreakerStates возвращает состояние предохранителя каждого эндпоинта РЖД
+func (c *Client) BreakerStates() map[string]string {
+	states := make(map[string]string, len(c.breakers))
+	for name, breaker := range c.breakers {
+		states[name] = breaker.State().String()
+	}
+	return states
+}
+
+// SchemaDrift возвращает последние расхождения ответов эндпоинта (пустой - всех) с контрактом схем
+func (c *Client) SchemaDrift(endpoint string, limit int) []domain.SchemaDriftEvent {
+	return c.contract.Events(endpoint, limit)
+}
+
+// cfg возвращает действующую конфигурацию клиента
+func (c *Client) cfg() *config.RZD {
+	return c.config.Load()
+}
+
+// Name возвращает имя провайдера данных
+func (c *Client) Name() string {
+	return "rzd"
+}
+
+// language возвращает язык запроса или язык по умолчанию из конфигурации
+func (c *Client) language(lang string) string {
+	if lang == "" {
+		return c.cfg().Language
+	}
+	return lang
+}
+
+// endpointsFor возвращает эндпоинты для языка, создавая их при первом обращении
+func (c *Client) endpointsFor(lang string) (Endpoints, error) {
+	lang = c.language(lang)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if endpoints, ok := c.endpoints[lang]; ok {
+		return endpoints, nil
+	}
+	endpoints, err := NewEndpoints(c.cfg().BasePath, lang)
+	if err != nil {
+		return Endpoints{}, fmt.Errorf("failed to create endpoints for language %q: %v", lang, err)
+	}
+	c.endpoints[lang] = endpoints
+	return endpoints, nil
+}
+
+// Ping проверяет доступность РЖД: любой ответ, кроме 5xx, считается признаком доступности
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.cfg().BasePath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", c.cfg().UserAgent)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("upstream returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // executeRequest выполняет HTTP-запрос и обрабатывает ответ, включая обработку RID.
-func (c *Client) executeRequest(req *http.Request) ([]byte, error) {
+// Каждая попытка проходит через предохранитель эндпоинта: при разомкнутом предохранителе
+// повторы прекращаются, а вместо ошибки отдаётся сохранённый ответ, если он есть.
+// Вызов записывается в спан "rzd.<endpoint>", каждая попытка - в дочерний спан "rzd.attempt".
+func (c *Client) executeRequest(req *http.Request, endpoint string) (_ []byte, err error) {
+	cfg := c.cfg()
+	breaker := c.breakers[endpoint]
 	var lastError error
+	breakerOpen := false
+
+	ctx, span := tracer.Start(req.Context(), "rzd."+endpoint, trace.WithAttributes(tracing.AttrEndpoint.String(endpoint)))
+	req = req.WithContext(ctx)
+	var attemptSpan trace.Span
+	endAttempt := func(err error) {
+		if attemptSpan != nil {
+			tracing.End(attemptSpan, err)
+			attemptSpan = nil
+		}
+	}
+	defer func() {
+		endAttempt(err)
+		tracing.End(span, err)
+	}()
+
+	// Сохранение тела запроса для повторных попыток
+	var reqBodyBytes []byte
+	if req.Body != nil {
+		reqBodyBytes, _ = io.ReadAll(req.Body)
+	}
+	ridKey := ridCacheKey(req, reqBodyBytes)
 
-	for attempt := 1; attempt <= c.config.MaxRetries; attempt++ {
+	for attempt := 1; attempt <= cfg.MaxRetries; attempt++ {
+		endAttempt(lastError)
+		if err := c.waitBudget(ctx, cfg); err != nil {
+			return nil, err
+		}
+		if err := breaker.Allow(); err != nil {
+			lastError = err
+			breakerOpen = true
+			break
+		}
+		_, attemptSpan = tracer.Start(ctx, "rzd.attempt", trace.WithAttributes(
+			tracing.AttrEndpoint.String(endpoint),
+			tracing.AttrAttempt.Int(attempt),
+		))
 		log.Printf("Executing request: %s %s (Attempt %d)", req.Method, req.URL.String(), attempt)
 
-		// Сохранение тела запроса для повторных попыток
-		var reqBodyBytes []byte
 		if req.Body != nil {
-			reqBodyBytes, _ = io.ReadAll(req.Body)
 			req.Body = io.NopCloser(bytes.NewBuffer(reqBodyBytes))
 		}
 
-		// Попытка использовать закэшированный RID
-		if rid, valid := c.getCachedRID(); valid {
+		// Попытка использовать закэшированный RID этого запроса
+		if rid, valid := c.RIDCache.Get(ctx, ridKey); valid {
 			q := req.URL.Query()
 			q.Set("rid", rid)
 			req.URL.RawQuery = q.Encode()
+			attemptSpan.SetAttributes(tracing.AttrRIDCached.Bool(true))
 			log.Printf("Using cached RID: %s", rid)
 		}
 
-		resp, err := c.HTTPClient.Do(req)
+		statusCode, body, err := c.roundTrip(req, cfg)
 		if err != nil {
 			log.Printf("Request failed: %v", err)
-			lastError = err
+			lastError = unavailableError(err)
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				// Отмена клиентом не говорит о состоянии РЖД
+				breaker.Release()
+				return nil, ctxErr
+			}
+			breaker.Failure()
 			continue
 		}
-
-		// Логирование ответа
-		respDump, err := httputil.DumpResponse(resp, true)
-		if err != nil {
-			log.Printf("Failed to dump response: %v", err)
+		attemptSpan.SetAttributes(tracing.AttrStatus.Int(statusCode))
+		if statusCode >= http.StatusInternalServerError {
+			breaker.Failure()
 		} else {
-			log.Printf("Response dump:\n%s", string(respDump))
+			breaker.Success()
 		}
 
-		if resp.StatusCode != http.StatusOK {
-			log.Printf("Non-200 response: %d", resp.StatusCode)
-			err := resp.Body.Close()
-			if err != nil {
-				return nil, err
+		if statusCode != http.StatusOK {
+			log.Printf("Non-200 response: %d", statusCode)
+			lastError = fmt.Errorf("received non-200 response: %d", statusCode)
+			if statusCode >= http.StatusInternalServerError {
+				lastError = unavailableError(lastError)
 			}
-			lastError = fmt.Errorf("received non-200 response: %d", resp.StatusCode)
-			continue
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			log.Printf("Failed to read response body: %v", err)
-			lastError = err
 			continue
 		}
-		if err := resp.Body.Close(); err != nil {
-			return nil, err
-		}
 
 		// Если ответ начинается с "[", значит это JSON-массив, и проверка поля "result" не требуется.
 		trimmedBody := strings.TrimSpace(string(body))
 		if strings.HasPrefix(trimmedBody, "[") {
-			c.expireRID() // Сброс RID после успешного запроса.
+			c.RIDCache.Expire(ctx, ridKey) // Сброс RID после успешного запроса.
+			c.stale.Set(ctx, ridKey, body, cfg.Breaker.StaleTTL)
 			return body, nil
 		}
 
@@ -148,8 +308,18 @@ func (c *Client) executeRequest(req *http.Request) ([]byte, error) {
 			continue
 		}
 
+		// Ответ расписания (basicRoute) приходит без поля "result", не использует RID и является окончательным.
+		// У остальных эндпоинтов отсутствие "result" - неожиданный ответ.
+		rawResult, hasResult := apiResponse["result"]
+		if !hasResult && endpoint == breakerSchedule {
+			c.RIDCache.Expire(ctx, ridKey)
+			c.stale.Set(ctx, ridKey, body, cfg.Breaker.StaleTTL)
+			return body, nil
+		}
+
 		// Если в объекте есть поле "result", работаем с ним.
-		result, _ := apiResponse["result"].(string)
+		result, _ := rawResult.(string)
+		attemptSpan.SetAttributes(tracing.AttrResult.String(result))
 		if result == "RID" || result == "REQUEST_ID" {
 			rid, err := extractRID(apiResponse)
 			if err != nil {
@@ -157,10 +327,13 @@ func (c *Client) executeRequest(req *http.Request) ([]byte, error) {
 				lastError = err
 				continue
 			}
-			c.updateRID(rid, time.Duration(c.config.RIDLifetime)*time.Millisecond)
+			c.RIDCache.Set(ctx, ridKey, rid, cfg.RIDLifetime)
+			attemptSpan.SetAttributes(tracing.AttrRIDIssued.Bool(true))
 			log.Printf("Received RID: %s", rid)
 			// Задержка перед повторным запросом.
-			time.Sleep(time.Duration(c.config.Timeout) * time.Millisecond)
+			if err := sleepContext(req.Context(), cfg.RetryDelay); err != nil {
+				return nil, err
+			}
 			lastError = nil
 			continue
 		}
@@ -171,17 +344,117 @@ func (c *Client) executeRequest(req *http.Request) ([]byte, error) {
 				log.Printf("API returned error: %s", msg)
 				return nil, errors.New(msg)
 			}
-			c.expireRID()
+			c.RIDCache.Expire(ctx, ridKey)
+			c.stale.Set(ctx, ridKey, body, cfg.Breaker.StaleTTL)
 			return body, nil
 		}
 
 		// Обработка других результатов
-		log.Printf("Unexpected result field: %s", result)
-		lastError = fmt.Errorf("unexpected result field: %s", result)
-		time.Sleep(time.Duration(c.config.Timeout) * time.Millisecond)
+		log.Printf("Unexpected result field: %q", result)
+		lastError = fmt.Errorf("unexpected result field: %q", result)
+		if err := sleepContext(req.Context(), cfg.RetryDelay); err != nil {
+			return nil, err
+		}
 	}
 
-	return nil, fmt.Errorf("failed after %d attempts: %v", c.config.MaxRetries, lastError)
+	// РЖД недоступен: отдаём последний успешный ответ на тот же запрос, если он не устарел
+	endAttempt(lastError)
+	if body, age, ok := c.stale.Get(ctx, ridKey, cfg.Breaker.StaleTTL); ok {
+		log.Printf("Serving stale %s response (age %s): %v", endpoint, age.Round(time.Second), lastError)
+		staleResponses.WithLabelValues(endpoint).Inc()
+		span.SetAttributes(tracing.AttrStale.Bool(true))
+		return body, nil
+	}
+	if breakerOpen {
+		return nil, lastError
+	}
+	return nil, fmt.Errorf("failed after %d attempts: %w", cfg.MaxRetries, lastError)
+}
+
+// unavailableError помечает сетевую ошибку или ответ 5xx как недоступность РЖД (domain.ErrUpstreamUnavailable).
+// Адрес запроса из *url.Error в текст не попадает: ошибка уходит клиентам gRPC.
+func unavailableError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	return fmt.Errorf("%w: %v", domain.ErrUpstreamUnavailable, err)
+}
+
+// waitBudget ждёт, пока бюджет запросов к РЖД (RZD.BUDGET), общий для реплик, позволит сделать запрос.
+// Если хранилище недоступно, запрос выполняется без ограничения.
+func (c *Client) waitBudget(ctx context.Context, cfg *config.RZD) error {
+	for {
+		allowed, wait, err := c.budget.Allow(ctx, "rzd", cfg.Budget.RPS, cfg.Budget.Burst)
+		if err != nil {
+			log.Printf("Failed to check RZD request budget: %v", err)
+			return nil
+		}
+		if allowed {
+			return nil
+		}
+		budgetWaits.Inc()
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// roundTrip выполняет одну попытку запроса с таймаутом из конфигурации и читает тело ответа
+func (c *Client) roundTrip(req *http.Request, cfg *config.RZD) (int, []byte, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), cfg.Timeout)
+	defer cancel()
+
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	// Логирование ответа в режиме отладки
+	if cfg.DebugMode {
+		respDump, err := httputil.DumpResponse(resp, true)
+		if err != nil {
+			log.Printf("Failed to dump response: %v", err)
+		} else {
+			log.Printf("Response dump:\n%s", string(respDump))
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// sleepContext ждёт d или отмены контекста
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// decode разбирает ответ эндпоинта в схему в режиме из конфигурации (RZD.DECODE_MODE)
+// и передаёт расхождения с контрактом схемы в ContractMonitor.
+func (c *Client) decode(body []byte, v interface{}, endpoint string) error {
+	mode := schemas.DecodeLenient
+	if c.cfg().DecodeMode == config.DecodeStrict {
+		mode = schemas.DecodeStrict
+	}
+	drift, err := schemas.Decode(body, v, mode)
+	if err == nil || errors.Is(err, schemas.ErrSchemaDrift) {
+		c.contract.Record(endpoint, drift, body)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", endpoint, err)
+	}
+	return nil
 }
 
 // getErrorMessage извлекает сообщение об ошибке из ответа API, если оно присутствует
@@ -201,17 +474,22 @@ func getErrorMessage(apiResponse map[string]interface{}) (string, bool) {
 }
 
 // GetTrainRoutes получает маршруты поездов в одну точку
-func (c *Client) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+func (c *Client) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
 	data := url.Values{}
 	data.Set("code0", fmt.Sprintf("%d", params.FromCode))
 	data.Set("code1", fmt.Sprintf("%d", params.ToCode))
 	data.Set("dir", fmt.Sprintf("%d", params.Direction))
 	data.Set("tfl", fmt.Sprintf("%d", params.TrainType))
 	data.Set("checkSeats", utils.BoolToString(params.CheckSeats))
+	// Календарная дата запроса как есть: пояс станции важен только для времени в ответе
 	data.Set("dt0", params.FromDate.Format("02.01.2006"))
 	data.Set("md", utils.BoolToString(params.WithChange))
 
-	req, err := http.NewRequest("POST", c.Endpoints.TrainRoutes, strings.NewReader(data.Encode()))
+	endpoints, err := c.endpointsFor(params.Language)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoints.TrainRoutes, strings.NewReader(data.Encode()))
 	if err != nil {
 		log.Printf("Failed to create request: %v", err)
 		return nil, err
@@ -220,20 +498,20 @@ func (c *Client) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesP
 	// Установка заголовков
 	SetHeaders(req, c)
 
-	responseBody, err := c.executeRequest(req)
+	responseBody, err := c.executeRequest(req, breakerRoutes)
 	if err != nil {
 		log.Printf("Failed to get train routes: %v", err)
 		return nil, err
 	}
 
 	var schemaResp schemas.TrainRouteResponse
-	if err := json.Unmarshal(responseBody, &schemaResp); err != nil {
+	if err := c.decode(responseBody, &schemaResp, breakerRoutes); err != nil {
 		log.Printf("Failed to unmarshal train routes: %v", err)
 		return nil, err
 	}
 
 	// Используем маппер для преобразования схемы в доменные модели
-	domainRoutes, err := mappers.MapTrainRouteResponse(schemaResp)
+	domainRoutes, err := mappers.MapTrainRouteResponse(schemaResp, c.TimeZones)
 	if err != nil {
 		log.Printf("Failed to map train routes: %v", err)
 		return nil, err
@@ -243,16 +521,22 @@ func (c *Client) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesP
 }
 
 // GetTrainCarriages получает список вагонов выбранного поезда
-func (c *Client) GetTrainCarriages(_ context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+func (c *Client) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
 	data := url.Values{}
 	data.Set("code0", fmt.Sprintf("%d", params.FromCode))
 	data.Set("code1", fmt.Sprintf("%d", params.ToCode))
 	data.Set("tnum0", params.TrainNumber)
-	data.Set("time0", params.FromTime.Format("15:04"))
-	data.Set("dt0", params.FromTime.Format("02.01.2006"))
+	// РЖД ожидает местное время отправления, а не UTC
+	fromTime := params.FromTime.In(c.TimeZones.Resolve(params.FromCode))
+	data.Set("time0", fromTime.Format("15:04"))
+	data.Set("dt0", fromTime.Format("02.01.2006"))
 	data.Set("dir", fmt.Sprintf("%d", params.Direction))
 
-	req, err := http.NewRequest("POST", c.Endpoints.TrainCarriages, strings.NewReader(data.Encode()))
+	endpoints, err := c.endpointsFor(params.Language)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoints.TrainCarriages, strings.NewReader(data.Encode()))
 	if err != nil {
 		log.Printf("Failed to create request: %v", err)
 		return nil, err
@@ -261,14 +545,14 @@ func (c *Client) GetTrainCarriages(_ context.Context, params domain.GetTrainCarr
 	// Установка заголовков
 	SetHeaders(req, c)
 
-	responseBody, err := c.executeRequest(req)
+	responseBody, err := c.executeRequest(req, breakerCarriages)
 	if err != nil {
 		log.Printf("Failed to get train carriages: %v", err)
 		return nil, err
 	}
 
 	var schemaResp schemas.TrainCarriagesResponse
-	if err := json.Unmarshal(responseBody, &schemaResp); err != nil {
+	if err := c.decode(responseBody, &schemaResp, breakerCarriages); err != nil {
 		log.Printf("Failed to unmarshal train carriages: %v", err)
 		return nil, err
 	}
@@ -283,18 +567,57 @@ func (c *Client) GetTrainCarriages(_ context.Context, params domain.GetTrainCarr
 	return domainResp, nil
 }
 
+// FindTrain находит поезд по номеру и дате отправления с начальной станции (basicRoute)
+// и возвращает его маршрут со всеми остановками
+func (c *Client) FindTrain(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	moscowDate := time.Date(params.Date.Year(), params.Date.Month(), params.Date.Day(), 0, 0, 0, 0, c.TimeZones.Moscow())
+	data := url.Values{}
+	data.Set("STRUCTURE_ID", fmt.Sprintf("%d", StationsStructureID))
+	data.Set("refererPageId", fmt.Sprintf("%d", BasicRoutePageID))
+	data.Set("trainNumber", params.TrainNumber)
+	data.Set("depDate", moscowDate.Format("02.01.2006"))
+
+	endpoints, err := c.endpointsFor(params.Language)
+	if err != nil {
+		return domain.TrainSchedule{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoints.TrainStationList, nil)
+	if err != nil {
+		log.Printf("Failed to create request: %v", err)
+		return domain.TrainSchedule{}, err
+	}
+	req.URL.RawQuery = data.Encode()
+
+	// Установка заголовков
+	SetHeaders(req, c)
+
+	responseBody, err := c.executeRequest(req, breakerSchedule)
+	if err != nil {
+		log.Printf("Failed to get train schedule: %v", err)
+		return domain.TrainSchedule{}, err
+	}
+
+	var schemaResp schemas.TrainScheduleResponse
+	if err := c.decode(responseBody, &schemaResp, breakerSchedule); err != nil {
+		log.Printf("Failed to unmarshal train schedule: %v", err)
+		return domain.TrainSchedule{}, err
+	}
+
+	return mappers.MapTrainScheduleResponse(schemaResp, params.TrainNumber, moscowDate, c.TimeZones)
+}
+
 // SearchStation получает список станций, коды которых содержат подстроку запроса.
 // Остальные поля ответа игнорируются.
-func (c *Client) SearchStation(_ context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+func (c *Client) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
 	// Формирование параметров запроса.
 	data := url.Values{}
 	data.Set("stationNamePart", params.Query)
 	data.Set("compactMode", utils.BoolToYesNoLowerCase(params.CompactMode))
-	// Добавляем язык из конфигурации
-	data.Set("lang", c.config.Language)
+	// Язык из запроса или из конфигурации
+	data.Set("lang", c.language(params.Language))
 
 	// Создаем GET-запрос к эндпоинту для поиска станций.
-	req, err := http.NewRequest("GET", c.Endpoints.StationCode, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Endpoints.StationCode, nil)
 	if err != nil {
 		log.Printf("Failed to create request: %v", err)
 		return nil, err
@@ -305,7 +628,7 @@ func (c *Client) SearchStation(_ context.Context, params domain.SearchStationPar
 	SetHeaders(req, c)
 
 	// Выполняем запрос
-	responseBody, err := c.executeRequest(req)
+	responseBody, err := c.executeRequest(req, breakerSuggester)
 	if err != nil {
 		log.Printf("Failed to get station codes: %v", err)
 		return nil, err
@@ -313,7 +636,7 @@ func (c *Client) SearchStation(_ context.Context, params domain.SearchStationPar
 
 	// Десериализуем ответ в схему.
 	var schemaResp schemas.StationCodeResponse
-	if err := json.Unmarshal(responseBody, &schemaResp); err != nil {
+	if err := c.decode(responseBody, &schemaResp, breakerSuggester); err != nil {
 		log.Printf("Failed to unmarshal station codes: %v", err)
 		return nil, err
 	}
@@ -0,0 +1,134 @@
+package rzd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func TestClientFindTrain(t *testing.T) {
+	var depDate atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("trainNumber") != "010Э" {
+			_, _ = w.Write([]byte(`{"data":{"routes":[]}}`))
+			return
+		}
+		depDate.Store(r.URL.Query().Get("depDate"))
+		_, _ = w.Write([]byte(`{"data":{"trainInfo":{"number":"010Э"},"routes":[
+			{"station":"МОСКВА ЯРОСЛАВСКАЯ","code":2000002,"depTime":"21:50","distance":0},
+			{"station":"КИРОВ","code":2060600,"arvTime":"08:50","depTime":"09:10","waitingTime":20,"distance":957},
+			{"station":"ЕКАТЕРИНБУРГ-ПАСС.","code":"2030000","arvTime":"20:20","distance":"1814"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  1,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+	}, nil)
+	require.NoError(t, err)
+
+	schedule, err := client.FindTrain(context.Background(), domain.FindTrainParams{
+		TrainNumber: "010Э",
+		Date:        time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "13.02.2025", depDate.Load())
+	require.Len(t, schedule.Stops, 3)
+
+	// Время по Москве переходит через полночь, время прибытия в Екатеринбург - местное (+2 часа)
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	require.True(t, schedule.Stops[0].Arrival.IsZero())
+	require.Equal(t, time.Date(2025, 2, 13, 21, 50, 0, 0, moscow), schedule.Stops[0].Departure)
+	require.Equal(t, time.Date(2025, 2, 14, 9, 10, 0, 0, moscow), schedule.Stops[1].Departure)
+	require.Equal(t, 20*time.Minute, schedule.Stops[1].Stay)
+	arrival := schedule.Stops[2].Arrival
+	require.Equal(t, "Asia/Yekaterinburg", arrival.Location().String())
+	require.Equal(t, "2025-02-14 22:20", arrival.Format("2006-01-02 15:04"))
+	require.Equal(t, 1814, schedule.Stops[2].Distance)
+
+	require.Equal(t, "010Э", schedule.Route.TrainNumber)
+	require.Equal(t, 2000002, schedule.Route.From.Code)
+	require.Equal(t, 2030000, schedule.Route.To.Code)
+	require.Equal(t, 22*time.Hour+30*time.Minute, schedule.Route.Duration)
+
+	_, err = client.FindTrain(context.Background(), domain.FindTrainParams{TrainNumber: "999", Date: time.Now()})
+	require.ErrorIs(t, err, domain.ErrTrainNotFound)
+}
+
+// Ответ без поля "result" окончателен только для расписания: маршруты без него не принимаются
+func TestClientRequiresResultOutsideSchedule(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"tp":[{"list":[]}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  2,
+		RetryDelay:  time.Millisecond,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2004000,
+		ToCode:   2000000,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	})
+	require.ErrorContains(t, err, `unexpected result field: ""`)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+// Дата маршрутов - календарная: московская полночь для Калининграда (UTC+2) не превращается во вчера,
+// как и дата поиска поезда по номеру
+func TestClientSendsCalendarDate(t *testing.T) {
+	var dt0, depDate atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if date := r.URL.Query().Get("depDate"); date != "" {
+			depDate.Store(date)
+			_, _ = w.Write([]byte(`{"data":{"routes":[]}}`))
+			return
+		}
+		_ = r.ParseForm()
+		dt0.Store(r.PostForm.Get("dt0"))
+		_, _ = w.Write([]byte(`{"result":"OK","tp":[{"list":[]}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  1,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+	}, nil)
+	require.NoError(t, err)
+
+	date := time.Date(2025, 2, 13, 0, 0, 0, 0, client.TimeZones.Moscow())
+	_, err = client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 2058000, ToCode: 2000000, FromDate: date})
+	require.NoError(t, err)
+	require.Equal(t, "13.02.2025", dt0.Load())
+
+	_, err = client.FindTrain(context.Background(), domain.FindTrainParams{TrainNumber: "029Ч", Date: date})
+	require.ErrorIs(t, err, domain.ErrTrainNotFound)
+	require.Equal(t, "13.02.2025", depDate.Load())
+}
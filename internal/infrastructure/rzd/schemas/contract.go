@@ -0,0 +1,243 @@
+// internal/infrastructure/rzd/schemas/contract.go
+package schemas
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Контракт ответа РЖД описывается JSON Schema, выведенной из типов этого пакета:
+// поля структур - свойства объекта, лишние свойства запрещены, обязательные поля
+// отмечаются тегом contract:"required". Обязательными отмечены только поля, без которых
+// мапперы не могут построить доменную модель (коды станций, время, тарифы и т.п.).
+
+// JSONSchema подмножество JSON Schema, достаточное для описания ответов РЖД
+type JSONSchema struct {
+	Type                 []string               `json:"type,omitempty"` // Пустой - любое значение
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *AdditionalProperties  `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+
+	flex flexChecker // Дополнительная проверка значений гибких типов (FlexInt и др.)
+}
+
+// AdditionalProperties значение additionalProperties: false запрещает лишние свойства
+// объекта, а схема описывает значения словаря
+type AdditionalProperties struct {
+	Schema *JSONSchema // nil - лишние свойства запрещены
+}
+
+// MarshalJSON сериализует запрет как false, а схему значений - как вложенную схему
+func (a AdditionalProperties) MarshalJSON() ([]byte, error) {
+	if a.Schema == nil {
+		return []byte("false"), nil
+	}
+	return json.Marshal(a.Schema)
+}
+
+var (
+	schemaCache     sync.Map // reflect.Type -> *JSONSchema
+	flexCheckerType = reflect.TypeOf((*flexChecker)(nil)).Elem()
+	flexTypes       = map[reflect.Type][]string{
+		reflect.TypeOf(FlexInt(0)):     {"integer", "string", "null"},
+		reflect.TypeOf(FlexFloat(0)):   {"number", "string", "null"},
+		reflect.TypeOf(FlexString("")): {"string", "number", "null"},
+	}
+)
+
+// SchemaOf возвращает JSON Schema для значения схемы (или указателя на него)
+func SchemaOf(v interface{}) *JSONSchema {
+	return schemaFor(reflect.TypeOf(v))
+}
+
+func schemaFor(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cached, ok := schemaCache.Load(t); ok {
+		return cached.(*JSONSchema)
+	}
+	schema := buildSchema(t)
+	schemaCache.Store(t, schema)
+	return schema
+}
+
+func buildSchema(t reflect.Type) *JSONSchema {
+	if t.Implements(flexCheckerType) {
+		return &JSONSchema{Type: flexTypes[t], flex: reflect.Zero(t).Interface().(flexChecker)}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		schema := &JSONSchema{Type: []string{"object"}, Properties: map[string]*JSONSchema{}, AdditionalProperties: &AdditionalProperties{}}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, ok := jsonFieldName(field)
+			if !ok {
+				continue
+			}
+			schema.Properties[name] = schemaFor(field.Type)
+			if field.Tag.Get("contract") == "required" {
+				schema.Required = append(schema.Required, name)
+			}
+		}
+		return schema
+	case reflect.Slice, reflect.Array:
+		return &JSONSchema{Type: []string{"array"}, Items: schemaFor(t.Elem())}
+	case reflect.Map:
+		return &JSONSchema{Type: []string{"object"}, AdditionalProperties: &AdditionalProperties{Schema: schemaFor(t.Elem())}}
+	case reflect.String:
+		return &JSONSchema{Type: []string{"string"}}
+	case reflect.Bool:
+		return &JSONSchema{Type: []string{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: []string{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: []string{"number"}}
+	default:
+		return &JSONSchema{}
+	}
+}
+
+// jsonFieldName возвращает имя поля в JSON по тегу json, как его понимает encoding/json
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// Validate сверяет разобранный с json.Number JSON со схемой
+func (s *JSONSchema) Validate(raw interface{}) Drift {
+	v := validator{seen: map[string]bool{}}
+	v.validate(s, raw, "")
+	sort.Strings(v.drift.Unknown)
+	sort.Strings(v.drift.Missing)
+	sort.Slice(v.drift.Changed, func(i, j int) bool { return v.drift.Changed[i].Path < v.drift.Changed[j].Path })
+	return v.drift
+}
+
+// validator собирает расхождения с контрактом.
+// Каждый путь учитывается один раз, даже если встречается во всех элементах массива.
+type validator struct {
+	drift Drift
+	seen  map[string]bool
+}
+
+func (v *validator) once(kind, path string) bool {
+	key := kind + " " + path
+	if v.seen[key] {
+		return false
+	}
+	v.seen[key] = true
+	return true
+}
+
+func (v *validator) changed(path, reason string) {
+	if v.once("changed", path) {
+		v.drift.Changed = append(v.drift.Changed, FieldChange{Path: path, Reason: reason})
+	}
+}
+
+func (v *validator) validate(schema *JSONSchema, raw interface{}, path string) {
+	// null допустим для любого поля: encoding/json оставляет нулевое значение
+	if raw == nil || len(schema.Type) == 0 {
+		return
+	}
+	if schema.flex != nil {
+		if err := schema.flex.check(raw); err != nil {
+			v.changed(path, err.Error())
+		}
+		return
+	}
+
+	switch schema.Type[0] {
+	case "object":
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			v.changed(path, "expected object, got "+describeRaw(raw))
+			return
+		}
+		v.validateObject(schema, object, path)
+	case "array":
+		items, ok := raw.([]interface{})
+		if !ok {
+			v.changed(path, "expected array, got "+describeRaw(raw))
+			return
+		}
+		for _, item := range items {
+			v.validate(schema.Items, item, path+"[]")
+		}
+	case "string":
+		if _, ok := raw.(string); !ok {
+			v.changed(path, "expected string, got "+describeRaw(raw))
+		}
+	case "boolean":
+		if _, ok := raw.(bool); !ok {
+			v.changed(path, "expected bool, got "+describeRaw(raw))
+		}
+	case "integer":
+		number, ok := raw.(json.Number)
+		if _, err := number.Int64(); !ok || err != nil {
+			v.changed(path, "expected integer, got "+describeRaw(raw))
+		}
+	case "number":
+		if _, ok := raw.(json.Number); !ok {
+			v.changed(path, "expected number, got "+describeRaw(raw))
+		}
+	}
+}
+
+// validateObject сверяет свойства объекта со схемой.
+// Как и encoding/json, имена свойств сравниваются без учёта регистра.
+// Значения словаря сверяются со схемой additionalProperties под путём "<путь>{}".
+func (v *validator) validateObject(schema *JSONSchema, object map[string]interface{}, path string) {
+	if schema.Properties == nil {
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			for _, value := range object {
+				v.validate(schema.AdditionalProperties.Schema, value, path+"{}")
+			}
+		}
+		return
+	}
+	properties := make(map[string]*JSONSchema, len(schema.Properties))
+	for name, property := range schema.Properties {
+		properties[strings.ToLower(name)] = property
+	}
+	present := make(map[string]bool, len(object))
+	for key, value := range object {
+		present[strings.ToLower(key)] = value != nil
+		property, ok := properties[strings.ToLower(key)]
+		if !ok {
+			if v.once("unknown", joinPath(path, key)) {
+				v.drift.Unknown = append(v.drift.Unknown, joinPath(path, key))
+			}
+			continue
+		}
+		v.validate(property, value, joinPath(path, key))
+	}
+	for _, name := range schema.Required {
+		if !present[strings.ToLower(name)] && v.once("missing", joinPath(path, name)) {
+			v.drift.Missing = append(v.drift.Missing, joinPath(path, name))
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
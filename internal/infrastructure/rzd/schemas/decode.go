@@ -0,0 +1,78 @@
+// internal/infrastructure/rzd/schemas/decode.go
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DecodeMode определяет реакцию Decode на расхождения ответа со схемой
+type DecodeMode int
+
+const (
+	// DecodeLenient разбирает ответ несмотря на расхождения и только сообщает о них
+	DecodeLenient DecodeMode = iota
+	// DecodeStrict возвращает ошибку при любом неизвестном или отсутствующем обязательном поле
+	// и при значении неожиданного типа
+	DecodeStrict
+)
+
+// ErrSchemaDrift возвращается Decode в строгом режиме, если ответ не соответствует схеме
+var ErrSchemaDrift = errors.New("response does not match schema")
+
+// Drift описывает расхождения ответа РЖД со схемой.
+// Пути полей записываются без индексов массивов, например "tp[].list[].cars[].tariff".
+type Drift struct {
+	Unknown []string      // Поля ответа, которых нет в схеме
+	Missing []string      // Обязательные поля схемы, которых нет в ответе
+	Changed []FieldChange // Поля, значение которых не удалось разобрать в тип схемы
+}
+
+// FieldChange поле, тип значения которого изменился
+type FieldChange struct {
+	Path   string
+	Reason string // Например, `expected number, got string "2533р"`
+}
+
+// Empty сообщает, что расхождений нет
+func (d Drift) Empty() bool {
+	return len(d.Unknown) == 0 && len(d.Missing) == 0 && len(d.Changed) == 0
+}
+
+// String возвращает краткое описание расхождений для журнала
+func (d Drift) String() string {
+	var parts []string
+	if len(d.Unknown) > 0 {
+		parts = append(parts, "unknown fields: "+strings.Join(d.Unknown, ", "))
+	}
+	if len(d.Missing) > 0 {
+		parts = append(parts, "missing fields: "+strings.Join(d.Missing, ", "))
+	}
+	for _, change := range d.Changed {
+		parts = append(parts, change.Path+": "+change.Reason)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Decode разбирает ответ РЖД в v (указатель на схему) и сверяет его с контрактом SchemaOf(v).
+// Синтаксические ошибки JSON возвращаются в любом режиме. Значения неожиданного типа
+// в лёгком режиме становятся нулевыми и попадают в Drift, в строгом режиме - приводят к ошибке.
+func Decode(data []byte, v interface{}, mode DecodeMode) (Drift, error) {
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(data, v); err != nil && !errors.As(err, &typeErr) {
+		return Drift{}, err
+	}
+
+	raw, err := decodeRaw(data)
+	if err != nil {
+		return Drift{}, err
+	}
+	drift := SchemaOf(v).Validate(raw)
+
+	if mode == DecodeStrict && !drift.Empty() {
+		return drift, fmt.Errorf("%w: %s", ErrSchemaDrift, drift)
+	}
+	return drift, nil
+}
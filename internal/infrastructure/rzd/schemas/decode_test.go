@@ -0,0 +1,149 @@
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlexTypes(t *testing.T) {
+	var seat Seat
+	drift, err := Decode([]byte(`{"type":"dn","tariff":"2533.50","tariff2":null,"tariffServ":"","free":"12","freeRef":3.0}`), &seat, DecodeStrict)
+	require.NoError(t, err)
+	require.True(t, drift.Empty())
+	require.Equal(t, FlexFloat(2533.5), seat.Tariff)
+	require.Equal(t, 2534, seat.Tariff.Rubles())
+	require.Zero(t, seat.Tariff2)
+	require.Zero(t, seat.TariffServ)
+	require.Equal(t, 12, seat.Free.Int())
+	require.Equal(t, 3, seat.FreeRef.Int())
+
+	var carriage CarriageType
+	_, err = Decode([]byte(`{"itype":1,"tariff":"1822,40","freeSeats":41}`), &carriage, DecodeStrict)
+	require.NoError(t, err)
+	require.Equal(t, 1822, carriage.Tariff.Rubles())
+	require.Equal(t, 41, carriage.FreeSeats.Int())
+
+	var station StationCode
+	_, err = Decode([]byte(`{"n":"МОСКВА","c":"2000000","L":0,"S":5}`), &station, DecodeStrict)
+	require.NoError(t, err)
+	require.Equal(t, 2000000, station.C.Int())
+}
+
+func TestDecodeDrift(t *testing.T) {
+	body := []byte(`{"result":"OK","tp":[{"list":[
+		{"number":"119А","code0":"2004001","code1":"2000000","date0":"13.02.2025","time0":"00:12","date1":"13.02.2025","time1":"09:47","timeInWay":"09:35",
+			"cars":[{"itype":4,"tariff":"по запросу","freeSeats":4,"newField":1}]},
+		{"number":"021А","code0":2004001,"code1":2000000,"date0":"13.02.2025","time0":"01:00","date1":"13.02.2025","time1":"09:00",
+			"cars":[{"itype":4,"tariff":1822,"freeSeats":"много","newField":2}]}
+	]}]}`)
+
+	// В лёгком режиме ответ разбирается, каждое расхождение указывается один раз
+	var resp TrainRouteResponse
+	drift, err := Decode(body, &resp, DecodeLenient)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tp[].list[].cars[].newField"}, drift.Unknown)
+	require.Equal(t, []string{"tp[].list[].timeInWay"}, drift.Missing)
+	require.Equal(t, []FieldChange{
+		{Path: "tp[].list[].cars[].freeSeats", Reason: `expected integer, got string "много"`},
+		{Path: "tp[].list[].cars[].tariff", Reason: `expected number, got string "по запросу"`},
+	}, drift.Changed)
+	require.Len(t, resp.TP[0].List, 2)
+	require.Equal(t, 2004001, resp.TP[0].List[0].Code0.Int())
+	require.Zero(t, resp.TP[0].List[0].Cars[0].Tariff)
+	require.Equal(t, 1822, resp.TP[0].List[1].Cars[0].Tariff.Rubles())
+	require.Zero(t, resp.TP[0].List[1].Cars[0].FreeSeats)
+
+	// Тип обычного поля тоже проверяется
+	var train TrainList
+	drift, err = Decode([]byte(`{"number":119,"bFirm":"да"}`), &train, DecodeLenient)
+	require.NoError(t, err)
+	require.Len(t, drift.Changed, 2)
+
+	_, err = Decode(body, &TrainRouteResponse{}, DecodeStrict)
+	require.ErrorIs(t, err, ErrSchemaDrift)
+	require.ErrorContains(t, err, "unknown fields: tp[].list[].cars[].newField")
+
+	_, err = Decode([]byte(`{"result":`), &TrainRouteResponse{}, DecodeLenient)
+	require.Error(t, err)
+}
+
+func TestSchemaOf(t *testing.T) {
+	data, err := json.Marshal(SchemaOf(StationCodeResponse{}))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": ["array"],
+		"items": {
+			"type": ["object"],
+			"additionalProperties": false,
+			"required": ["n", "c"],
+			"properties": {
+				"n": {"type": ["string"]},
+				"c": {"type": ["integer", "string", "null"]},
+				"L": {"type": ["integer", "string", "null"]},
+				"S": {"type": ["integer", "string", "null"]}
+			}
+		}
+	}`, string(data))
+}
+
+// tariffsByClass схема со словарём, значения которого описываются additionalProperties
+type tariffsByClass struct {
+	Tariffs map[string]FlexFloat `json:"tariffs" contract:"required"`
+}
+
+func TestSchemaOfMap(t *testing.T) {
+	data, err := json.Marshal(SchemaOf(tariffsByClass{}))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": ["object"],
+		"additionalProperties": false,
+		"required": ["tariffs"],
+		"properties": {
+			"tariffs": {
+				"type": ["object"],
+				"additionalProperties": {"type": ["number", "string", "null"]}
+			}
+		}
+	}`, string(data))
+
+	var resp tariffsByClass
+	drift, err := Decode([]byte(`{"tariffs":{"2К":"2533,50","1Р":true}}`), &resp, DecodeLenient)
+	require.NoError(t, err)
+	require.Empty(t, drift.Unknown)
+	require.Len(t, drift.Changed, 1)
+	require.Equal(t, "tariffs{}", drift.Changed[0].Path)
+	require.Equal(t, 2534, resp.Tariffs["2К"].Rubles())
+}
+
+// Образцы ответов из docs/data_templates должны соответствовать схемам без расхождений
+func TestDecodeTemplates(t *testing.T) {
+	for name, schema := range map[string]interface{}{
+		"GetTrainRoutes.json":    &TrainRouteResponse{},
+		"GetTrainCarriages.json": &TrainCarriagesResponse{},
+		"SearchStation.json":     &StationCodeResponse{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "docs", "data_templates", name))
+			require.NoError(t, err)
+			drift, err := Decode(stripComments(data), schema, DecodeStrict)
+			require.NoError(t, err)
+			require.True(t, drift.Empty())
+		})
+	}
+}
+
+// stripComments удаляет строки-комментарии, которые браузер добавляет при сохранении ответа
+func stripComments(data []byte) []byte {
+	var out [][]byte
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if !bytes.HasPrefix(bytes.TrimSpace(line), []byte("//")) {
+			out = append(out, line)
+		}
+	}
+	return bytes.Join(out, []byte("\n"))
+}
@@ -0,0 +1,189 @@
+// internal/infrastructure/rzd/schemas/flex.go
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// РЖД непоследовательно кодирует числа: один и тот же тариф приходит то числом, то строкой,
+// коды станций - то числом, то строкой, отсутствующие значения - null или пустой строкой.
+// Типы ниже принимают все эти варианты. Значение, которое не удалось разобрать, становится нулевым,
+// а Decode сообщает о нём в Drift, поэтому ошибка в данных не превращается молча в нулевую цену.
+
+// FlexInt целое число, которое может прийти числом, строкой ("2004000"), пустой строкой или null
+type FlexInt int
+
+// FlexFloat дробное число (тарифы), которое может прийти числом, строкой ("2533.50", "2533,50"),
+// пустой строкой или null
+type FlexFloat float64
+
+// FlexString строка, которая может прийти строкой, числом или null
+type FlexString string
+
+// flexChecker проверяет, может ли сырое значение JSON быть разобрано в тип схемы
+type flexChecker interface {
+	check(raw interface{}) error
+}
+
+// UnmarshalJSON разбирает целое число; некорректное значение становится нулём
+func (v *FlexInt) UnmarshalJSON(data []byte) error {
+	raw, err := decodeRaw(data)
+	if err != nil {
+		return err
+	}
+	n, _ := parseFlexInt(raw)
+	*v = FlexInt(n)
+	return nil
+}
+
+// UnmarshalJSON разбирает дробное число; некорректное значение становится нулём
+func (v *FlexFloat) UnmarshalJSON(data []byte) error {
+	raw, err := decodeRaw(data)
+	if err != nil {
+		return err
+	}
+	f, _ := parseFlexFloat(raw)
+	*v = FlexFloat(f)
+	return nil
+}
+
+// UnmarshalJSON разбирает строку; некорректное значение становится пустой строкой
+func (v *FlexString) UnmarshalJSON(data []byte) error {
+	raw, err := decodeRaw(data)
+	if err != nil {
+		return err
+	}
+	s, _ := parseFlexString(raw)
+	*v = FlexString(s)
+	return nil
+}
+
+func (FlexInt) check(raw interface{}) error {
+	_, err := parseFlexInt(raw)
+	return err
+}
+
+func (FlexFloat) check(raw interface{}) error {
+	_, err := parseFlexFloat(raw)
+	return err
+}
+
+func (FlexString) check(raw interface{}) error {
+	_, err := parseFlexString(raw)
+	return err
+}
+
+// Int возвращает значение как int
+func (v FlexInt) Int() int {
+	return int(v)
+}
+
+// Rubles возвращает тариф, округлённый до целых рублей
+func (v FlexFloat) Rubles() int {
+	return int(math.Round(float64(v)))
+}
+
+// String возвращает значение как string
+func (v FlexString) String() string {
+	return string(v)
+}
+
+// decodeRaw разбирает значение JSON, сохраняя числа в виде json.Number
+func decodeRaw(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func parseFlexInt(raw interface{}) (int, error) {
+	var text string
+	switch value := raw.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		text = value.String()
+	case string:
+		text = strings.TrimSpace(value)
+		if text == "" {
+			return 0, nil
+		}
+	default:
+		return 0, fmt.Errorf("expected integer, got %s", describeRaw(raw))
+	}
+	if n, err := strconv.Atoi(text); err == nil {
+		return n, nil
+	}
+	// Целое число в записи с дробной частью ("4.0")
+	if f, err := strconv.ParseFloat(text, 64); err == nil && f == math.Trunc(f) && math.Abs(f) <= math.MaxInt32 {
+		return int(f), nil
+	}
+	return 0, fmt.Errorf("expected integer, got %s", describeRaw(raw))
+}
+
+func parseFlexFloat(raw interface{}) (float64, error) {
+	var text string
+	switch value := raw.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		text = value.String()
+	case string:
+		text = strings.TrimSpace(value)
+		if text == "" {
+			return 0, nil
+		}
+		text = strings.Replace(text, ",", ".", 1)
+	default:
+		return 0, fmt.Errorf("expected number, got %s", describeRaw(raw))
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("expected number, got %s", describeRaw(raw))
+	}
+	return f, nil
+}
+
+func parseFlexString(raw interface{}) (string, error) {
+	switch value := raw.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	default:
+		return "", fmt.Errorf("expected string, got %s", describeRaw(raw))
+	}
+}
+
+// describeRaw кратко описывает сырое значение JSON для отчёта о расхождениях
+func describeRaw(raw interface{}) string {
+	switch value := raw.(type) {
+	case nil:
+		return "null"
+	case json.Number:
+		return "number " + value.String()
+	case string:
+		if len([]rune(value)) > 32 {
+			value = string([]rune(value)[:32]) + "..."
+		}
+		return fmt.Sprintf("string %q", value)
+	case bool:
+		return fmt.Sprintf("bool %t", value)
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", raw)
+	}
+}
@@ -6,8 +6,8 @@ type StationCodeResponse []StationCode
 
 // StationCode represents a station code from the RZD API
 type StationCode struct {
-	N string `json:"n"` // Название станции, как в API
-	C int    `json:"c"` // Код станции, как в API
-	L int    `json:"L"` // Уровень станции (0-5, 5 - самый высокий)
-	S int    `json:"S"` // Значение сортировки (0-5, 5 - самое высокое)
+	N string  `json:"n" contract:"required"` // Название станции, как в API
+	C FlexInt `json:"c" contract:"required"` // Код станции, как в API
+	L FlexInt `json:"L"`                     // Уровень станции (0-5, 5 - самый высокий)
+	S FlexInt `json:"S"`                     // Значение сортировки (0-5, 5 - самое высокое)
 }
@@ -3,27 +3,33 @@ package schemas
 
 // TrainRouteResponse представляет ответ от API РЖД на запрос маршрутов
 type TrainRouteResponse struct {
-	Result string `json:"result"`
-	TP     []TP   `json:"tp"`
+	Result             string      `json:"result" contract:"required"`
+	TP                 []TP        `json:"tp" contract:"required"`
+	TransferSearchMode string      `json:"TransferSearchMode"` // Режим поиска пересадок, например "SEMI_AUTO"
+	AutoTransferMode   bool        `json:"AutoTransferMode"`
+	FlFPKRoundBonus    bool        `json:"flFPKRoundBonus"`
+	Discounts          interface{} `json:"discounts"`
+	Timestamp          string      `json:"timestamp"`
 }
 
 // TP представляет маршрут поезда из API РЖД
 type TP struct {
-	From        string      `json:"from"`
-	FromCode    int         `json:"fromCode"`
-	Where       string      `json:"where"`
-	WhereCode   int         `json:"whereCode"`
-	Date        string      `json:"date"`
-	NoSeats     bool        `json:"noSeats"`
-	DefShowTime string      `json:"defShowTime"`
-	State       string      `json:"state"`
-	List        []TrainList `json:"list"`
-	Cur         []int       `json:"cur"`
+	From        string        `json:"from"`
+	FromCode    FlexInt       `json:"fromCode"`
+	Where       string        `json:"where"`
+	WhereCode   FlexInt       `json:"whereCode"`
+	Date        string        `json:"date"`
+	NoSeats     bool          `json:"noSeats"`
+	DefShowTime string        `json:"defShowTime"`
+	State       string        `json:"state"`
+	List        []TrainList   `json:"list"`
+	Cur         []int         `json:"cur"`
+	MsgList     []interface{} `json:"msgList"` // Сообщения об ошибках, разбираются при выполнении запроса
 }
 
 // TrainList представляет ОДИН поезд из списка поездов, возвращаемых API РЖД.
 type TrainList struct {
-	Number            string             `json:"number"`
+	Number            string             `json:"number" contract:"required"`
 	Number2           string             `json:"number2"`
 	Type              int                `json:"type"`
 	TypeEx            int                `json:"typeEx"`
@@ -32,8 +38,8 @@ type TrainList struct {
 	ElReg             bool               `json:"elReg"`
 	DeferredPayment   bool               `json:"deferredPayment"`
 	VarPrice          bool               `json:"varPrice"`
-	Code0             int                `json:"code0"`
-	Code1             int                `json:"code1"`
+	Code0             FlexInt            `json:"code0" contract:"required"`
+	Code1             FlexInt            `json:"code1" contract:"required"`
 	BEntire           bool               `json:"bEntire"`
 	TrainName         string             `json:"trainName"`
 	BFirm             bool               `json:"bFirm"`
@@ -41,17 +47,19 @@ type TrainList struct {
 	Carrier           string             `json:"carrier"`
 	Route0            string             `json:"route0"`
 	Route1            string             `json:"route1"`
+	RouteCode0        FlexInt            `json:"routeCode0"` // Код начальной станции маршрута поезда
+	RouteCode1        FlexInt            `json:"routeCode1"` // Код конечной станции маршрута поезда
 	TrDate0           string             `json:"trDate0"`
 	TrTime0           string             `json:"trTime0"`
 	Station0          string             `json:"station0"`
 	Station1          string             `json:"station1"`
-	Date0             string             `json:"date0"`
-	Time0             string             `json:"time0"`
-	Date1             string             `json:"date1"`
-	Time1             string             `json:"time1"`
-	TimeInWay         string             `json:"timeInWay"`
+	Date0             string             `json:"date0" contract:"required"`
+	Time0             string             `json:"time0" contract:"required"`
+	Date1             string             `json:"date1" contract:"required"`
+	Time1             string             `json:"time1" contract:"required"`
+	TimeInWay         string             `json:"timeInWay" contract:"required"`
 	FlMsk             int                `json:"flMsk"`
-	TrainID           int                `json:"train_id"`
+	TrainID           FlexInt            `json:"train_id"`
 	Cars              []CarriageType     `json:"cars"`
 	SeatCars          []SeatCarriageType `json:"seatCars,omitempty"`
 	CarNumeration     string             `json:"carNumeration"`
@@ -59,32 +67,42 @@ type TrainList struct {
 	AddCompLuggageNum int                `json:"addCompLuggageNum"`
 	AddCompLuggage    bool               `json:"addCompLuggage"`
 	AddHandLuggage    bool               `json:"addHandLuggage"`
+	Bus               bool               `json:"bus,omitempty"`  // Автобусный сегмент (как в TrainResult)
+	Boat              bool               `json:"boat,omitempty"` // Паромный сегмент (как в TrainResult)
+	BrandID           FlexInt            `json:"brandId"`
+	BrandLogo         bool               `json:"brandLogo"`
+	NonRefundable     bool               `json:"nonRefundable"`
+	CarMods           bool               `json:"carMods"`
+	BWifi             bool               `json:"bWifi"`    // В поезде есть Wi-Fi
+	InetInfo          string             `json:"inetInfo"` // Описание доступа в интернет
 }
 
 // CarriageType представляет один тип вагона в поезде из API РЖД
 type CarriageType struct {
-	CarDataType    int    `json:"carDataType"`
-	Itype          int    `json:"itype"`
-	Type           string `json:"type"`
-	TypeLoc        string `json:"typeLoc"`
-	FreeSeats      int    `json:"freeSeats"`
-	Pt             int    `json:"pt"`
-	Tariff         int    `json:"tariff"` // Да-да, это int, а не string. А в seatcarriage - string
-	ServCls        string `json:"servCls"`
-	DisabledPerson bool   `json:"disabledPerson,omitempty"`
+	CarDataType    int       `json:"carDataType"`
+	Itype          int       `json:"itype" contract:"required"`
+	Type           string    `json:"type"`
+	TypeLoc        string    `json:"typeLoc"`
+	FreeSeats      FlexInt   `json:"freeSeats" contract:"required"`
+	Pt             FlexInt   `json:"pt"`
+	Tariff         FlexFloat `json:"tariff" contract:"required"` // Обычно приходит числом, в seatcarriage - строкой
+	ServCls        string    `json:"servCls"`
+	DisabledPerson bool      `json:"disabledPerson,omitempty"`
 }
 
 // SeatCarriageType представляет один тип вагона в поезде из API РЖД, но другой.
 // По сути это тот же CarriageType, но с другими полями немного
 type SeatCarriageType struct { // Да, они разные
-	CarDataType int    `json:"carDataType"`
-	Itype       int    `json:"itype"`
-	Type        string `json:"type"`
-	TypeLoc     string `json:"typeLoc"`
-	FreeSeats   int    `json:"freeSeats"`
-	Pt          int    `json:"pt"`
-	Tariff      string `json:"tariff"`            // Да-да, это string, а не int. А в carriage - int
-	Tariff2     string `json:"tariff2,omitempty"` // И тут тоже string
-	ServCls     string `json:"servCls"`
-	// И тут нет disabledPerson, да
+	CarDataType int       `json:"carDataType"`
+	Itype       int       `json:"itype" contract:"required"`
+	Type        string    `json:"type"`
+	TypeLoc     string    `json:"typeLoc"`
+	FreeSeats   FlexInt   `json:"freeSeats" contract:"required"`
+	Pt          FlexInt   `json:"pt"`
+	Tariff      FlexFloat `json:"tariff" contract:"required"` // Обычно приходит строкой, в carriage - числом
+	Tariff2     FlexFloat `json:"tariff2,omitempty"`          // Тоже строкой, или null
+	ServCls     string    `json:"servCls"`
+	// disabledPerson приходит не всегда, а в CarriageType - всегда
+	DisabledPerson bool `json:"disabledPerson,omitempty"`
+	LastPlaces     bool `json:"lastPlaces,omitempty"` // Осталось мало мест
 }
@@ -0,0 +1,30 @@
+// internal/infrastructure/rzd/schemas/train_schedule.go
+package schemas
+
+// TrainScheduleResponse представляет ответ basicRoute: маршрут следования поезда по номеру и дате.
+type TrainScheduleResponse struct {
+	Data TrainScheduleData `json:"data" contract:"required"` // Поезд и его остановки
+}
+
+// TrainScheduleData описывает поезд и его остановки.
+type TrainScheduleData struct {
+	TrainInfo TrainScheduleInfo   `json:"trainInfo"`                  // Сведения о поезде
+	Routes    []TrainScheduleStop `json:"routes" contract:"required"` // Остановки по порядку следования
+}
+
+// TrainScheduleInfo сведения о поезде.
+type TrainScheduleInfo struct {
+	Number FlexString `json:"number"` // Номер поезда (например, "119А")
+	From   string     `json:"from"`   // Начальная станция маршрута
+	To     string     `json:"to"`     // Конечная станция маршрута
+}
+
+// TrainScheduleStop остановка поезда. Время указано по Москве.
+type TrainScheduleStop struct {
+	Station     string  `json:"station" contract:"required"` // Название станции
+	Code        FlexInt `json:"code" contract:"required"`    // Код станции
+	ArvTime     string  `json:"arvTime"`                     // Время прибытия (формат HH:MM), пустое для начальной станции
+	DepTime     string  `json:"depTime"`                     // Время отправления (формат HH:MM), пустое для конечной станции
+	WaitingTime FlexInt `json:"waitingTime"`                 // Стоянка в минутах
+	Distance    FlexInt `json:"distance"`                    // Расстояние от начальной станции, км
+}
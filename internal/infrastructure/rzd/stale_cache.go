@@ -0,0 +1,52 @@
+package rzd
+
+import (
+	"context"
+	"encoding/binary"
+	"log"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+)
+
+// staleCache хранит последние успешные ответы РЖД, чтобы отдавать их, пока РЖД недоступен.
+// При общем хранилище ответ, полученный одной репликой, доступен всем.
+type staleCache struct {
+	store state.Store
+	now   func() time.Time
+}
+
+func newStaleCache(store state.Store) *staleCache {
+	return &staleCache{store: store, now: time.Now}
+}
+
+// Get возвращает ответ не старше ttl и его возраст
+func (c *staleCache) Get(ctx context.Context, key string, ttl time.Duration) ([]byte, time.Duration, bool) {
+	value, ok, err := c.store.Get(ctx, state.HashKey("stale:", key))
+	if err != nil {
+		log.Printf("Failed to get stale response from state store: %v", err)
+		return nil, 0, false
+	}
+	if !ok || len(value) < 8 {
+		return nil, 0, false
+	}
+	storedAt := time.Unix(0, int64(binary.BigEndian.Uint64(value)))
+	age := c.now().Sub(storedAt)
+	if age > ttl {
+		return nil, 0, false
+	}
+	return value[8:], age, true
+}
+
+// Set сохраняет ответ на ttl вместе со временем получения. При ttl <= 0 кэш не используется.
+func (c *staleCache) Set(ctx context.Context, key string, body []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	value := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint64(value, uint64(c.now().UnixNano()))
+	value = append(value, body...)
+	if err := c.store.Set(ctx, state.HashKey("stale:", key), value, ttl); err != nil {
+		log.Printf("Failed to save stale response to state store: %v", err)
+	}
+}
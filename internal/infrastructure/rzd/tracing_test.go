@@ -0,0 +1,78 @@
+package rzd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func TestClientTracesRIDFlow(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	// Первый ответ выдаёт RID, второй - результат. RID повторного запроса проверяется
+	// после вызова: require нельзя вызывать из горутины обработчика.
+	var requests atomic.Int32
+	var rid atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"result":"RID","RID":123456}`))
+			return
+		}
+		rid.Store(r.URL.Query().Get("rid"))
+		_, _ = w.Write([]byte(`{"result":"OK","tp":[{"list":[]}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewRzdClient(&config.RZD{
+		Language:    "ru",
+		BasePath:    server.URL + "/",
+		Timeout:     time.Second,
+		MaxRetries:  3,
+		RetryDelay:  time.Millisecond,
+		RIDLifetime: time.Minute,
+		Breaker:     config.RZDBreaker{FailureThreshold: 3, OpenTimeout: time.Minute},
+		DecodeMode:  config.DecodeLenient,
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2004000,
+		ToCode:   2000000,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load())
+	require.Equal(t, "123456", rid.Load())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	first, second, parent := spans[0], spans[1], spans[2]
+	require.Equal(t, "rzd."+breakerRoutes, parent.Name())
+	require.Equal(t, "rzd.attempt", first.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), first.Parent().SpanID())
+	require.Equal(t, parent.SpanContext().SpanID(), second.Parent().SpanID())
+
+	require.Contains(t, first.Attributes(), tracing.AttrAttempt.Int(1))
+	require.Contains(t, first.Attributes(), tracing.AttrResult.String("RID"))
+	require.Contains(t, first.Attributes(), tracing.AttrRIDIssued.Bool(true))
+	require.Contains(t, second.Attributes(), tracing.AttrAttempt.Int(2))
+	require.Contains(t, second.Attributes(), tracing.AttrRIDCached.Bool(true))
+	require.Contains(t, second.Attributes(), tracing.AttrStatus.Int(http.StatusOK))
+	require.NotContains(t, parent.Attributes(), tracing.AttrStale.Bool(true))
+}
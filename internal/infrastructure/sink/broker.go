@@ -0,0 +1,53 @@
+// internal/infrastructure/sink/broker.go
+package sink
+
+import (
+	"context"
+	"strings"
+)
+
+// Publisher минимальный интерфейс клиента брокера сообщений.
+// Позволяет подключить NATS, Kafka и т.п. без изменения BrokerSink.
+type Publisher interface {
+	// Publish публикует сообщение в топик (subject)
+	Publish(ctx context.Context, topic string, payload []byte) error
+	// Close закрывает соединение с брокером
+	Close() error
+}
+
+// BrokerSink публикует события в брокер сообщений.
+// Топик строится как "<prefix>.<тип события>", например "rzd.train_routes".
+type BrokerSink struct {
+	publisher Publisher
+	prefix    string
+}
+
+// NewBrokerSink создаёт получателя событий поверх клиента брокера
+func NewBrokerSink(publisher Publisher, topicPrefix string) *BrokerSink {
+	return &BrokerSink{
+		publisher: publisher,
+		prefix:    strings.TrimSuffix(topicPrefix, "."),
+	}
+}
+
+// Topic возвращает топик для типа события
+func (s *BrokerSink) Topic(eventType EventType) string {
+	if s.prefix == "" {
+		return string(eventType)
+	}
+	return s.prefix + "." + string(eventType)
+}
+
+// Publish сериализует событие и публикует его в топик по типу события
+func (s *BrokerSink) Publish(ctx context.Context, event Event) error {
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	return s.publisher.Publish(ctx, s.Topic(event.Type), data)
+}
+
+// Close закрывает клиента брокера
+func (s *BrokerSink) Close() error {
+	return s.publisher.Close()
+}
@@ -0,0 +1,66 @@
+// internal/infrastructure/sink/config.go
+package sink
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// NewFromConfig создаёт получателей, включённых в конфигурации, и объединяет их, дав каждому свою
+// асинхронную очередь. Возвращает nil, если ни один получатель не настроен.
+func NewFromConfig(cfg *config.Sinks) (Sink, error) {
+	var sinks []Sink
+
+	if cfg.NATS.URL != "" {
+		publisher, err := NewNATSPublisher(cfg.NATS.URL, "rzd-scraper")
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewBrokerSink(publisher, cfg.NATS.SubjectPrefix))
+	}
+
+	if cfg.Kafka.Brokers != "" {
+		publisher, err := NewKafkaPublisher(cfg.Kafka.Brokers, "rzd-scraper")
+		if err != nil {
+			closeErr := NewMulti(sinks...).Close()
+			return nil, errors.Join(err, closeErr)
+		}
+		sinks = append(sinks, NewBrokerSink(publisher, cfg.Kafka.TopicPrefix))
+	}
+
+	if cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(WebhookConfig{
+			URL:        cfg.Webhook.URL,
+			Secret:     cfg.Webhook.Secret,
+			MaxRetries: cfg.Webhook.MaxRetries,
+			Backoff:    cfg.Webhook.Backoff,
+			Timeout:    cfg.Webhook.Timeout,
+		}))
+	}
+
+	if cfg.File.Path != "" {
+		fileSink, err := NewFileSink(cfg.File.Path)
+		if err != nil {
+			closeErr := NewMulti(sinks...).Close()
+			return nil, errors.Join(fmt.Errorf("failed to create file sink: %w", err), closeErr)
+		}
+		sinks = append(sinks, fileSink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return newQueued(sinks, cfg.Buffer), nil
+}
+
+// newQueued оборачивает каждого получателя отдельной очередью размера bufferSize со своим обработчиком:
+// повторы медленного получателя (например, вебхука) не задерживают доставку остальным
+func newQueued(sinks []Sink, bufferSize int) Sink {
+	queued := make([]Sink, 0, len(sinks))
+	for _, s := range sinks {
+		queued = append(queued, NewAsync(s, bufferSize))
+	}
+	return NewMulti(queued...)
+}
@@ -0,0 +1,286 @@
+// internal/infrastructure/sink/event.go
+package sink
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// SchemaVersion версия JSON-схемы событий. Меняется только при несовместимых изменениях,
+// новые поля добавляются без смены версии.
+const SchemaVersion = "1"
+
+// EventType тип экспортируемого события
+type EventType string
+
+const (
+	EventTrainRoutes    EventType = "train_routes"    // Результат поиска маршрутов
+	EventTrainCarriages EventType = "train_carriages" // Результат запроса вагонов
+)
+
+// Event конверт экспортируемого события.
+// Доменные модели не сериализуются напрямую: схема фиксируется DTO-структурами ниже,
+// чтобы изменения domain не ломали потребителей.
+type Event struct {
+	SchemaVersion string            `json:"schema_version"`
+	ID            string            `json:"id"`
+	Type          EventType         `json:"type"`
+	EmittedAt     time.Time         `json:"emitted_at"`
+	Routes        *RoutesPayload    `json:"routes,omitempty"`
+	Carriages     *CarriagesPayload `json:"carriages,omitempty"`
+}
+
+// RoutesPayload данные события train_routes
+type RoutesPayload struct {
+	Query  RoutesQuery `json:"query"`
+	Routes []Route     `json:"routes"`
+}
+
+// RoutesQuery параметры запроса, по которому получены маршруты
+type RoutesQuery struct {
+	FromCode   int     `json:"from_code"`
+	ToCode     int     `json:"to_code"`
+	Date       string  `json:"date"` // YYYY-MM-DD
+	Direction  int32   `json:"direction"`
+	TrainType  int32   `json:"train_type"`
+	CheckSeats bool    `json:"check_seats"`
+	WithChange bool    `json:"with_change"`
+	TrainTypes []int32 `json:"train_types,omitempty"`
+	Language   string  `json:"language,omitempty"`
+}
+
+// CarriagesPayload данные события train_carriages
+type CarriagesPayload struct {
+	Query     CarriagesQuery `json:"query"`
+	Carriages []Car          `json:"carriages"`
+}
+
+// CarriagesQuery параметры запроса, по которому получены вагоны
+type CarriagesQuery struct {
+	TrainNumber string    `json:"train_number"`
+	FromCode    int       `json:"from_code"`
+	ToCode      int       `json:"to_code"`
+	Departure   time.Time `json:"departure"`
+	Direction   int32     `json:"direction"`
+	Language    string    `json:"language,omitempty"`
+}
+
+// Route маршрут поезда (схема v1)
+type Route struct {
+	TrainNumber     string         `json:"train_number"`
+	TrainNumber2    string         `json:"train_number2,omitempty"`
+	TrainName       string         `json:"train_name,omitempty"`
+	TrainType       int32          `json:"train_type"`
+	DurationMinutes int64          `json:"duration_minutes"`
+	Brand           string         `json:"brand,omitempty"`
+	Carrier         Carrier        `json:"carrier"`
+	Firm            bool           `json:"firm"`
+	ElReg           bool           `json:"el_reg"`
+	VarPrice        bool           `json:"var_price"`
+	DeferredPayment bool           `json:"deferred_payment"`
+	CarNumeration   int32          `json:"car_numeration"`
+	From            Station        `json:"from"`
+	To              Station        `json:"to"`
+	Departure       time.Time      `json:"departure"`
+	Arrival         time.Time      `json:"arrival"`
+	OriginDeparture *time.Time     `json:"origin_departure,omitempty"`
+	CarTypes        []CarriageType `json:"car_types"`
+	Cars            []Car          `json:"cars,omitempty"`
+}
+
+// Station станция (схема v1)
+type Station struct {
+	Code      int    `json:"code"`
+	Name      string `json:"name"`
+	RouteName string `json:"route_name,omitempty"`
+}
+
+// CarriageType агрегированный тип вагона (схема v1)
+type CarriageType struct {
+	SeatType    int32  `json:"seat_type"`
+	ShortLabel  string `json:"short_label,omitempty"`
+	Label       string `json:"label"`
+	Class       string `json:"class"`
+	Tariff      int    `json:"tariff"`
+	TariffExtra int    `json:"tariff_extra,omitempty"`
+	FreeSeats   int    `json:"free_seats"`
+	Disabled    bool   `json:"disabled"`
+	DataType    int32  `json:"data_type,omitempty"`    // domain.CarDataType: 1 - сводка, 2 - ценовая ступень
+	BonusPoints int    `json:"bonus_points,omitempty"` // Баллы «РЖД Бонус»
+	LastSeats   bool   `json:"last_seats,omitempty"`
+}
+
+// Car конкретный вагон (схема v1)
+type Car struct {
+	Number        string    `json:"number"`
+	Type          string    `json:"type"`
+	TypeLabel     string    `json:"type_label"`
+	CategoryCode  string    `json:"category_code,omitempty"`
+	CarType       int       `json:"car_type"`
+	SeatType      int32     `json:"seat_type,omitempty"` // domain.CarSeatType
+	Letter        string    `json:"letter,omitempty"`
+	ClassType     string    `json:"class_type"`
+	Tariff        int       `json:"tariff"`
+	TariffExtra   int       `json:"tariff_extra,omitempty"`
+	Carrier       Carrier   `json:"carrier"`
+	CarNumeration int32     `json:"car_numeration"`
+	Services      []Service `json:"services,omitempty"`
+}
+
+// Carrier перевозчик (схема v1)
+type Carrier struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// Service услуга в вагоне (схема v1)
+type Service struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NewRoutesEvent формирует событие train_routes из результата поиска маршрутов
+func NewRoutesEvent(params domain.GetTrainRoutesParams, routes []domain.TrainRoute) Event {
+	payload := &RoutesPayload{
+		Query: RoutesQuery{
+			FromCode:   params.FromCode,
+			ToCode:     params.ToCode,
+			Date:       params.FromDate.Format("2006-01-02"),
+			Direction:  int32(params.Direction),
+			TrainType:  int32(params.TrainType),
+			CheckSeats: params.CheckSeats,
+			WithChange: params.WithChange,
+			TrainTypes: mapTrainTypes(params.TrainTypes),
+			Language:   params.Language,
+		},
+		Routes: make([]Route, 0, len(routes)),
+	}
+	for _, r := range routes {
+		payload.Routes = append(payload.Routes, mapRoute(r))
+	}
+	event := newEvent(EventTrainRoutes)
+	event.Routes = payload
+	return event
+}
+
+// NewCarriagesEvent формирует событие train_carriages из результата запроса вагонов
+func NewCarriagesEvent(params domain.GetTrainCarriagesParams, cars []domain.Car) Event {
+	payload := &CarriagesPayload{
+		Query: CarriagesQuery{
+			TrainNumber: params.TrainNumber,
+			FromCode:    params.FromCode,
+			ToCode:      params.ToCode,
+			Departure:   params.FromTime,
+			Direction:   int32(params.Direction),
+			Language:    params.Language,
+		},
+		Carriages: mapCars(cars),
+	}
+	event := newEvent(EventTrainCarriages)
+	event.Carriages = payload
+	return event
+}
+
+func newEvent(eventType EventType) Event {
+	return Event{
+		SchemaVersion: SchemaVersion,
+		ID:            newEventID(),
+		Type:          eventType,
+		EmittedAt:     time.Now().UTC(),
+	}
+}
+
+// newEventID генерирует случайный идентификатор события для дедупликации на стороне потребителя
+func newEventID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().UTC().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
+
+func mapRoute(r domain.TrainRoute) Route {
+	route := Route{
+		TrainNumber:     r.TrainNumber,
+		TrainNumber2:    r.TrainNumber2,
+		TrainName:       r.TrainName,
+		TrainType:       int32(r.TrainType),
+		DurationMinutes: int64(r.Duration.Minutes()),
+		Brand:           r.Brand,
+		Carrier:         Carrier{ID: r.Carrier.ID, Name: r.Carrier.Name},
+		Firm:            r.Firm,
+		ElReg:           r.ElReg,
+		VarPrice:        r.VarPrice,
+		DeferredPayment: r.DeferredPayment,
+		CarNumeration:   int32(r.CarNumeration),
+		From:            mapStation(r.From),
+		To:              mapStation(r.To),
+		Departure:       r.Departure,
+		Arrival:         r.Arrival,
+		CarTypes:        make([]CarriageType, 0, len(r.CarTypes)),
+		Cars:            mapCars(r.Cars),
+	}
+	if !r.OriginDeparture.IsZero() {
+		originDeparture := r.OriginDeparture
+		route.OriginDeparture = &originDeparture
+	}
+	for _, ct := range r.CarTypes {
+		route.CarTypes = append(route.CarTypes, CarriageType{
+			SeatType:    int32(ct.Type),
+			ShortLabel:  ct.TypeShortLabel,
+			Label:       ct.TypeLabel,
+			Class:       ct.Class,
+			Tariff:      ct.Tariff,
+			TariffExtra: ct.TariffExtra,
+			FreeSeats:   ct.FreeSeats,
+			Disabled:    ct.Disabled,
+			DataType:    int32(ct.DataType),
+			BonusPoints: ct.BonusPoints,
+			LastSeats:   ct.LastSeats,
+		})
+	}
+	return route
+}
+
+func mapTrainTypes(types []domain.TrainType) []int32 {
+	var result []int32
+	for _, t := range types {
+		result = append(result, int32(t))
+	}
+	return result
+}
+
+func mapStation(s domain.Station) Station {
+	return Station{Code: s.Code, Name: s.Name, RouteName: s.RouteName}
+}
+
+func mapCars(cars []domain.Car) []Car {
+	if len(cars) == 0 {
+		return nil
+	}
+	result := make([]Car, 0, len(cars))
+	for _, c := range cars {
+		car := Car{
+			Number:        c.CarNumber,
+			Type:          c.Type,
+			TypeLabel:     c.TypeLabel,
+			CategoryCode:  c.CategoryCode,
+			CarType:       c.CarType,
+			SeatType:      int32(c.SeatType),
+			Letter:        c.Letter,
+			ClassType:     c.ClassType,
+			Tariff:        c.Tariff,
+			TariffExtra:   c.Tariff2,
+			Carrier:       Carrier{ID: c.Carrier.ID, Name: c.Carrier.Name},
+			CarNumeration: int32(c.CarNumeration),
+		}
+		for _, s := range c.Services {
+			car.Services = append(car.Services, Service{ID: s.ID, Name: s.Name})
+		}
+		result = append(result, car)
+	}
+	return result
+}
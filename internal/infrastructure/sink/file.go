@@ -0,0 +1,47 @@
+// internal/infrastructure/sink/file.go
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileSink записывает события в файл в формате NDJSON (одно событие на строку)
+type FileSink struct {
+	file  *os.File
+	mutex sync.Mutex
+}
+
+// NewFileSink открывает (или создаёт) файл для дозаписи событий
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sink file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Publish дописывает событие строкой в конец файла
+func (s *FileSink) Publish(_ context.Context, event Event) error {
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, err := s.file.Write(data); err != nil {
+		return fmt.Errorf("failed to write event to file: %w", err)
+	}
+	return nil
+}
+
+// Close закрывает файл
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.file.Close()
+}
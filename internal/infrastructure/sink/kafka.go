@@ -0,0 +1,484 @@
+// internal/infrastructure/sink/kafka.go
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Версии и ключи API Kafka, которые использует клиент. Produce v3 - наименьшая версия
+// с record batch v2, её поддерживают брокеры от 0.11 до 4.x.
+const (
+	kafkaAPIProduce  int16 = 0
+	kafkaAPIMetadata int16 = 3
+
+	kafkaProduceVersion  int16 = 3
+	kafkaMetadataVersion int16 = 4
+)
+
+// Коды ошибок Kafka, после которых нужно заново узнать лидеров разделов
+const (
+	kafkaErrUnknownTopicOrPartition int16 = 3
+	kafkaErrLeaderNotAvailable      int16 = 5
+	kafkaErrNotLeaderOrFollower     int16 = 6
+)
+
+// kafkaMaxResponse наибольший принимаемый ответ брокера
+const kafkaMaxResponse = 64 << 20
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// KafkaPublisher минимальный клиент Kafka, умеющий только публиковать (Produce v3, record batch v2
+// без сжатия, acks=all). Лидеры разделов узнаются запросом Metadata к одному из брокеров списка,
+// сообщения распределяются по разделам топика по кругу. Соединения устанавливаются лениво
+// и сбрасываются при ошибке вместе со сведениями о лидерах.
+type KafkaPublisher struct {
+	brokers     []string
+	clientID    string
+	timeout     time.Duration
+	dialTimeout time.Duration
+
+	mutex       sync.Mutex
+	conns       map[string]*kafkaConn       // Соединения по адресу брокера
+	partitions  map[string][]kafkaPartition // Разделы топика с известным лидером
+	counters    map[string]int              // Счётчик сообщений топика для выбора раздела
+	correlation int32
+}
+
+// kafkaPartition раздел топика и адрес брокера-лидера
+type kafkaPartition struct {
+	id     int32
+	leader string
+}
+
+// kafkaConn соединение с брокером
+type kafkaConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewKafkaPublisher создаёт клиента для списка брокеров "host:port,host:port"
+func NewKafkaPublisher(brokers, clientID string) (*KafkaPublisher, error) {
+	var addrs []string
+	for _, broker := range strings.Split(brokers, ",") {
+		broker = strings.TrimSpace(broker)
+		if broker == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			broker = net.JoinHostPort(broker, "9092")
+		}
+		addrs = append(addrs, broker)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("invalid Kafka brokers %q: list is empty", brokers)
+	}
+	return &KafkaPublisher{
+		brokers:     addrs,
+		clientID:    clientID,
+		timeout:     10 * time.Second,
+		dialTimeout: 5 * time.Second,
+		conns:       make(map[string]*kafkaConn),
+		partitions:  make(map[string][]kafkaPartition),
+		counters:    make(map[string]int),
+	}, nil
+}
+
+// Publish публикует сообщение в следующий по кругу раздел топика из тех, у которых есть лидер
+func (p *KafkaPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	if topic == "" || len(topic) > 249 || strings.ContainsAny(topic, " \t\r\n/") {
+		return fmt.Errorf("invalid Kafka topic %q", topic)
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	partitions, err := p.partitionsLocked(ctx, topic)
+	if err != nil {
+		return fmt.Errorf("failed to publish to Kafka: %w", err)
+	}
+	partition := partitions[p.counters[topic]%len(partitions)]
+	p.counters[topic]++
+
+	request := newKafkaEncoder()
+	request.nullableString(nil) // transactional_id
+	request.int16(-1)           // acks=all
+	request.int32(int32(p.timeout / time.Millisecond))
+	request.int32(1) // topic_data
+	request.string(topic)
+	request.int32(1) // partition_data
+	request.int32(partition.id)
+	request.bytes(encodeRecordBatch(payload, time.Now()))
+
+	response, err := p.roundTripLocked(ctx, partition.leader, kafkaAPIProduce, kafkaProduceVersion, request.buf)
+	if err != nil {
+		delete(p.partitions, topic)
+		return fmt.Errorf("failed to publish to Kafka: %w", err)
+	}
+	if code := decodeProduceResponse(response); code != 0 {
+		if code == kafkaErrUnknownTopicOrPartition || code == kafkaErrLeaderNotAvailable || code == kafkaErrNotLeaderOrFollower {
+			delete(p.partitions, topic)
+		}
+		return fmt.Errorf("failed to publish to Kafka topic %s partition %d: broker error %d", topic, partition.id, code)
+	}
+	return nil
+}
+
+// partitionsLocked возвращает разделы топика с лидерами, запрашивая Metadata при необходимости.
+// Разделы без лидера пропускаются. Вызывается под мьютексом.
+func (p *KafkaPublisher) partitionsLocked(ctx context.Context, topic string) ([]kafkaPartition, error) {
+	if partitions, ok := p.partitions[topic]; ok {
+		return partitions, nil
+	}
+	request := newKafkaEncoder()
+	request.int32(1)
+	request.string(topic)
+	request.bool(true) // allow_auto_topic_creation
+
+	var errs []error
+	for _, broker := range p.brokers {
+		response, err := p.roundTripLocked(ctx, broker, kafkaAPIMetadata, kafkaMetadataVersion, request.buf)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		partitions, err := decodeMetadataResponse(response, topic)
+		if err != nil {
+			return nil, err
+		}
+		p.partitions[topic] = partitions
+		return partitions, nil
+	}
+	return nil, errors.Join(errs...)
+}
+
+// roundTripLocked отправляет запрос брокеру и читает ответ. При ошибке соединение закрывается.
+// Вызывается под мьютексом.
+func (p *KafkaPublisher) roundTripLocked(ctx context.Context, addr string, apiKey, version int16, body []byte) ([]byte, error) {
+	conn, ok := p.conns[addr]
+	if !ok {
+		dialer := net.Dialer{Timeout: p.dialTimeout}
+		netConn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to Kafka broker %s: %w", addr, err)
+		}
+		conn = &kafkaConn{conn: netConn, reader: bufio.NewReader(netConn)}
+		p.conns[addr] = conn
+	}
+	deadline := time.Now().Add(p.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = conn.conn.SetDeadline(deadline)
+
+	p.correlation++
+	correlation := p.correlation
+	header := newKafkaEncoder()
+	header.int32(0) // Размер запроса, заполняется ниже
+	header.int16(apiKey)
+	header.int16(version)
+	header.int32(correlation)
+	header.nullableString(&p.clientID)
+	frame := append(header.buf, body...)
+	binary.BigEndian.PutUint32(frame, uint32(len(frame)-4))
+
+	response, err := conn.exchange(frame, correlation)
+	if err != nil {
+		_ = conn.conn.Close()
+		delete(p.conns, addr)
+		return nil, fmt.Errorf("kafka broker %s: %w", addr, err)
+	}
+	return response, nil
+}
+
+// exchange записывает запрос и возвращает тело ответа без заголовка
+func (c *kafkaConn) exchange(frame []byte, correlation int32) ([]byte, error) {
+	if _, err := c.conn.Write(frame); err != nil {
+		return nil, err
+	}
+	var size [4]byte
+	if _, err := io.ReadFull(c.reader, size[:]); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(size[:])
+	if length < 4 || length > kafkaMaxResponse {
+		return nil, fmt.Errorf("invalid response size %d", length)
+	}
+	response := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, response); err != nil {
+		return nil, err
+	}
+	if got := int32(binary.BigEndian.Uint32(response)); got != correlation {
+		return nil, fmt.Errorf("unexpected correlation id %d, want %d", got, correlation)
+	}
+	return response[4:], nil
+}
+
+// Close закрывает соединения с брокерами
+func (p *KafkaPublisher) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var errs []error
+	for addr, conn := range p.conns {
+		errs = append(errs, conn.conn.Close())
+		delete(p.conns, addr)
+	}
+	return errors.Join(errs...)
+}
+
+// encodeRecordBatch кодирует одно сообщение без ключа в record batch v2
+func encodeRecordBatch(payload []byte, now time.Time) []byte {
+	record := newKafkaEncoder()
+	record.int8(0)    // attributes
+	record.varint(0)  // timestamp_delta
+	record.varint(0)  // offset_delta
+	record.varint(-1) // key
+	record.varint(int64(len(payload)))
+	record.buf = append(record.buf, payload...)
+	record.varint(0) // headers
+
+	timestamp := now.UnixMilli()
+	batch := newKafkaEncoder()
+	batch.int64(0)  // base_offset
+	batch.int32(0)  // batch_length, заполняется ниже
+	batch.int32(-1) // partition_leader_epoch
+	batch.int8(2)   // magic
+	batch.int32(0)  // crc, заполняется ниже
+	crcStart := len(batch.buf)
+	batch.int16(0) // attributes: без сжатия
+	batch.int32(0) // last_offset_delta
+	batch.int64(timestamp)
+	batch.int64(timestamp)
+	batch.int64(-1) // producer_id
+	batch.int16(-1) // producer_epoch
+	batch.int32(-1) // base_sequence
+	batch.int32(1)  // records
+	batch.varint(int64(len(record.buf)))
+	batch.buf = append(batch.buf, record.buf...)
+
+	binary.BigEndian.PutUint32(batch.buf[8:], uint32(len(batch.buf)-12))
+	binary.BigEndian.PutUint32(batch.buf[crcStart-4:], crc32.Checksum(batch.buf[crcStart:], crc32c))
+	return batch.buf
+}
+
+// decodeMetadataResponse возвращает разделы топика с лидерами из ответа Metadata v4, упорядоченные по номеру.
+// Номер раздела сохраняется: при пропуске разделов без лидера номера перестают совпадать с индексами.
+func decodeMetadataResponse(response []byte, topic string) ([]kafkaPartition, error) {
+	d := kafkaDecoder{buf: response}
+	d.int32() // throttle_time_ms
+	brokers := make(map[int32]string)
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		nodeID := d.int32()
+		host := d.string()
+		port := d.int32()
+		d.nullableString() // rack
+		brokers[nodeID] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.nullableString() // cluster_id
+	d.int32()          // controller_id
+
+	var partitions []kafkaPartition
+	found := false
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		code := d.int16()
+		name := d.string()
+		d.bool() // is_internal
+		var topicPartitions []kafkaPartition
+		for m := d.int32(); m > 0 && d.err == nil; m-- {
+			d.int16() // error_code
+			index := d.int32()
+			leader := d.int32()
+			d.int32Array() // replica_nodes
+			d.int32Array() // isr_nodes
+			if addr, ok := brokers[leader]; ok && index >= 0 {
+				topicPartitions = append(topicPartitions, kafkaPartition{id: index, leader: addr})
+			}
+		}
+		if name != topic {
+			continue
+		}
+		found = true
+		if code != 0 {
+			return nil, fmt.Errorf("kafka topic %s: broker error %d", topic, code)
+		}
+		partitions = topicPartitions
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid Kafka metadata response: %w", d.err)
+	}
+	if !found || len(partitions) == 0 {
+		return nil, fmt.Errorf("kafka topic %s: no partition leaders", topic)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].id < partitions[j].id })
+	return partitions, nil
+}
+
+// decodeProduceResponse возвращает код ошибки раздела из ответа Produce v3.
+// Неразборчивый ответ считается ошибкой -1 (неизвестная ошибка сервера).
+func decodeProduceResponse(response []byte) int16 {
+	d := kafkaDecoder{buf: response}
+	var code int16
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		d.string()
+		for m := d.int32(); m > 0 && d.err == nil; m-- {
+			d.int32() // partition_index
+			if partitionCode := d.int16(); partitionCode != 0 {
+				code = partitionCode
+			}
+			d.int64() // base_offset
+			d.int64() // log_append_time_ms
+		}
+	}
+	if d.err != nil {
+		return -1
+	}
+	return code
+}
+
+// kafkaEncoder кодирует примитивы протокола Kafka (big-endian)
+type kafkaEncoder struct {
+	buf []byte
+}
+
+func newKafkaEncoder() *kafkaEncoder {
+	return &kafkaEncoder{buf: make([]byte, 0, 256)}
+}
+
+func (e *kafkaEncoder) int8(v int8) { e.buf = append(e.buf, byte(v)) }
+
+func (e *kafkaEncoder) int16(v int16) { e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v)) }
+
+func (e *kafkaEncoder) int32(v int32) { e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v)) }
+
+func (e *kafkaEncoder) int64(v int64) { e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v)) }
+
+func (e *kafkaEncoder) varint(v int64) { e.buf = binary.AppendVarint(e.buf, v) }
+
+func (e *kafkaEncoder) bool(v bool) {
+	if v {
+		e.int8(1)
+	} else {
+		e.int8(0)
+	}
+}
+
+func (e *kafkaEncoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *kafkaEncoder) nullableString(s *string) {
+	if s == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*s)
+}
+
+func (e *kafkaEncoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// kafkaDecoder читает примитивы протокола Kafka; первая ошибка запоминается в err,
+// после неё все чтения возвращают нулевые значения
+type kafkaDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *kafkaDecoder) bool() bool { return d.int8() != 0 }
+
+func (d *kafkaDecoder) string() string {
+	return string(d.next(int(d.int16())))
+}
+
+func (d *kafkaDecoder) nullableString() *string {
+	size := d.int16()
+	if size < 0 {
+		return nil
+	}
+	s := string(d.next(int(size)))
+	return &s
+}
+
+func (d *kafkaDecoder) bytes() []byte {
+	size := d.int32()
+	if size < 0 {
+		return nil
+	}
+	return d.next(int(size))
+}
+
+func (d *kafkaDecoder) int32Array() []int32 {
+	var values []int32
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		values = append(values, d.int32())
+	}
+	return values
+}
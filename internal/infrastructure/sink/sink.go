@@ -0,0 +1,119 @@
+// internal/infrastructure/sink/sink.go
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Sink получатель экспортируемых событий (брокер сообщений, вебхук, файл и т.п.)
+type Sink interface {
+	// Publish отправляет событие получателю
+	Publish(ctx context.Context, event Event) error
+	// Close освобождает ресурсы получателя
+	Close() error
+}
+
+// encodeEvent сериализует событие в JSON одной строкой
+func encodeEvent(event Event) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return data, nil
+}
+
+// multiSink рассылает событие во все вложенные получатели
+type multiSink struct {
+	sinks []Sink
+}
+
+// NewMulti объединяет несколько получателей в один.
+// Ошибка одного получателя не мешает доставке в остальные.
+func NewMulti(sinks ...Sink) Sink {
+	return &multiSink{sinks: sinks}
+}
+
+func (m *multiSink) Publish(ctx context.Context, event Event) error {
+	var errs []error
+	for _, s := range m.sinks {
+		if err := s.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *multiSink) Close() error {
+	var errs []error
+	for _, s := range m.sinks {
+		if err := s.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// asyncSink публикует события в фоне через ограниченную очередь,
+// чтобы медленный получатель не задерживал ответы gRPC.
+type asyncSink struct {
+	next   Sink
+	queue  chan Event
+	wg     sync.WaitGroup
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsync оборачивает получателя фоновой очередью размера bufferSize.
+// При переполнении очереди событие отбрасывается с записью в лог.
+func NewAsync(next Sink, bufferSize int) Sink {
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	a := &asyncSink{
+		next:  next,
+		queue: make(chan Event, bufferSize),
+	}
+	a.wg.Add(1)
+	go a.run()
+	return a
+}
+
+func (a *asyncSink) run() {
+	defer a.wg.Done()
+	for event := range a.queue {
+		if err := a.next.Publish(context.Background(), event); err != nil {
+			log.Printf("Failed to publish event %s (%s): %v", event.ID, event.Type, err)
+		}
+	}
+}
+
+func (a *asyncSink) Publish(_ context.Context, event Event) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return errors.New("sink is closed")
+	}
+	select {
+	case a.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("sink queue is full, event %s dropped", event.ID)
+	}
+}
+
+// Close дожидается отправки событий из очереди и закрывает вложенного получателя
+func (a *asyncSink) Close() error {
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mu.Unlock()
+	a.wg.Wait()
+	return a.next.Close()
+}
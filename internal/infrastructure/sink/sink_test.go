@@ -0,0 +1,372 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+func testRoutesEvent() Event {
+	departure := time.Date(2025, 2, 13, 0, 12, 0, 0, time.UTC)
+	return NewRoutesEvent(
+		domain.GetTrainRoutesParams{FromCode: 2004000, ToCode: 2000000, TrainType: domain.AllTrains, FromDate: departure, Language: "en"},
+		[]domain.TrainRoute{{
+			TrainNumber: "119А",
+			Duration:    9*time.Hour + 35*time.Minute,
+			Carrier:     domain.Carrier{Name: "ФПК"},
+			From:        domain.Station{Code: 2004001, Name: "САНКТ-ПЕТЕРБУРГ-ГЛАВН."},
+			To:          domain.Station{Code: 2001025, Name: "МОСКВА ВК ВОСТОЧНЫЙ"},
+			Departure:   departure,
+			Arrival:     departure.Add(9*time.Hour + 35*time.Minute),
+			CarTypes:    []domain.CarriageType{{Type: domain.Coupe, TypeLabel: "Купе", Class: "2К", Tariff: 2533, FreeSeats: 132}},
+		}},
+	)
+}
+
+// fakeNATSServer in-process заглушка NATS: принимает одно соединение и собирает PUB сообщения
+type fakeNATSServer struct {
+	listener net.Listener
+	messages chan [2]string // subject, payload
+}
+
+func startFakeNATSServer(t *testing.T) *fakeNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &fakeNATSServer{listener: listener, messages: make(chan [2]string, 10)}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte("INFO {\"server_id\":\"fake\"}\r\n"))
+		_, _ = conn.Write([]byte("PING\r\n"))
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) != 3 || fields[0] != "PUB" {
+				continue
+			}
+			size, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			srv.messages <- [2]string{fields[1], string(payload[:size])}
+		}
+	}()
+	return srv
+}
+
+func TestBrokerSinkPublishesToNATS(t *testing.T) {
+	srv := startFakeNATSServer(t)
+	publisher, err := NewNATSPublisher("nats://"+srv.listener.Addr().String(), "test")
+	require.NoError(t, err)
+	s := NewBrokerSink(publisher, "rzd")
+	defer s.Close()
+
+	event := testRoutesEvent()
+	require.NoError(t, s.Publish(context.Background(), event))
+
+	select {
+	case msg := <-srv.messages:
+		require.Equal(t, "rzd.train_routes", msg[0])
+		var decoded Event
+		require.NoError(t, json.Unmarshal([]byte(msg[1]), &decoded))
+		require.Equal(t, SchemaVersion, decoded.SchemaVersion)
+		require.Equal(t, event.ID, decoded.ID)
+		require.Equal(t, "en", decoded.Routes.Query.Language)
+		require.Len(t, decoded.Routes.Routes, 1)
+		require.Equal(t, int64(575), decoded.Routes.Routes[0].DurationMinutes)
+	case <-time.After(2 * time.Second):
+		t.Fatal("message was not delivered to NATS")
+	}
+}
+
+// kafkaMessage сообщение, принятое заглушкой Kafka
+type kafkaMessage struct {
+	topic     string
+	partition int32
+	value     []byte
+}
+
+// kafkaNoLeader раздел заглушки Kafka без лидера
+const kafkaNoLeader int32 = -1
+
+// startFakeKafkaBroker in-process заглушка брокера Kafka (узел 7) с топиком из разделов с указанными лидерами:
+// отвечает на Metadata и Produce, отклоняет запись в раздел без лидера и собирает сообщения из record batch
+func startFakeKafkaBroker(t *testing.T, leaders ...int32) (string, chan kafkaMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	host, portText, _ := net.SplitHostPort(listener.Addr().String())
+	port, _ := strconv.Atoi(portText)
+	messages := make(chan kafkaMessage, 10)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var size [4]byte
+			if _, err := io.ReadFull(conn, size[:]); err != nil {
+				return
+			}
+			frame := make([]byte, binary.BigEndian.Uint32(size[:]))
+			if _, err := io.ReadFull(conn, frame); err != nil {
+				return
+			}
+			d := kafkaDecoder{buf: frame}
+			apiKey, _ := d.int16(), d.int16()
+			correlation := d.int32()
+			d.nullableString()
+
+			resp := newKafkaEncoder()
+			resp.int32(0)
+			resp.int32(correlation)
+			switch apiKey {
+			case kafkaAPIMetadata:
+				d.int32()
+				topic := d.string()
+				resp.int32(0) // throttle
+				resp.int32(1)
+				resp.int32(7)
+				resp.string(host)
+				resp.int32(int32(port))
+				resp.nullableString(nil)
+				resp.nullableString(nil)
+				resp.int32(7)
+				resp.int32(1)
+				resp.int16(0)
+				resp.string(topic)
+				resp.bool(false)
+				resp.int32(int32(len(leaders)))
+				for partition, leader := range leaders {
+					resp.int16(0)
+					resp.int32(int32(partition))
+					resp.int32(leader)
+					resp.int32(0)
+					resp.int32(0)
+				}
+			case kafkaAPIProduce:
+				d.nullableString()
+				d.int16()
+				d.int32()
+				d.int32()
+				topic := d.string()
+				d.int32()
+				partition := d.int32()
+				batch := kafkaDecoder{buf: d.bytes()}
+				batch.int64()
+				batch.int32()
+				batch.int32()
+				batch.int8()
+				crc := uint32(batch.int32())
+				if crc != crc32.Checksum(batch.buf, crc32c) {
+					return
+				}
+				batch.next(2 + 4 + 8 + 8 + 8 + 2 + 4 + 4)
+				batch.varint()
+				batch.int8()
+				batch.varint()
+				batch.varint()
+				batch.varint()
+				value := batch.next(int(batch.varint()))
+				code := kafkaErrNotLeaderOrFollower
+				if partition >= 0 && int(partition) < len(leaders) && leaders[partition] == 7 {
+					code = 0
+					messages <- kafkaMessage{topic: topic, partition: partition, value: value}
+				}
+
+				resp.int32(1)
+				resp.string(topic)
+				resp.int32(1)
+				resp.int32(partition)
+				resp.int16(code)
+				resp.int64(0)
+				resp.int64(-1)
+				resp.int32(0)
+			default:
+				return
+			}
+			binary.BigEndian.PutUint32(resp.buf, uint32(len(resp.buf)-4))
+			if _, err := conn.Write(resp.buf); err != nil {
+				return
+			}
+		}
+	}()
+	return listener.Addr().String(), messages
+}
+
+func TestBrokerSinkPublishesToKafka(t *testing.T) {
+	addr, messages := startFakeKafkaBroker(t, 7, 7)
+	publisher, err := NewKafkaPublisher(addr, "test")
+	require.NoError(t, err)
+	s := NewBrokerSink(publisher, "rzd")
+	defer s.Close()
+
+	event := testRoutesEvent()
+	for partition := int32(0); partition < 2; partition++ {
+		require.NoError(t, s.Publish(context.Background(), event))
+		select {
+		case msg := <-messages:
+			require.Equal(t, "rzd.train_routes", msg.topic)
+			require.Equal(t, partition, msg.partition, "messages go round-robin over partitions")
+			var decoded Event
+			require.NoError(t, json.Unmarshal(msg.value, &decoded))
+			require.Equal(t, event.ID, decoded.ID)
+		case <-time.After(2 * time.Second):
+			t.Fatal("message was not delivered to Kafka")
+		}
+	}
+}
+
+// Раздел без лидера пропускается, а сообщения уходят в разделы с их настоящими номерами
+func TestKafkaPublisherSkipsLeaderlessPartitions(t *testing.T) {
+	addr, messages := startFakeKafkaBroker(t, kafkaNoLeader, 7, 7)
+	publisher, err := NewKafkaPublisher(addr, "test")
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	for _, partition := range []int32{1, 2, 1} {
+		require.NoError(t, publisher.Publish(context.Background(), "rzd.train_routes", []byte(`{}`)))
+		select {
+		case msg := <-messages:
+			require.Equal(t, partition, msg.partition)
+		case <-time.After(2 * time.Second):
+			t.Fatal("message was not delivered to Kafka")
+		}
+	}
+}
+
+func TestWebhookSinkRetriesAndSigns(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		expected := Sign("secret", r.Header.Get(HeaderTimestamp), body)
+		if r.Header.Get(HeaderSignature) != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(WebhookConfig{URL: srv.URL, Secret: "secret", MaxRetries: 2, Backoff: time.Millisecond})
+	require.NoError(t, s.Publish(context.Background(), testRoutesEvent()))
+	require.Equal(t, int32(2), attempts.Load())
+}
+
+func TestWebhookSinkDoesNotRetryClientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(WebhookConfig{URL: srv.URL, MaxRetries: 3, Backoff: time.Millisecond})
+	require.Error(t, s.Publish(context.Background(), testRoutesEvent()))
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestFileSinkWritesNDJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	fileSink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	s := NewAsync(fileSink, 4)
+	require.NoError(t, s.Publish(context.Background(), testRoutesEvent()))
+	require.NoError(t, s.Publish(context.Background(), NewCarriagesEvent(domain.GetTrainCarriagesParams{TrainNumber: "119А", Language: "ru"}, nil)))
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var second Event
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	require.Equal(t, EventTrainCarriages, second.Type)
+	require.Equal(t, "119А", second.Carriages.Query.TrainNumber)
+	require.Equal(t, "ru", second.Carriages.Query.Language)
+}
+
+// blockingSink получатель, который не отвечает, пока не закрыт release
+type blockingSink struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingSink) Publish(context.Context, Event) error {
+	s.started <- struct{}{}
+	<-s.release
+	return nil
+}
+
+func (s *blockingSink) Close() error { return nil }
+
+// recordingSink получатель, передающий события в канал
+type recordingSink struct {
+	events chan Event
+}
+
+func (s *recordingSink) Publish(_ context.Context, event Event) error {
+	s.events <- event
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+// Зависший получатель не задерживает доставку остальным: у каждого своя очередь
+func TestQueuedSinksDeliverIndependently(t *testing.T) {
+	slow := &blockingSink{started: make(chan struct{}, 1), release: make(chan struct{})}
+	fast := &recordingSink{events: make(chan Event, 2)}
+	s := newQueued([]Sink{slow, fast}, 4)
+
+	first, second := testRoutesEvent(), testRoutesEvent()
+	require.NoError(t, s.Publish(context.Background(), first))
+	require.NoError(t, s.Publish(context.Background(), second))
+	<-slow.started
+	for _, expected := range []Event{first, second} {
+		select {
+		case event := <-fast.events:
+			require.Equal(t, expected.ID, event.ID)
+		case <-time.After(2 * time.Second):
+			t.Fatal("event was held up by the slow sink")
+		}
+	}
+
+	close(slow.release)
+	<-slow.started
+	require.NoError(t, s.Close())
+}
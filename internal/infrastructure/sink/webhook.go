@@ -0,0 +1,135 @@
+// internal/infrastructure/sink/webhook.go
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Заголовки запроса вебхука
+const (
+	HeaderSignature     = "X-Rzd-Signature"
+	HeaderTimestamp     = "X-Rzd-Timestamp"
+	HeaderEventType     = "X-Rzd-Event"
+	HeaderEventID       = "X-Rzd-Event-Id"
+	HeaderSchemaVersion = "X-Rzd-Schema-Version"
+)
+
+// WebhookConfig параметры отправки событий на HTTP-вебхук
+type WebhookConfig struct {
+	URL        string        // Адрес вебхука
+	Secret     string        // Секрет для HMAC-подписи; пустой - без подписи
+	MaxRetries int           // Количество повторов при временных ошибках
+	Backoff    time.Duration // Начальная задержка между повторами (удваивается)
+	Timeout    time.Duration // Таймаут одного запроса
+}
+
+// WebhookSink отправляет события POST-запросом на вебхук
+type WebhookSink struct {
+	config     WebhookConfig
+	httpClient *http.Client
+}
+
+// NewWebhookSink создаёт получателя событий для HTTP-вебхука
+func NewWebhookSink(cfg WebhookConfig) *WebhookSink {
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = 500 * time.Millisecond
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &WebhookSink{
+		config:     cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Sign вычисляет подпись тела запроса: "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)).
+// Временная метка входит в подпись, чтобы получатель мог отбрасывать повторно отправленные запросы.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Publish отправляет событие, повторяя запрос при сетевых ошибках, 429 и 5xx
+func (s *WebhookSink) Publish(ctx context.Context, event Event) error {
+	body, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+
+	var lastError error
+	delay := s.config.Backoff
+	for attempt := 0; attempt <= s.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		retry, err := s.send(ctx, event, body)
+		if err == nil {
+			return nil
+		}
+		lastError = err
+		if !retry {
+			break
+		}
+	}
+	return fmt.Errorf("webhook delivery of event %s failed: %w", event.ID, lastError)
+}
+
+// send выполняет одну попытку доставки и сообщает, имеет ли смысл её повторить
+func (s *WebhookSink) send(ctx context.Context, event Event, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderEventType, string(event.Type))
+	req.Header.Set(HeaderEventID, event.ID)
+	req.Header.Set(HeaderSchemaVersion, event.SchemaVersion)
+	if s.config.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(s.config.Secret, timestamp, body))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		return true, err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("webhook rejected event with %d", resp.StatusCode)
+	}
+}
+
+// Close закрывает простаивающие HTTP-соединения
+func (s *WebhookSink) Close() error {
+	s.httpClient.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,146 @@
+// internal/infrastructure/state/cookies.go
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"time"
+)
+
+// sessionCookieTTL сколько хранятся cookie без срока действия (сессионные)
+const sessionCookieTTL = 24 * time.Hour
+
+// CookieJar хранилище cookie, которое дублирует cookie хоста в Store, чтобы реплики
+// работали с РЖД в одной сессии. Правила домена, пути и срока действия применяет локальный
+// cookiejar.Jar, в который перед каждым запросом подгружаются общие cookie хоста.
+// В отличие от http.CookieJar методы принимают контекст запроса, и обращения к хранилищу
+// отменяются вместе с ним. Ошибки хранилища логируются, запрос продолжается с локальными cookie.
+type CookieJar struct {
+	local  *cookiejar.Jar
+	store  Store
+	prefix string
+	now    func() time.Time
+}
+
+// storedCookie cookie в хранилище
+type storedCookie struct {
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	Path     string    `json:"path,omitempty"`
+	Domain   string    `json:"domain,omitempty"`
+	Expires  time.Time `json:"expires,omitempty"`
+	Secure   bool      `json:"secure,omitempty"`
+	HttpOnly bool      `json:"httpOnly,omitempty"`
+}
+
+// NewCookieJar создаёт общий CookieJar с ключами вида "<prefix><хост>"
+func NewCookieJar(store Store, prefix string) (*CookieJar, error) {
+	local, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CookieJar: %v", err)
+	}
+	return &CookieJar{local: local, store: store, prefix: prefix, now: time.Now}, nil
+}
+
+// SetCookies сохраняет cookie ответа локально и в хранилище
+func (j *CookieJar) SetCookies(ctx context.Context, u *url.URL, cookies []*http.Cookie) {
+	j.local.SetCookies(u, cookies)
+	if len(cookies) == 0 {
+		return
+	}
+	stored, err := j.load(ctx, u)
+	if err != nil {
+		log.Printf("Failed to load shared cookies for %s: %v", u.Hostname(), err)
+	}
+	now := j.now()
+	for _, cookie := range cookies {
+		stored = mergeCookie(stored, cookie, now)
+	}
+	if err := j.save(ctx, u, stored, now); err != nil {
+		log.Printf("Failed to save shared cookies for %s: %v", u.Hostname(), err)
+	}
+}
+
+// Cookies возвращает cookie для запроса с учётом полученных другими репликами
+func (j *CookieJar) Cookies(ctx context.Context, u *url.URL) []*http.Cookie {
+	stored, err := j.load(ctx, u)
+	if err != nil {
+		log.Printf("Failed to load shared cookies for %s: %v", u.Hostname(), err)
+	}
+	if len(stored) > 0 {
+		cookies := make([]*http.Cookie, 0, len(stored))
+		for _, c := range stored {
+			cookies = append(cookies, &http.Cookie{
+				Name: c.Name, Value: c.Value, Path: c.Path, Domain: c.Domain,
+				Expires: c.Expires, Secure: c.Secure, HttpOnly: c.HttpOnly,
+			})
+		}
+		j.local.SetCookies(u, cookies)
+	}
+	return j.local.Cookies(u)
+}
+
+// load читает cookie хоста из хранилища
+func (j *CookieJar) load(ctx context.Context, u *url.URL) ([]storedCookie, error) {
+	data, ok, err := j.store.Get(ctx, j.prefix+u.Hostname())
+	if err != nil || !ok {
+		return nil, err
+	}
+	var stored []storedCookie
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode cookies: %w", err)
+	}
+	return stored, nil
+}
+
+// save записывает cookie хоста, пока не истечёт последняя из них
+func (j *CookieJar) save(ctx context.Context, u *url.URL, stored []storedCookie, now time.Time) error {
+	key := j.prefix + u.Hostname()
+	if len(stored) == 0 {
+		return j.store.Delete(ctx, key)
+	}
+	ttl := time.Duration(0)
+	for _, c := range stored {
+		cookieTTL := sessionCookieTTL
+		if !c.Expires.IsZero() {
+			cookieTTL = c.Expires.Sub(now)
+		}
+		ttl = max(ttl, cookieTTL)
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	return j.store.Set(ctx, key, data, ttl)
+}
+
+// mergeCookie заменяет cookie с тем же именем, путём и доменом (или удаляет её, если срок истёк)
+// и отбрасывает истёкшие
+func mergeCookie(stored []storedCookie, cookie *http.Cookie, now time.Time) []storedCookie {
+	expires := cookie.Expires
+	if cookie.MaxAge > 0 {
+		expires = now.Add(time.Duration(cookie.MaxAge) * time.Second)
+	}
+	removed := cookie.MaxAge < 0 || (!expires.IsZero() && !expires.After(now))
+
+	merged := stored[:0]
+	for _, c := range stored {
+		same := c.Name == cookie.Name && c.Path == cookie.Path && c.Domain == cookie.Domain
+		expired := !c.Expires.IsZero() && !c.Expires.After(now)
+		if !same && !expired {
+			merged = append(merged, c)
+		}
+	}
+	if removed {
+		return merged
+	}
+	return append(merged, storedCookie{
+		Name: cookie.Name, Value: cookie.Value, Path: cookie.Path, Domain: cookie.Domain,
+		Expires: expires, Secure: cookie.Secure, HttpOnly: cookie.HttpOnly,
+	})
+}
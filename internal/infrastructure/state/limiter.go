@@ -0,0 +1,39 @@
+// internal/infrastructure/state/limiter.go
+package state
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limiter ограничивает частоту событий по ключу через счётчики окон в Store,
+// поэтому лимит общий для всех реплик, подключённых к одному хранилищу.
+// За окно длиной burst/rps пропускается burst событий: в среднем это rps в секунду
+// с всплеском до burst, как у корзины токенов.
+type Limiter struct {
+	store  Store
+	prefix string
+}
+
+// NewLimiter создаёт ограничитель с ключами вида "<prefix><ключ>"
+func NewLimiter(store Store, prefix string) *Limiter {
+	return &Limiter{store: store, prefix: prefix}
+}
+
+// Allow учитывает событие ключа. Если лимит исчерпан, возвращает false и время до начала следующего окна.
+// При rps <= 0 ограничение отключено. burst < 1 заменяется на rps, округлённое вверх.
+func (l *Limiter) Allow(ctx context.Context, key string, rps float64, burst int) (bool, time.Duration, error) {
+	if rps <= 0 {
+		return true, 0, nil
+	}
+	if burst < 1 {
+		burst = int(math.Ceil(rps))
+	}
+	window := time.Duration(float64(burst) / rps * float64(time.Second))
+	count, remaining, err := l.store.Incr(ctx, l.prefix+key, window)
+	if err != nil {
+		return false, 0, err
+	}
+	return count <= int64(burst), remaining, nil
+}
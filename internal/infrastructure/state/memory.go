@@ -0,0 +1,98 @@
+// internal/infrastructure/state/memory.go
+package state
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval как часто из памяти удаляются истёкшие ключи
+const memorySweepInterval = time.Minute
+
+// MemoryStore хранилище в памяти процесса (одна реплика)
+type MemoryStore struct {
+	mutex     sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// memoryEntry значение ключа и время его истечения
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryStore создаёт пустое хранилище в памяти
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry), now: time.Now}
+}
+
+// Get возвращает значение ключа, если он не истёк
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entry, ok := s.entries[key]
+	if !ok || !s.now().Before(entry.expiresAt) {
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set сохраняет значение на ttl и попутно удаляет истёкшие ключи
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	s.sweep(now)
+	s.entries[key] = memoryEntry{value: value, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Delete удаляет ключ
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// Incr увеличивает счётчик окна
+func (s *MemoryStore) Incr(_ context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	s.sweep(now)
+
+	entry, ok := s.entries[key]
+	var count int64
+	if ok && now.Before(entry.expiresAt) {
+		count, _ = strconv.ParseInt(string(entry.value), 10, 64)
+	} else {
+		entry.expiresAt = now.Add(window)
+	}
+	count++
+	entry.value = []byte(strconv.FormatInt(count, 10))
+	s.entries[key] = entry
+	return count, entry.expiresAt.Sub(now), nil
+}
+
+// Close ничего не делает
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// sweep удаляет истёкшие ключи не чаще memorySweepInterval. Вызывается под мьютексом.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// internal/infrastructure/state/redis.go
+package state
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redisPoolSize сколько соединений с Redis может быть открыто одновременно
+const redisPoolSize = 8
+
+// incrWindowScript атомарно увеличивает счётчик окна и задаёт срок жизни, если его нет
+// (новый ключ или ключ, оставшийся без срока). Возвращает {значение, оставшиеся мс}.
+const incrWindowScript = `local count = redis.call('INCR', KEYS[1])
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < 0 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+  ttl = tonumber(ARGV[1])
+end
+return {count, ttl}`
+
+// RedisStore хранилище поверх Redis (или совместимого сервера: Valkey, KeyDB, Dragonfly).
+// Минимальный клиент протокола RESP2 с небольшим пулом соединений: соединения
+// устанавливаются по мере надобности, после сетевой ошибки соединение закрывается
+// и следующая команда открывает новое. Все ключи получают общий префикс.
+type RedisStore struct {
+	addr     string
+	user     string
+	password string
+	db       int
+	prefix   string
+	timeout  time.Duration
+
+	idle  chan *redisConn // Свободные соединения
+	slots chan struct{}   // Занятые слоты пула: открытые соединения, включая свободные
+
+	mutex  sync.Mutex
+	closed bool
+}
+
+// redisConn соединение пула с буферами чтения и записи
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+// redisError ответ сервера с ошибкой (-ERR ...)
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// respValue ответ RESP2: строка, число, массив или null
+type respValue struct {
+	str   string
+	num   int64
+	array []respValue
+	null  bool
+}
+
+// NewRedisStore создаёт клиента для адреса вида redis://[user:password@]host[:6379][/db].
+// timeout ограничивает установку соединения и каждую команду без собственного дедлайна.
+func NewRedisStore(rawURL, prefix string, timeout time.Duration) (*RedisStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %w", err)
+	}
+	if u.Scheme != "redis" {
+		return nil, fmt.Errorf("invalid Redis URL: unsupported scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid Redis URL: host is empty")
+	}
+	s := &RedisStore{
+		addr:    u.Host,
+		prefix:  prefix,
+		timeout: timeout,
+		idle:    make(chan *redisConn, redisPoolSize),
+		slots:   make(chan struct{}, redisPoolSize),
+	}
+	if u.Port() == "" {
+		s.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	if u.User != nil {
+		s.user = u.User.Username()
+		s.password, _ = u.User.Password()
+	}
+	if db := strings.Trim(u.Path, "/"); db != "" {
+		if s.db, err = strconv.Atoi(db); err != nil || s.db < 0 {
+			return nil, fmt.Errorf("invalid Redis URL: bad database %q", db)
+		}
+	}
+	return s, nil
+}
+
+// Get возвращает значение ключа
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	replies, err := s.do(ctx, []string{"GET", s.prefix + key})
+	if err != nil {
+		return nil, false, err
+	}
+	if replies[0].null {
+		return nil, false, nil
+	}
+	return []byte(replies[0].str), true, nil
+}
+
+// Set сохраняет значение на ttl
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := s.do(ctx, []string{"SET", s.prefix + key, string(value), "PX", milliseconds(ttl)})
+	return err
+}
+
+// Delete удаляет ключ
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	_, err := s.do(ctx, []string{"DEL", s.prefix + key})
+	return err
+}
+
+// Incr увеличивает счётчик окна. Увеличение и установка срока жизни выполняются одним
+// Lua-скриптом, поэтому счётчик не остаётся без срока, даже если реплика упадёт посреди вызова.
+func (s *RedisStore) Incr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	replies, err := s.do(ctx, []string{"EVAL", incrWindowScript, "1", s.prefix + key, milliseconds(window)})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(replies[0].array) != 2 {
+		return 0, 0, fmt.Errorf("redis EVAL: unexpected reply")
+	}
+	return replies[0].array[0].num, time.Duration(replies[0].array[1].num) * time.Millisecond, nil
+}
+
+// Close закрывает свободные соединения; занятые закрываются по завершении команды
+func (s *RedisStore) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	s.mutex.Unlock()
+
+	var errs []error
+	for {
+		select {
+		case c := <-s.idle:
+			if err := c.conn.Close(); err != nil {
+				errs = append(errs, err)
+			}
+			<-s.slots
+		default:
+			return errors.Join(errs...)
+		}
+	}
+}
+
+// do отправляет команды одним пакетом через соединение из пула и читает ответ на каждую.
+// Ошибка сервера в ответе на любую из команд возвращается как redisError.
+func (s *RedisStore) do(ctx context.Context, commands ...[]string) ([]respValue, error) {
+	c, err := s.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := c.roundTrip(ctx, s.timeout, commands)
+	var serverErr redisError
+	if err != nil && !errors.As(err, &serverErr) {
+		s.release(c, true)
+		return nil, fmt.Errorf("redis %s failed: %w", commands[0][0], err)
+	}
+	s.release(c, false)
+	return replies, err
+}
+
+// acquire берёт свободное соединение или открывает новое, если пул не заполнен.
+// Когда все соединения заняты, ждёт освобождения одного из них или отмены ctx.
+func (s *RedisStore) acquire(ctx context.Context) (*redisConn, error) {
+	s.mutex.Lock()
+	closed := s.closed
+	s.mutex.Unlock()
+	if closed {
+		return nil, errors.New("redis store is closed")
+	}
+
+	select {
+	case c := <-s.idle:
+		return c, nil
+	default:
+	}
+	select {
+	case c := <-s.idle:
+		return c, nil
+	case s.slots <- struct{}{}:
+		c, err := s.connect(ctx)
+		if err != nil {
+			<-s.slots
+			return nil, err
+		}
+		return c, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// release возвращает соединение в пул. Сломанное соединение (или любое после Close) закрывается.
+func (s *RedisStore) release(c *redisConn, broken bool) {
+	s.mutex.Lock()
+	closed := s.closed
+	s.mutex.Unlock()
+	if broken || closed {
+		_ = c.conn.Close()
+		<-s.slots
+		return
+	}
+	s.idle <- c
+}
+
+// connect устанавливает соединение, выполняет AUTH и SELECT
+func (s *RedisStore) connect(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+	c := &redisConn{conn: conn, reader: bufio.NewReader(conn), writer: bufio.NewWriter(conn)}
+
+	var handshake [][]string
+	switch {
+	case s.user != "" && s.password != "":
+		handshake = append(handshake, []string{"AUTH", s.user, s.password})
+	case s.password != "":
+		handshake = append(handshake, []string{"AUTH", s.password})
+	}
+	if s.db != 0 {
+		handshake = append(handshake, []string{"SELECT", strconv.Itoa(s.db)})
+	}
+	if len(handshake) == 0 {
+		return c, nil
+	}
+	if _, err := c.roundTrip(ctx, s.timeout, handshake); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to set up Redis connection: %w", err)
+	}
+	return c, nil
+}
+
+// roundTrip записывает команды и читает ответы. Без дедлайна в ctx команды ограничены timeout.
+func (c *redisConn) roundTrip(ctx context.Context, timeout time.Duration, commands [][]string) ([]respValue, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(timeout)
+	}
+	_ = c.conn.SetDeadline(deadline)
+	defer func() { _ = c.conn.SetDeadline(time.Time{}) }()
+
+	for _, args := range commands {
+		if err := writeCommand(c.writer, args); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	replies := make([]respValue, len(commands))
+	var firstErr error
+	for i := range commands {
+		reply, err := readReply(c.reader)
+		var serverErr redisError
+		if err != nil && !errors.As(err, &serverErr) {
+			return nil, err
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		replies[i] = reply
+	}
+	return replies, firstErr
+}
+
+// writeCommand записывает команду массивом bulk-строк
+func writeCommand(w *bufio.Writer, args []string) error {
+	if _, err := fmt.Fprintf(w, "*%d\r\n", len(args)); err != nil {
+		return err
+	}
+	for _, arg := range args {
+		if _, err := fmt.Fprintf(w, "$%d\r\n%s\r\n", len(arg), arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readReply читает один ответ RESP2
+func readReply(r *bufio.Reader) (respValue, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return respValue{}, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return respValue{}, fmt.Errorf("malformed reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return respValue{str: line[1:]}, nil
+	case '-':
+		return respValue{}, redisError(line[1:])
+	case ':':
+		n, err := strconv.ParseInt(line[1:], 10, 64)
+		if err != nil {
+			return respValue{}, fmt.Errorf("malformed integer reply %q", line)
+		}
+		return respValue{num: n}, nil
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return respValue{}, fmt.Errorf("malformed bulk reply %q", line)
+		}
+		if n < 0 {
+			return respValue{null: true}, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return respValue{}, err
+		}
+		return respValue{str: string(buf[:n])}, nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return respValue{}, fmt.Errorf("malformed array reply %q", line)
+		}
+		if n < 0 {
+			return respValue{null: true}, nil
+		}
+		value := respValue{array: make([]respValue, n)}
+		for i := range value.array {
+			if value.array[i], err = readReply(r); err != nil {
+				return respValue{}, err
+			}
+		}
+		return value, nil
+	default:
+		return respValue{}, fmt.Errorf("unexpected reply %q", line)
+	}
+}
+
+// milliseconds переводит длительность в аргумент PX (не меньше 1 мс)
+func milliseconds(d time.Duration) string {
+	ms := d.Milliseconds()
+	if ms < 1 {
+		ms = 1
+	}
+	return strconv.FormatInt(ms, 10)
+}
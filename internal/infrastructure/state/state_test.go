@@ -0,0 +1,416 @@
+package state
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedisServer in-process заглушка Redis: команды GET, SET (PX, NX), DEL, INCR, PTTL, AUTH, SELECT
+// и EVAL скрипта окна счётчика, который выполняется атомарно, как в Redis
+type fakeRedisServer struct {
+	listener net.Listener
+	password string
+
+	mutex   sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+	conns   []net.Conn
+}
+
+func startFakeRedisServer(t *testing.T, password string) *fakeRedisServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &fakeRedisServer{
+		listener: listener,
+		password: password,
+		values:   make(map[string]string),
+		expires:  make(map[string]time.Time),
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+		srv.dropConns()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			srv.mutex.Lock()
+			srv.conns = append(srv.conns, conn)
+			srv.mutex.Unlock()
+			go srv.serve(conn)
+		}
+	}()
+	return srv
+}
+
+func (s *fakeRedisServer) url() string {
+	if s.password != "" {
+		return "redis://:" + s.password + "@" + s.listener.Addr().String() + "/2"
+	}
+	return "redis://" + s.listener.Addr().String()
+}
+
+// dropConns разрывает все соединения, как при перезапуске сервера
+func (s *fakeRedisServer) dropConns() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.conns = nil
+}
+
+func (s *fakeRedisServer) keys() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var keys []string
+	for key := range s.values {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *fakeRedisServer) serve(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	authenticated := s.password == ""
+	for {
+		reply, err := readReply(reader)
+		if err != nil {
+			return
+		}
+		args := make([]string, len(reply.array))
+		for i, arg := range reply.array {
+			args[i] = arg.str
+		}
+		var response string
+		switch {
+		case strings.EqualFold(args[0], "AUTH"):
+			authenticated = args[len(args)-1] == s.password
+			response = "+OK\r\n"
+			if !authenticated {
+				response = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			response = "-NOAUTH Authentication required\r\n"
+		default:
+			response = s.execute(args)
+		}
+		if _, err := conn.Write([]byte(response)); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeRedisServer) execute(args []string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.executeLocked(args)
+}
+
+func (s *fakeRedisServer) executeLocked(args []string) string {
+	key := ""
+	if strings.EqualFold(args[0], "EVAL") && len(args) > 3 {
+		key = args[3]
+	} else if len(args) > 1 {
+		key = args[1]
+	}
+	if key != "" {
+		if expiresAt, ok := s.expires[key]; ok && !time.Now().Before(expiresAt) {
+			delete(s.values, key)
+			delete(s.expires, key)
+		}
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, ok := s.values[key]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	case "SET":
+		if _, exists := s.values[key]; exists && len(args) > 5 && strings.EqualFold(args[5], "NX") {
+			return "$-1\r\n"
+		}
+		s.values[key] = args[2]
+		delete(s.expires, key)
+		if len(args) > 4 && strings.EqualFold(args[3], "PX") {
+			ms, _ := strconv.Atoi(args[4])
+			s.expires[key] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		}
+		return "+OK\r\n"
+	case "DEL":
+		_, ok := s.values[key]
+		delete(s.values, key)
+		delete(s.expires, key)
+		if ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	case "INCR":
+		var n int64
+		if value, ok := s.values[key]; ok {
+			var err error
+			if n, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return "-ERR value is not an integer\r\n"
+			}
+		}
+		n++
+		s.values[key] = strconv.FormatInt(n, 10)
+		return ":" + strconv.FormatInt(n, 10) + "\r\n"
+	case "PTTL":
+		if _, ok := s.values[key]; !ok {
+			return ":-2\r\n"
+		}
+		expiresAt, ok := s.expires[key]
+		if !ok {
+			return ":-1\r\n"
+		}
+		return ":" + strconv.FormatInt(time.Until(expiresAt).Milliseconds(), 10) + "\r\n"
+	case "EVAL":
+		if args[1] != incrWindowScript || len(args) != 5 {
+			return "-ERR unexpected script\r\n"
+		}
+		incr := s.executeLocked([]string{"INCR", key})
+		if strings.HasPrefix(incr, "-") {
+			return incr
+		}
+		ttl := int64(-1)
+		if expiresAt, ok := s.expires[key]; ok {
+			ttl = time.Until(expiresAt).Milliseconds()
+		}
+		if ttl < 0 {
+			ms, _ := strconv.Atoi(args[4])
+			s.expires[key] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+			ttl = int64(ms)
+		}
+		return "*2\r\n" + incr + ":" + strconv.FormatInt(ttl, 10) + "\r\n"
+	default:
+		return "-ERR unknown command '" + args[0] + "'\r\n"
+	}
+}
+
+// connCount количество соединений, принятых сервером
+func (s *fakeRedisServer) connCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.conns)
+}
+
+func TestStores(t *testing.T) {
+	srv := startFakeRedisServer(t, "secret")
+	redis, err := NewRedisStore(srv.url(), "test:", time.Second)
+	require.NoError(t, err)
+	defer redis.Close()
+
+	for name, store := range map[string]Store{"memory": NewMemoryStore(), "redis": redis} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, ok, err := store.Get(ctx, "rid")
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			require.NoError(t, store.Set(ctx, "rid", []byte("123\r\n456"), time.Minute))
+			value, ok, err := store.Get(ctx, "rid")
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, "123\r\n456", string(value))
+
+			require.NoError(t, store.Delete(ctx, "rid"))
+			_, ok, err = store.Get(ctx, "rid")
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			require.NoError(t, store.Set(ctx, "short", []byte("x"), 20*time.Millisecond))
+			time.Sleep(40 * time.Millisecond)
+			_, ok, err = store.Get(ctx, "short")
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			for want := int64(1); want <= 3; want++ {
+				count, remaining, err := store.Incr(ctx, "window", time.Minute)
+				require.NoError(t, err)
+				require.Equal(t, want, count)
+				require.True(t, remaining > 0 && remaining <= time.Minute, remaining)
+			}
+		})
+	}
+	require.Contains(t, srv.keys(), "test:window")
+}
+
+func TestRedisStoreReconnects(t *testing.T) {
+	srv := startFakeRedisServer(t, "")
+	store, err := NewRedisStore(srv.url(), "", time.Second)
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+	require.NoError(t, store.Set(ctx, "key", []byte("value"), time.Minute))
+
+	// Разрыв соединения: текущая команда завершается ошибкой, следующая - через новое соединение
+	srv.dropConns()
+	_, _, err = store.Get(ctx, "key")
+	require.Error(t, err)
+	value, ok, err := store.Get(ctx, "key")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "value", string(value))
+
+	// Ошибка команды не разрывает соединение
+	_, err = store.do(ctx, []string{"HGET", "key", "field"})
+	require.EqualError(t, err, "redis: ERR unknown command 'HGET'")
+
+	_, err = NewRedisStore("http://localhost:6379", "", time.Second)
+	require.Error(t, err)
+	bad, err := NewRedisStore("redis://:wrong@"+startFakeRedisServer(t, "secret").listener.Addr().String(), "", time.Second)
+	require.NoError(t, err)
+	_, _, err = bad.Get(ctx, "key")
+	require.ErrorContains(t, err, "WRONGPASS")
+}
+
+// Счётчик, оставшийся без срока жизни, получает срок при следующем увеличении
+func TestRedisStoreIncrRestoresTTL(t *testing.T) {
+	srv := startFakeRedisServer(t, "")
+	store, err := NewRedisStore(srv.url(), "", time.Second)
+	require.NoError(t, err)
+	defer store.Close()
+
+	srv.mutex.Lock()
+	srv.values["stuck"] = "5"
+	srv.mutex.Unlock()
+
+	count, remaining, err := store.Incr(context.Background(), "stuck", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), count)
+	require.Equal(t, time.Minute, remaining)
+	srv.mutex.Lock()
+	_, ok := srv.expires["stuck"]
+	srv.mutex.Unlock()
+	require.True(t, ok)
+}
+
+func TestRedisStorePool(t *testing.T) {
+	srv := startFakeRedisServer(t, "")
+	store, err := NewRedisStore(srv.url(), "", time.Second)
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+
+	// Параллельные команды идут через несколько соединений, но не больше размера пула
+	var wg sync.WaitGroup
+	errs := make(chan error, 4*redisPoolSize)
+	for i := 0; i < 4*redisPoolSize; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- store.Set(ctx, "key"+strconv.Itoa(i), []byte("value"), time.Minute)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, srv.connCount(), redisPoolSize)
+
+	// Когда все соединения заняты, ожидание прерывается контекстом вызывающего
+	var busy []*redisConn
+	for i := 0; i < redisPoolSize; i++ {
+		c, err := store.acquire(ctx)
+		require.NoError(t, err)
+		busy = append(busy, c)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, _, err = store.Get(timeoutCtx, "key0")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	for _, c := range busy {
+		store.release(c, false)
+	}
+	_, ok, err := store.Get(ctx, "key0")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, store.Close())
+	_, _, err = store.Get(ctx, "key0")
+	require.Error(t, err)
+}
+
+func TestLimiterSharedAcrossReplicas(t *testing.T) {
+	srv := startFakeRedisServer(t, "")
+	var limiters []*Limiter
+	for i := 0; i < 2; i++ {
+		store, err := NewRedisStore(srv.url(), "", time.Second)
+		require.NoError(t, err)
+		defer store.Close()
+		limiters = append(limiters, NewLimiter(store, "budget:"))
+	}
+
+	// Всплеск в 3 вызова делится между репликами
+	ctx := context.Background()
+	var allowed int
+	for i := 0; i < 6; i++ {
+		ok, wait, err := limiters[i%2].Allow(ctx, "rzd", 1, 3)
+		require.NoError(t, err)
+		if ok {
+			allowed++
+		} else {
+			require.True(t, wait > 0 && wait <= 3*time.Second, wait)
+		}
+	}
+	require.Equal(t, 3, allowed)
+
+	ok, _, err := limiters[0].Allow(ctx, "other", 1, 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, _, err = limiters[0].Allow(ctx, "rzd", 0, 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestCookieJarSharedAcrossReplicas(t *testing.T) {
+	store := NewMemoryStore()
+	first, err := NewCookieJar(store, "cookies:")
+	require.NoError(t, err)
+	second, err := NewCookieJar(store, "cookies:")
+	require.NoError(t, err)
+	u, _ := url.Parse("https://pass.rzd.ru/timetable/public/ru")
+	ctx := context.Background()
+
+	first.SetCookies(ctx, u, []*http.Cookie{{Name: "JSESSIONID", Value: "abc", Path: "/"}})
+	require.Equal(t, "abc", cookieValue(second.Cookies(ctx, u), "JSESSIONID"))
+
+	// Новое значение от РЖД видят все реплики, истёкшие cookie в хранилище не попадают
+	second.SetCookies(ctx, u, []*http.Cookie{
+		{Name: "JSESSIONID", Value: "def", Path: "/"},
+		{Name: "old", Value: "x", Path: "/", MaxAge: -1},
+	})
+	require.Equal(t, "def", cookieValue(first.Cookies(ctx, u), "JSESSIONID"))
+	require.Empty(t, cookieValue(first.Cookies(ctx, u), "old"))
+
+	other, _ := url.Parse("https://ticket.rzd.ru/")
+	require.Empty(t, first.Cookies(ctx, other))
+}
+
+func cookieValue(cookies []*http.Cookie, name string) string {
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,42 @@
+// internal/infrastructure/state/store.go
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// Store хранилище состояния с ограниченным временем жизни ключей: RID и cookie сессии РЖД,
+// сохранённые ответы, корзины лимитов. Реализация в памяти действует в пределах процесса,
+// реализация поверх Redis общая для всех реплик.
+type Store interface {
+	// Get возвращает значение ключа; ok = false, если ключа нет или он истёк
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set сохраняет значение на ttl (ttl > 0)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete удаляет ключ
+	Delete(ctx context.Context, key string) error
+	// Incr увеличивает счётчик окна на 1. Окно создаётся первым увеличением и живёт window;
+	// возвращается новое значение и сколько осталось до конца окна.
+	Incr(ctx context.Context, key string, window time.Duration) (count int64, remaining time.Duration, err error)
+	// Close освобождает соединения
+	Close() error
+}
+
+// New создаёт хранилище по конфигурации: Redis при заданном адресе, иначе в памяти процесса
+func New(cfg *config.State) (Store, error) {
+	if cfg.Addr == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewRedisStore(cfg.Addr, cfg.Prefix, cfg.Timeout)
+}
+
+// HashKey сокращает длинный ключ (например, URL с параметрами запроса) до хэша с префиксом
+func HashKey(prefix, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return prefix + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,265 @@
+// internal/infrastructure/stationcodes/stationcodes.go
+package stationcodes
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// Колонки таблицы соответствия в формате сервиса. Обязательна express3 и хотя бы одна из esr, uic.
+const (
+	ColumnExpress3 = "express3" // Код Экспресс-3, например 2006004
+	ColumnESR      = "esr"      // Код ЕСР, пять цифр или шесть с контрольной
+	ColumnUIC      = "uic"      // Код UIC
+	ColumnName     = "name"     // Название станции
+)
+
+// Format описание таблицы соответствия: разделитель и названия колонок с кодами.
+// Таблицы партнёров называют колонки по-своему, например "Код ЭКСПРЕСС;Код ЕСР;Наименование".
+type Format struct {
+	Comma    rune   // Разделитель полей
+	Express3 string // Колонка кода Экспресс-3
+	ESR      string // Колонка кода ЕСР; пустая - нет в таблице
+	UIC      string // Колонка кода UIC; пустая - нет в таблице
+	Name     string // Колонка названия; пустая - нет в таблице
+}
+
+// DefaultFormat формат файла, который загружает сервис
+var DefaultFormat = Format{Comma: ',', Express3: ColumnExpress3, ESR: ColumnESR, UIC: ColumnUIC, Name: ColumnName}
+
+// Read читает таблицу соответствия. Первая строка - названия колонок (без учёта регистра, в любом порядке),
+// строки, начинающиеся с '#', - комментарии. Коды приводятся функцией domain.NormalizeStationCode.
+// Один и тот же код ЕСР или UIC у разных станций Экспресс-3 - ошибка.
+func Read(source io.Reader, format Format) ([]domain.StationCodes, error) {
+	reader := csv.NewReader(source)
+	reader.Comma = format.Comma
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read station codes header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[normalizeColumn(name)] = i
+	}
+	column := func(name string) int {
+		if name == "" {
+			return -1
+		}
+		if i, ok := columns[normalizeColumn(name)]; ok {
+			return i
+		}
+		return -1
+	}
+	express3, esr, uic, name := column(format.Express3), column(format.ESR), column(format.UIC), column(format.Name)
+	if express3 < 0 {
+		return nil, fmt.Errorf("station codes header: column %q is required", format.Express3)
+	}
+	if esr < 0 && uic < 0 {
+		return nil, fmt.Errorf("station codes header: column %q or %q is required", format.ESR, format.UIC)
+	}
+
+	var records []domain.StationCodes
+	owners := make(map[string]int) // Код ЕСР или UIC -> код Экспресс-3
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read station codes: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		codes, err := parseRecord(field(express3), field(esr), field(uic), field(name))
+		if err != nil {
+			return nil, fmt.Errorf("station codes line %d: %w", line, err)
+		}
+		for _, key := range []string{codeKey(domain.CodeESR, codes.ESR), codeKey(domain.CodeUIC, codes.UIC)} {
+			if key == "" {
+				continue
+			}
+			if owner, ok := owners[key]; ok && owner != codes.Express3 {
+				return nil, fmt.Errorf("station codes line %d: code %s is already assigned to station %d", line, key, owner)
+			}
+			owners[key] = codes.Express3
+		}
+		records = append(records, codes)
+	}
+	return records, nil
+}
+
+// parseRecord разбирает строку таблицы соответствия
+func parseRecord(express3, esr, uic, name string) (domain.StationCodes, error) {
+	code, err := strconv.Atoi(express3)
+	if err != nil || code <= 0 {
+		return domain.StationCodes{}, fmt.Errorf("invalid express3 code %q", express3)
+	}
+	codes := domain.StationCodes{Express3: code, Name: name}
+	if esr != "" {
+		if codes.ESR, err = domain.NormalizeStationCode(domain.CodeESR, esr); err != nil {
+			return domain.StationCodes{}, fmt.Errorf("station %d: %w", code, err)
+		}
+	}
+	if uic != "" {
+		if codes.UIC, err = domain.NormalizeStationCode(domain.CodeUIC, uic); err != nil {
+			return domain.StationCodes{}, fmt.Errorf("station %d: %w", code, err)
+		}
+	}
+	if codes.ESR == "" && codes.UIC == "" {
+		return domain.StationCodes{}, fmt.Errorf("station %d has neither esr nor uic code", code)
+	}
+	return codes, nil
+}
+
+// Write записывает таблицу соответствия в формате DefaultFormat, упорядоченную по коду Экспресс-3
+func Write(target io.Writer, records []domain.StationCodes) error {
+	sorted := append([]domain.StationCodes(nil), records...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Express3 < sorted[j].Express3 })
+
+	writer := csv.NewWriter(target)
+	if err := writer.Write([]string{ColumnExpress3, ColumnESR, ColumnUIC, ColumnName}); err != nil {
+		return fmt.Errorf("failed to write station codes: %w", err)
+	}
+	for _, codes := range sorted {
+		if err := writer.Write([]string{strconv.Itoa(codes.Express3), codes.ESR, codes.UIC, codes.Name}); err != nil {
+			return fmt.Errorf("failed to write station codes: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write station codes: %w", err)
+	}
+	return nil
+}
+
+// Registry таблица соответствия кодов станций с поиском в обе стороны:
+// от кода Экспресс-3 к кодам ЕСР и UIC и обратно.
+type Registry struct {
+	mutex      sync.RWMutex
+	byExpress3 map[int]domain.StationCodes
+	byCode     map[string]int // codeKey -> код Экспресс-3
+}
+
+// NewRegistry создаёт пустой реестр
+func NewRegistry() *Registry {
+	return &Registry{byExpress3: make(map[int]domain.StationCodes), byCode: make(map[string]int)}
+}
+
+// LoadFile дополняет реестр из файла в формате DefaultFormat
+func (r *Registry) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open station codes: %w", err)
+	}
+	defer file.Close()
+	return r.Load(file)
+}
+
+// Load дополняет реестр таблицей в формате DefaultFormat; записи заменяют прежние с тем же кодом Экспресс-3
+func (r *Registry) Load(source io.Reader) error {
+	records, err := Read(source, DefaultFormat)
+	if err != nil {
+		return err
+	}
+	r.Add(records...)
+	return nil
+}
+
+// Add добавляет записи в реестр, заменяя прежние с тем же кодом Экспресс-3. Если код ЕСР или UIC
+// был у другой станции, он у неё удаляется: более поздняя таблица считается точнее.
+func (r *Registry) Add(records ...domain.StationCodes) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, codes := range records {
+		if previous, ok := r.byExpress3[codes.Express3]; ok {
+			delete(r.byCode, codeKey(domain.CodeESR, previous.ESR))
+			delete(r.byCode, codeKey(domain.CodeUIC, previous.UIC))
+		}
+		if owner, ok := r.byCode[codeKey(domain.CodeESR, codes.ESR)]; ok {
+			stale := r.byExpress3[owner]
+			stale.ESR = ""
+			r.byExpress3[owner] = stale
+		}
+		if owner, ok := r.byCode[codeKey(domain.CodeUIC, codes.UIC)]; ok {
+			stale := r.byExpress3[owner]
+			stale.UIC = ""
+			r.byExpress3[owner] = stale
+		}
+		r.byExpress3[codes.Express3] = codes
+		for _, key := range []string{codeKey(domain.CodeESR, codes.ESR), codeKey(domain.CodeUIC, codes.UIC)} {
+			if key != "" {
+				r.byCode[key] = codes.Express3
+			}
+		}
+	}
+}
+
+// Len возвращает количество станций в реестре
+func (r *Registry) Len() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.byExpress3)
+}
+
+// Records возвращает все записи реестра, упорядоченные по коду Экспресс-3
+func (r *Registry) Records() []domain.StationCodes {
+	r.mutex.RLock()
+	records := make([]domain.StationCodes, 0, len(r.byExpress3))
+	for _, codes := range r.byExpress3 {
+		records = append(records, codes)
+	}
+	r.mutex.RUnlock()
+	sort.Slice(records, func(i, j int) bool { return records[i].Express3 < records[j].Express3 })
+	return records
+}
+
+// Lookup возвращает коды станции по коду в любой из систем. Код должен быть приведён
+// функцией domain.NormalizeStationCode.
+func (r *Registry) Lookup(codeType domain.StationCodeType, code string) (domain.StationCodes, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if codeType == domain.CodeExpress3 {
+		express3, err := strconv.Atoi(code)
+		if err != nil {
+			return domain.StationCodes{}, false
+		}
+		codes, ok := r.byExpress3[express3]
+		return codes, ok
+	}
+	express3, ok := r.byCode[codeKey(codeType, code)]
+	if !ok {
+		return domain.StationCodes{}, false
+	}
+	return r.byExpress3[express3], true
+}
+
+// codeKey ключ кода ЕСР или UIC в общем индексе; пустой код - пустой ключ
+func codeKey(codeType domain.StationCodeType, code string) string {
+	if code == "" {
+		return ""
+	}
+	return codeType.String() + ":" + code
+}
+
+// normalizeColumn название колонки без учёта регистра, лишних пробелов и BOM (таблицы, сохранённые из Excel)
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(strings.TrimPrefix(name, "\ufeff")), " "))
+}
@@ -0,0 +1,70 @@
+package stationcodes
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+func TestReadPartnerTable(t *testing.T) {
+	format := Format{Comma: ';', Express3: "Код ЭКСПРЕСС", ESR: "Код ЕСР", Name: "Наименование"}
+	records, err := Read(strings.NewReader("\ufeffКод  экспресс;Код ЕСР;Наименование\n"+
+		"# Вокзалы Москвы\n"+
+		"2006004;06007;МОСКВА ОКТЯБРЬСКАЯ\n"+
+		"2000003;194013;МОСКВА КАЗАНСКАЯ\n"), format)
+	require.NoError(t, err)
+	// Пятизначный код ЕСР дополняется контрольной цифрой
+	require.Equal(t, []domain.StationCodes{
+		{Express3: 2006004, ESR: "060073", Name: "МОСКВА ОКТЯБРЬСКАЯ"},
+		{Express3: 2000003, ESR: "194013", Name: "МОСКВА КАЗАНСКАЯ"},
+	}, records)
+
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, records))
+	require.Equal(t, "express3,esr,uic,name\n2000003,194013,,МОСКВА КАЗАНСКАЯ\n2006004,060073,,МОСКВА ОКТЯБРЬСКАЯ\n", out.String())
+}
+
+func TestReadInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"no esr or uic column": "express3,name\n2006004,МОСКВА\n",
+		"check digit":          "express3,esr\n2006004,060074\n",
+		"letters":              "express3,uic\n2006004,20A6007\n",
+		"no codes in row":      "express3,esr,uic\n2006004,,\n",
+		"esr of two stations":  "express3,esr\n2006004,060073\n2000003,060073\n",
+	} {
+		_, err := Read(strings.NewReader(data), DefaultFormat)
+		require.Error(t, err, name)
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Load(strings.NewReader("express3,esr,uic,name\n2006004,060073,2006007,МОСКВА ОКТЯБРЬСКАЯ\n")))
+
+	// Из Экспресс-3 в ЕСР и UIC и обратно
+	codes, ok := registry.Lookup(domain.CodeExpress3, "2006004")
+	require.True(t, ok)
+	require.Equal(t, "060073", codes.ESR)
+	codes, ok = registry.Lookup(domain.CodeESR, "060073")
+	require.True(t, ok)
+	require.Equal(t, 2006004, codes.Express3)
+	// Код UIC совпадает с каким-то кодом Экспресс-3 по виду, но ищется в своей системе
+	codes, ok = registry.Lookup(domain.CodeUIC, "2006007")
+	require.True(t, ok)
+	require.Equal(t, 2006004, codes.Express3)
+	_, ok = registry.Lookup(domain.CodeExpress3, "2006007")
+	require.False(t, ok)
+
+	// Код ЕСР, переданный другой станции более поздней таблицей, уходит от прежней
+	registry.Add(domain.StationCodes{Express3: 2006000, ESR: "060073"})
+	codes, _ = registry.Lookup(domain.CodeESR, "060073")
+	require.Equal(t, 2006000, codes.Express3)
+	codes, _ = registry.Lookup(domain.CodeExpress3, "2006004")
+	require.Empty(t, codes.ESR)
+	require.Equal(t, "2006007", codes.UIC)
+	require.Equal(t, 2, registry.Len())
+}
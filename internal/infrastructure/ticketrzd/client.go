@@ -0,0 +1,321 @@
+// internal/infrastructure/ticketrzd/client.go
+package ticketrzd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/mappers"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/upstream"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// Пути эндпоинтов JSON API относительно базового адреса
+const (
+	trainPricingPath = "apib2b/p/Railway/V1/Search/TrainPricing"
+	carPricingPath   = "apib2b/p/Railway/V1/Search/CarPricing"
+	suggestsPath     = "api/v1/suggests"
+	serviceProvider  = "B2B_RZD"
+)
+
+var tracer = tracing.Tracer("ticketrzd")
+
+// Client клиент JSON API РЖД (ticket.rzd.ru). В отличие от старого API не требует шага с RID.
+type Client struct {
+	config     atomic.Pointer[config.RZD]
+	baseURL    *url.URL
+	HTTPClient *upstream.HTTPClient
+	TimeZones  *timezone.Resolver
+}
+
+// apiError тело ответа JSON API с ошибкой
+type apiError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+// NewClient создаёт клиент JSON API. Базовый адрес берётся из JSONBasePath, остальные параметры
+// (прокси, таймауты, повторы, язык) общие со старым API.
+func NewClient(cfg *config.RZD) (*Client, error) {
+	baseURL, err := url.Parse(cfg.JSONBasePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON API base URL: %w", err)
+	}
+
+	httpClient, err := upstream.NewHTTPClient(cfg.Proxy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := timezone.NewResolver()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create time zone resolver: %v", err)
+	}
+	if cfg.TimeZonesFile != "" {
+		if err := zones.LoadFile(cfg.TimeZonesFile); err != nil {
+			return nil, fmt.Errorf("failed to load time zones: %v", err)
+		}
+	}
+
+	client := &Client{
+		baseURL:    baseURL,
+		HTTPClient: httpClient,
+		TimeZones:  zones,
+	}
+	client.config.Store(cfg)
+	return client, nil
+}
+
+// ApplyConfig применяет новую конфигурацию без пересоздания клиента:
+// прокси, таймауты, повторы и User-Agent
+func (c *Client) ApplyConfig(cfg *config.RZD) error {
+	if err := c.HTTPClient.SetProxy(cfg.Proxy); err != nil {
+		return err
+	}
+	current := *c.config.Load()
+	current.Proxy = cfg.Proxy
+	current.Timeout = cfg.Timeout
+	current.RetryDelay = cfg.RetryDelay
+	current.MaxRetries = cfg.MaxRetries
+	current.UserAgent = cfg.UserAgent
+	c.config.Store(&current)
+	return nil
+}
+
+// Name возвращает имя провайдера данных
+func (c *Client) Name() string {
+	return "ticket.rzd.ru"
+}
+
+// GetTrainRoutes получает маршруты поездов в одну точку
+func (c *Client) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	// Дата отправления - календарная дата запроса; поиск идёт по местному времени (GetByLocalTime)
+	year, month, day := params.FromDate.Date()
+	request := schemas.TrainPricingRequest{
+		Origin:                strconv.Itoa(params.FromCode),
+		Destination:           strconv.Itoa(params.ToCode),
+		DepartureDate:         time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(mappers.DateTimeLayout),
+		TimeFrom:              0,
+		TimeTo:                24,
+		CarGrouping:           "DontGroup",
+		GetByLocalTime:        true,
+		SpecialPlacesDemand:   "StandardPlacesAndForDisabledPersons",
+		CarIssuingType:        "All",
+		GetTrainsFromSchedule: !params.CheckSeats,
+	}
+
+	var response schemas.TrainPricingResponse
+	if err := c.postJSON(ctx, trainPricingPath, params.Language, request, &response); err != nil {
+		log.Printf("Failed to get train routes: %v", err)
+		return nil, err
+	}
+
+	routes, err := mappers.MapTrainPricingResponse(response, c.TimeZones)
+	if err != nil {
+		log.Printf("Failed to map train routes: %v", err)
+		return nil, err
+	}
+	return filterRoutes(routes, params), nil
+}
+
+// GetTrainCarriages получает список вагонов выбранного поезда
+func (c *Client) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	// API ожидает местное время отправления, а не UTC
+	fromTime := params.FromTime.In(c.TimeZones.Resolve(params.FromCode))
+	request := schemas.CarPricingRequest{
+		OriginCode:          strconv.Itoa(params.FromCode),
+		DestinationCode:     strconv.Itoa(params.ToCode),
+		DepartureDate:       fromTime.Format(mappers.DateTimeLayout),
+		TrainNumber:         params.TrainNumber,
+		SpecialPlacesDemand: "StandardPlacesAndForDisabledPersons",
+	}
+
+	var response schemas.CarPricingResponse
+	if err := c.postJSON(ctx, carPricingPath, params.Language, request, &response); err != nil {
+		log.Printf("Failed to get train carriages: %v", err)
+		return nil, err
+	}
+	return mappers.MapCarPricingResponse(response), nil
+}
+
+// SearchStation получает список городов и станций по части названия
+func (c *Client) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	query := url.Values{}
+	query.Set("Query", params.Query)
+	query.Set("TransportType", "rail")
+	query.Set("GroupResults", "true")
+	query.Set("RailwaySortPriority", "true")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint(suggestsPath, query), nil)
+	if err != nil {
+		return nil, err
+	}
+	var response schemas.SuggestsResponse
+	if err := c.do(req, nil, params.Language, &response); err != nil {
+		log.Printf("Failed to get station codes: %v", err)
+		return nil, err
+	}
+
+	// CompactMode относится к формату ответа старого API и здесь не используется
+	return mappers.MapSuggestsResponse(response), nil
+}
+
+// endpoint строит URL эндпоинта относительно базового адреса
+func (c *Client) endpoint(path string, query url.Values) string {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + path
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// postJSON отправляет JSON-запрос на эндпоинт поиска и декодирует ответ
+func (c *Client) postJSON(ctx context.Context, path, lang string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(path, url.Values{"service_provider": {serviceProvider}}), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, payload, lang, out)
+}
+
+// do выполняет запрос с повторами при сетевых ошибках, 429 и 5xx.
+// Вызов записывается в спан "ticketrzd.request", каждая попытка - в дочерний спан "ticketrzd.attempt".
+func (c *Client) do(req *http.Request, payload []byte, lang string, out interface{}) (err error) {
+	cfg := c.config.Load()
+	endpoint := strings.TrimPrefix(req.URL.Path, "/")
+	ctx, span := tracer.Start(req.Context(), "ticketrzd.request", trace.WithAttributes(tracing.AttrEndpoint.String(endpoint)))
+	req = req.WithContext(ctx)
+	var attemptSpan trace.Span
+	endAttempt := func(err error) {
+		if attemptSpan != nil {
+			tracing.End(attemptSpan, err)
+			attemptSpan = nil
+		}
+	}
+	defer func() {
+		endAttempt(err)
+		tracing.End(span, err)
+	}()
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	req.Header.Set("Accept-Language", c.language(lang))
+
+	attempts := cfg.MaxRetries
+	if attempts < 1 {
+		attempts = 1
+	}
+	var lastError error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		endAttempt(lastError)
+		if attempt > 1 {
+			select {
+			case <-req.Context().Done():
+				return req.Context().Err()
+			case <-time.After(cfg.RetryDelay):
+			}
+		}
+		if payload != nil {
+			req.Body = io.NopCloser(bytes.NewReader(payload))
+			req.ContentLength = int64(len(payload))
+		}
+		log.Printf("Executing request: %s %s (Attempt %d)", req.Method, req.URL.String(), attempt)
+		_, attemptSpan = tracer.Start(ctx, "ticketrzd.attempt", trace.WithAttributes(
+			tracing.AttrEndpoint.String(endpoint),
+			tracing.AttrAttempt.Int(attempt),
+		))
+
+		statusCode, body, err := c.roundTrip(req, cfg.Timeout)
+		if err != nil {
+			lastError = err
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				return ctxErr
+			}
+			continue
+		}
+		attemptSpan.SetAttributes(tracing.AttrStatus.Int(statusCode))
+
+		switch {
+		case statusCode == http.StatusOK:
+			if err := json.Unmarshal(body, out); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			return nil
+		case statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError:
+			lastError = fmt.Errorf("received %d response", statusCode)
+			continue
+		default:
+			var apiErr apiError
+			if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+				return errors.New(apiErr.Message)
+			}
+			return fmt.Errorf("received %d response", statusCode)
+		}
+	}
+	return fmt.Errorf("failed after %d attempts: %v", attempts, lastError)
+}
+
+// roundTrip выполняет одну попытку запроса с таймаутом и читает тело ответа
+func (c *Client) roundTrip(req *http.Request, timeout time.Duration) (int, []byte, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+// language возвращает язык запроса или язык по умолчанию из конфигурации
+func (c *Client) language(lang string) string {
+	if lang == "" {
+		return c.config.Load().Language
+	}
+	return lang
+}
+
+// filterRoutes применяет параметры поиска, которые JSON API не поддерживает напрямую
+func filterRoutes(routes []domain.TrainRoute, params domain.GetTrainRoutesParams) []domain.TrainRoute {
+	filtered := routes[:0]
+	for _, r := range routes {
+		suburban := r.TrainType == domain.Suburban || r.TrainType == domain.ExpressSuburban
+		switch {
+		case params.TrainType == domain.Trains && suburban:
+			continue
+		case params.TrainType == domain.Electrics && !suburban:
+			continue
+		case params.CheckSeats && len(r.CarTypes) == 0:
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
@@ -0,0 +1,168 @@
+package ticketrzd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// fakeServer отдаёт фикстуры из testdata вместо ticket.rzd.ru и запоминает последние запросы
+type fakeServer struct {
+	*httptest.Server
+	trainRequest schemas.TrainPricingRequest
+	carRequest   schemas.CarPricingRequest
+	language     string
+	failures     atomic.Int32 // Сколько первых запросов завершить ответом 503
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	fake := &fakeServer{}
+	fixture := func(name string) []byte {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+		return data
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+trainPricingPath, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, serviceProvider, r.URL.Query().Get("service_provider"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&fake.trainRequest))
+		_, _ = w.Write(fixture("train_pricing.json"))
+	})
+	mux.HandleFunc("/"+carPricingPath, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&fake.carRequest))
+		_, _ = w.Write(fixture("car_pricing.json"))
+	})
+	mux.HandleFunc("/"+suggestsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Query") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"Code":"InvalidQuery","Message":"Query is required"}`))
+			return
+		}
+		_, _ = w.Write(fixture("suggests.json"))
+	})
+
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.language = r.Header.Get("Accept-Language")
+		if fake.failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+func newTestClient(t *testing.T, fake *fakeServer) *Client {
+	client, err := NewClient(&config.RZD{
+		JSONBasePath: fake.URL + "/",
+		Language:     "ru",
+		Timeout:      5 * time.Second,
+		RetryDelay:   time.Millisecond,
+		MaxRetries:   3,
+		UserAgent:    "test",
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestClientGetTrainRoutes(t *testing.T) {
+	fake := newFakeServer(t)
+	fake.failures.Store(1)
+	client := newTestClient(t, fake)
+
+	moscow := client.TimeZones.Moscow()
+	routes, err := client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode:  2004000,
+		ToCode:    2000000,
+		TrainType: domain.AllTrains,
+		FromDate:  time.Date(2025, 2, 13, 0, 0, 0, 0, moscow),
+		Language:  "en",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2025-02-13T00:00:00", fake.trainRequest.DepartureDate)
+	require.Equal(t, "2004000", fake.trainRequest.Origin)
+	require.Equal(t, "en", fake.language)
+	require.Len(t, routes, 2)
+
+	train := routes[0]
+	require.Equal(t, "119А", train.TrainNumber)
+	require.Equal(t, domain.Train, train.TrainType)
+	require.Equal(t, 575*time.Minute, train.Duration)
+	require.Equal(t, time.Date(2025, 2, 13, 0, 12, 0, 0, moscow).Unix(), train.Departure.Unix())
+	require.Equal(t, 2004001, train.From.Code)
+	require.Equal(t, "МУРМАНСК", train.From.RouteName)
+	require.Equal(t, domain.Carrier{Name: "ФПК"}, train.Carrier)
+	require.Equal(t, []domain.CarriageType{
+		{Type: domain.Platz, TypeShortLabel: "ПЛАЦ", TypeLabel: "ПЛАЦ", Class: "3Э", Tariff: 1822, FreeSeats: 41, DataType: domain.CarDataSummary},
+		{Type: domain.Coupe, TypeShortLabel: "КУПЕ", TypeLabel: "КУПЕ", Class: "2Э", Tariff: 2533, TariffExtra: 3463, FreeSeats: 132, Disabled: true, DataType: domain.CarDataSummary},
+	}, train.CarTypes)
+	require.Equal(t, domain.Suburban, routes[1].TrainType)
+
+	// Поиск только поездов дальнего следования с местами отсекает электричку
+	routes, err = client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2004000, ToCode: 2000000, TrainType: domain.Trains, CheckSeats: true,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, moscow),
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.False(t, fake.trainRequest.GetTrainsFromSchedule)
+	require.Equal(t, "ru", fake.language)
+
+	// Калининград (UTC+2): московская полночь - та же календарная дата, а не предыдущий день
+	_, err = client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2058000, ToCode: 2000000, TrainType: domain.AllTrains,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, moscow),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2025-02-13T00:00:00", fake.trainRequest.DepartureDate)
+}
+
+func TestClientGetTrainCarriages(t *testing.T) {
+	fake := newFakeServer(t)
+	client := newTestClient(t, fake)
+
+	cars, err := client.GetTrainCarriages(context.Background(), domain.GetTrainCarriagesParams{
+		TrainNumber: "119А",
+		FromCode:    2004000,
+		ToCode:      2000000,
+		FromTime:    time.Date(2025, 2, 12, 21, 12, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2025-02-13T00:12:00", fake.carRequest.DepartureDate)
+	require.Len(t, cars, 1)
+	require.Equal(t, "05", cars[0].CarNumber)
+	require.Equal(t, int(domain.Coupe), cars[0].CarType)
+	require.Equal(t, domain.Coupe, cars[0].SeatType)
+	require.Equal(t, 3463, cars[0].Tariff2)
+	require.Equal(t, domain.Carrier{ID: "ФПК", Name: "АО «ФПК»"}, cars[0].Carrier)
+	require.Len(t, cars[0].Services, 2)
+}
+
+func TestClientSearchStation(t *testing.T) {
+	fake := newFakeServer(t)
+	client := newTestClient(t, fake)
+
+	stations, err := client.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОСК"})
+	require.NoError(t, err)
+	require.Equal(t, []domain.Station{
+		{Name: "МОСКВА", Code: 2000000, Level: 5},
+		{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004, Level: 1},
+	}, stations)
+
+	_, err = client.SearchStation(context.Background(), domain.SearchStationParams{})
+	require.EqualError(t, err, "Query is required")
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"math"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/schemas"
+)
+
+// MapCarPricingResponse преобразует ответ со списком вагонов в доменные вагоны
+func MapCarPricingResponse(response schemas.CarPricingResponse) []domain.Car {
+	cars := make([]domain.Car, 0, len(response.Cars))
+	for _, c := range response.Cars {
+		car := domain.Car{
+			CarNumber:          c.CarNumber,
+			Type:               c.CarTypeName,
+			CategoryLabelLocal: c.CarTypeName,
+			TypeLabel:          c.CarTypeName,
+			CategoryCode:       c.CarSubType,
+			CarType:            int(carTypes[c.CarType]),
+			SeatType:           carTypes[c.CarType],
+			Letter:             c.Letter,
+			ClassType:          c.ServiceClass,
+			Tariff:             int(math.Round(c.MinPrice)),
+			Carrier:            domain.Carrier{ID: c.Carrier, Name: c.CarrierDisplayName},
+			CarNumeration:      domain.Unknown,
+		}
+		if c.MaxPrice > c.MinPrice {
+			car.Tariff2 = int(math.Round(c.MaxPrice))
+		}
+		for _, s := range c.Services {
+			car.Services = append(car.Services, domain.Service{ID: s, Name: s})
+		}
+		cars = append(cars, car)
+	}
+	return cars
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"strconv"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/schemas"
+)
+
+// Уровни станций, соответствующие полю L старого API: город выше отдельного вокзала
+const (
+	cityLevel    = 5
+	stationLevel = 1
+)
+
+// MapSuggestsResponse преобразует ответ поиска в доменные станции: сначала города, затем станции.
+// Узлы без кода Экспресс-3 пропускаются.
+func MapSuggestsResponse(response schemas.SuggestsResponse) []domain.Station {
+	var stations []domain.Station
+	seen := make(map[int]struct{})
+	add := func(nodes []schemas.SuggestNode, level int) {
+		for _, node := range nodes {
+			code, err := strconv.Atoi(node.ExpressCode)
+			if err != nil {
+				continue
+			}
+			if _, ok := seen[code]; ok {
+				continue
+			}
+			seen[code] = struct{}{}
+			stations = append(stations, domain.Station{Name: node.Name, Code: code, Level: level})
+		}
+	}
+	add(response.City, cityLevel)
+	add(response.Train, stationLevel)
+	return stations
+}
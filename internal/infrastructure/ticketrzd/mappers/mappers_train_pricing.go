@@ -0,0 +1,125 @@
+package mappers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/ticketrzd/schemas"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+)
+
+// DateTimeLayout формат даты и времени в JSON API
+const DateTimeLayout = "2006-01-02T15:04:05"
+
+// carTypes соответствие типов вагонов JSON API доменным типам мест
+var carTypes = map[string]domain.CarSeatType{
+	"ReservedSeat": domain.Platz,
+	"Shared":       domain.General,
+	"Sedentary":    domain.Side,
+	"Compartment":  domain.Coupe,
+	"Soft":         domain.Soft,
+	"Luxury":       domain.Lux,
+}
+
+// MapTrainPricingResponse преобразует ответ поиска поездов в доменные маршруты.
+// Время в ответе московское, в домене оно переводится в пояс соответствующей станции.
+func MapTrainPricingResponse(response schemas.TrainPricingResponse, zones *timezone.Resolver) ([]domain.TrainRoute, error) {
+	routes := make([]domain.TrainRoute, 0, len(response.Trains))
+	for _, train := range response.Trains {
+		route, err := mapTrain(train, zones)
+		if err != nil {
+			return nil, fmt.Errorf("train %s: %w", train.TrainNumber, err)
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+func mapTrain(train schemas.Train, zones *timezone.Resolver) (domain.TrainRoute, error) {
+	fromCode, err := strconv.Atoi(train.OriginStationCode)
+	if err != nil {
+		return domain.TrainRoute{}, fmt.Errorf("invalid origin station code %q: %w", train.OriginStationCode, err)
+	}
+	toCode, err := strconv.Atoi(train.DestinationStationCode)
+	if err != nil {
+		return domain.TrainRoute{}, fmt.Errorf("invalid destination station code %q: %w", train.DestinationStationCode, err)
+	}
+	departure, err := time.ParseInLocation(DateTimeLayout, train.DepartureDateTime, zones.Moscow())
+	if err != nil {
+		return domain.TrainRoute{}, fmt.Errorf("invalid departure time: %w", err)
+	}
+	arrival, err := time.ParseInLocation(DateTimeLayout, train.ArrivalDateTime, zones.Moscow())
+	if err != nil {
+		return domain.TrainRoute{}, fmt.Errorf("invalid arrival time: %w", err)
+	}
+	fromZone, toZone := zones.Resolve(fromCode), zones.Resolve(toCode)
+
+	route := domain.TrainRoute{
+		TrainNumber:   train.TrainNumber,
+		TrainNumber2:  train.DisplayTrainNumber,
+		TrainName:     train.TrainName,
+		TrainType:     mapTrainType(train),
+		Duration:      time.Duration(math.Round(train.TripDuration)) * time.Minute,
+		Brand:         train.TrainBrandCode,
+		Firm:          train.IsBranded,
+		ElReg:         train.HasElectronicRegistration,
+		CarNumeration: domain.Unknown,
+		From: domain.Station{
+			Name:      train.OriginName,
+			RouteName: train.InitialStationName,
+			Code:      fromCode,
+			TimeZone:  fromZone.String(),
+		},
+		To: domain.Station{
+			Name:      train.DestinationName,
+			RouteName: train.FinalStationName,
+			Code:      toCode,
+			TimeZone:  toZone.String(),
+		},
+		Departure: departure.In(fromZone),
+		Arrival:   arrival.In(toZone),
+	}
+	if len(train.Carriers) > 0 {
+		route.Carrier = domain.Carrier{Name: train.Carriers[0]}
+	}
+	for _, group := range train.CarGroups {
+		route.CarTypes = append(route.CarTypes, mapCarGroup(group))
+	}
+	return route, nil
+}
+
+func mapCarGroup(group schemas.CarGroup) domain.CarriageType {
+	carriageType := domain.CarriageType{
+		Type:           carTypes[group.CarType],
+		TypeShortLabel: group.CarTypeName,
+		TypeLabel:      group.CarTypeName,
+		Tariff:         int(math.Round(group.MinPrice)),
+		FreeSeats:      group.TotalPlaceQuantity,
+		Disabled:       group.HasPlacesForDisabledPersons,
+		DataType:       domain.CarDataSummary,
+	}
+	if group.MaxPrice > group.MinPrice {
+		carriageType.TariffExtra = int(math.Round(group.MaxPrice))
+	}
+	if len(group.ServiceClasses) > 0 {
+		carriageType.Class = group.ServiceClasses[0]
+	}
+	return carriageType
+}
+
+// mapTrainType определяет категорию поезда: бренд, затем диапазон номера, затем признак пригородного
+func mapTrainType(train schemas.Train) domain.TrainType {
+	if domain.IsHighSpeedBrand(train.TrainBrandCode) {
+		return domain.HighSpeed
+	}
+	if trainType, ok := domain.TrainTypeByNumber(train.TrainNumber); ok {
+		return trainType
+	}
+	if train.IsSuburban {
+		return domain.Suburban
+	}
+	return domain.Train
+}
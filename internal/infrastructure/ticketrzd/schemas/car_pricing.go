@@ -0,0 +1,35 @@
+// internal/infrastructure/ticketrzd/schemas/car_pricing.go
+package schemas
+
+// CarPricingRequest тело запроса вагонов поезда (Railway/V1/Search/CarPricing)
+type CarPricingRequest struct {
+	OriginCode          string `json:"OriginCode"`
+	DestinationCode     string `json:"DestinationCode"`
+	DepartureDate       string `json:"DepartureDate"` // Местное время отправления, "2006-01-02T15:04:05"
+	TrainNumber         string `json:"TrainNumber"`
+	SpecialPlacesDemand string `json:"SpecialPlacesDemand"`
+}
+
+// CarPricingResponse ответ со списком вагонов поезда
+type CarPricingResponse struct {
+	OriginCode      string `json:"OriginCode"`
+	DestinationCode string `json:"DestinationCode"`
+	Cars            []Car  `json:"Cars"`
+}
+
+// Car вагон поезда
+type Car struct {
+	CarNumber          string   `json:"CarNumber"`     // Номер вагона, например "01"
+	CarType            string   `json:"CarType"`       // Тип вагона: Compartment, ReservedSeat, ...
+	CarSubType         string   `json:"CarSubType"`    // Подтип вагона, например "66К"
+	CarTypeName        string   `json:"CarTypeName"`   // Наименование типа вагона
+	ServiceClass       string   `json:"ServiceClass"`  // Класс обслуживания, например "2Э"
+	Letter             string   `json:"Letter"`        // Буква вагона
+	FreePlaces         string   `json:"FreePlaces"`    // Номера свободных мест через запятую
+	PlaceQuantity      int      `json:"PlaceQuantity"` // Количество свободных мест
+	MinPrice           float64  `json:"MinPrice"`
+	MaxPrice           float64  `json:"MaxPrice"`
+	Carrier            string   `json:"Carrier"`            // Код перевозчика
+	CarrierDisplayName string   `json:"CarrierDisplayName"` // Наименование перевозчика
+	Services           []string `json:"Services"`           // Коды услуг, например "Bedclothes"
+}
@@ -0,0 +1,16 @@
+// internal/infrastructure/ticketrzd/schemas/suggests.go
+package schemas
+
+// SuggestsResponse ответ поиска станций (api/v1/suggests)
+type SuggestsResponse struct {
+	City  []SuggestNode `json:"city"`  // Города (объединяют вокзалы)
+	Train []SuggestNode `json:"train"` // Станции и вокзалы
+}
+
+// SuggestNode найденный город или станция
+type SuggestNode struct {
+	Name        string `json:"name"`
+	ExpressCode string `json:"expressCode"` // Код Экспресс-3
+	Region      string `json:"region"`
+	NodeType    string `json:"nodeType"` // "city" или "station"
+}
@@ -0,0 +1,58 @@
+// internal/infrastructure/ticketrzd/schemas/train_pricing.go
+package schemas
+
+// TrainPricingRequest тело запроса поиска поездов (Railway/V1/Search/TrainPricing)
+type TrainPricingRequest struct {
+	Origin                string `json:"Origin"`                // Код станции отправления Экспресс-3
+	Destination           string `json:"Destination"`           // Код станции прибытия Экспресс-3
+	DepartureDate         string `json:"DepartureDate"`         // Дата отправления, "2006-01-02T00:00:00"
+	TimeFrom              int    `json:"TimeFrom"`              // Начало интервала отправления, час
+	TimeTo                int    `json:"TimeTo"`                // Конец интервала отправления, час
+	CarGrouping           string `json:"CarGrouping"`           // Группировка вагонов, "DontGroup"
+	GetByLocalTime        bool   `json:"GetByLocalTime"`        // Дата и интервал заданы по местному времени
+	SpecialPlacesDemand   string `json:"SpecialPlacesDemand"`   // Учитывать места для инвалидов
+	CarIssuingType        string `json:"CarIssuingType"`        // Тип оформления, "All"
+	GetTrainsFromSchedule bool   `json:"GetTrainsFromSchedule"` // Включать поезда без мест из расписания
+}
+
+// TrainPricingResponse ответ поиска поездов
+type TrainPricingResponse struct {
+	OriginStationCode      string  `json:"OriginStationCode"`
+	DestinationStationCode string  `json:"DestinationStationCode"`
+	Trains                 []Train `json:"Trains"`
+}
+
+// Train поезд в ответе поиска
+type Train struct {
+	TrainNumber               string     `json:"TrainNumber"`               // Номер поезда для продажи
+	DisplayTrainNumber        string     `json:"DisplayTrainNumber"`        // Отображаемый номер поезда
+	TrainName                 string     `json:"TrainName"`                 // Собственное название поезда
+	TrainBrandCode            string     `json:"TrainBrandCode"`            // Бренд, например "САПСАН"
+	IsSuburban                bool       `json:"IsSuburban"`                // Пригородный поезд
+	IsBranded                 bool       `json:"IsBranded"`                 // Фирменный поезд
+	HasElectronicRegistration bool       `json:"HasElectronicRegistration"` // Доступна электронная регистрация
+	IsSaleForbidden           bool       `json:"IsSaleForbidden"`           // Продажа запрещена
+	OriginStationCode         string     `json:"OriginStationCode"`         // Код станции отправления пассажира
+	OriginName                string     `json:"OriginName"`                // Название станции отправления
+	DestinationStationCode    string     `json:"DestinationStationCode"`    // Код станции прибытия пассажира
+	DestinationName           string     `json:"DestinationName"`           // Название станции прибытия
+	InitialStationName        string     `json:"InitialStationName"`        // Начальная станция маршрута поезда
+	FinalStationName          string     `json:"FinalStationName"`          // Конечная станция маршрута поезда
+	DepartureDateTime         string     `json:"DepartureDateTime"`         // Время отправления по Москве, "2006-01-02T15:04:05"
+	ArrivalDateTime           string     `json:"ArrivalDateTime"`           // Время прибытия по Москве
+	TripDuration              float64    `json:"TripDuration"`              // Время в пути, минуты
+	Carriers                  []string   `json:"Carriers"`                  // Перевозчики
+	CarGroups                 []CarGroup `json:"CarGroups"`                 // Агрегированные данные по типам вагонов
+}
+
+// CarGroup агрегированные данные по вагонам одного типа и класса
+type CarGroup struct {
+	CarType                     string   `json:"CarType"`     // Тип вагона: Compartment, ReservedSeat, Sedentary, Luxury, Soft, Shared
+	CarTypeName                 string   `json:"CarTypeName"` // Наименование типа вагона, например "КУПЕ"
+	ServiceClasses              []string `json:"ServiceClasses"`
+	MinPrice                    float64  `json:"MinPrice"`
+	MaxPrice                    float64  `json:"MaxPrice"`
+	TotalPlaceQuantity          int      `json:"TotalPlaceQuantity"`
+	HasPlacesForDisabledPersons bool     `json:"HasPlacesForDisabledPersons"`
+	CarrierDisplayNames         []string `json:"CarrierDisplayNames"`
+}
@@ -0,0 +1,54 @@
+package ticketrzd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+)
+
+func TestClientTracesAttempts(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	// Первая попытка получает 503, вторая - ответ
+	fake := newFakeServer(t)
+	fake.failures.Store(1)
+	client := newTestClient(t, fake)
+
+	_, err := client.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2004000,
+		ToCode:   2000000,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, client.TimeZones.Moscow()),
+	})
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	first, second, parent := spans[0], spans[1], spans[2]
+	require.Equal(t, "ticketrzd.request", parent.Name())
+	require.Contains(t, parent.Attributes(), tracing.AttrEndpoint.String(trainPricingPath))
+	require.Equal(t, codes.Unset, parent.Status().Code)
+
+	for i, attempt := range []sdktrace.ReadOnlySpan{first, second} {
+		require.Equal(t, "ticketrzd.attempt", attempt.Name())
+		require.Equal(t, parent.SpanContext().SpanID(), attempt.Parent().SpanID())
+		require.Contains(t, attempt.Attributes(), tracing.AttrAttempt.Int(i+1))
+	}
+	require.Contains(t, first.Attributes(), tracing.AttrStatus.Int(http.StatusServiceUnavailable))
+	require.Equal(t, codes.Error, first.Status().Code)
+	require.Contains(t, second.Attributes(), tracing.AttrStatus.Int(http.StatusOK))
+	require.Equal(t, codes.Unset, second.Status().Code)
+}
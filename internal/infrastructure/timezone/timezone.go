@@ -0,0 +1,175 @@
+// internal/infrastructure/timezone/timezone.go
+package timezone
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // База часовых поясов встраивается в бинарник: в alpine-образе её нет
+)
+
+// MoscowZone часовой пояс, в котором РЖД публикует "московское" время
+const MoscowZone = "Europe/Moscow"
+
+// regionPrefixLen длина префикса кода Экспресс-3, определяющего железную дорогу (регион)
+const regionPrefixLen = 4
+
+// defaultRegions часовые пояса по умолчанию для железных дорог (первые 4 цифры кода Экспресс-3).
+// Если дорога проходит через несколько поясов, указан пояс основной её части,
+// а отличающиеся станции перечисляются в defaultStations.
+var defaultRegions = map[string]string{
+	"2000": MoscowZone,           // Москва
+	"2001": MoscowZone,           // Московский узел
+	"2004": MoscowZone,           // Октябрьская
+	"2006": MoscowZone,           // Октябрьская (московское направление)
+	"2010": MoscowZone,           // Северная
+	"2014": MoscowZone,           // Юго-Восточная
+	"2024": "Europe/Samara",      // Куйбышевская
+	"2030": "Asia/Yekaterinburg", // Свердловская
+	"2034": "Asia/Vladivostok",   // Дальневосточная
+	"2038": "Asia/Krasnoyarsk",   // Красноярская
+	"2040": "Asia/Yekaterinburg", // Южно-Уральская
+	"2044": "Asia/Novosibirsk",   // Западно-Сибирская
+	"2054": "Asia/Irkutsk",       // Восточно-Сибирская
+	"2058": "Europe/Kaliningrad", // Калининградская
+	"2060": MoscowZone,           // Горьковская
+	"2064": MoscowZone,           // Северо-Кавказская
+	"2100": "Europe/Minsk",       // Белоруссия
+	"2200": "Europe/Kyiv",        // Украина
+}
+
+// defaultStations часовые пояса отдельных станций, имеют приоритет над поясом дороги
+var defaultStations = map[int]string{
+	2000000: MoscowZone,           // Москва (все вокзалы)
+	2004000: MoscowZone,           // Санкт-Петербург (все вокзалы)
+	2030000: "Asia/Yekaterinburg", // Екатеринбург
+	2040000: "Asia/Yekaterinburg", // Челябинск
+	2044000: "Asia/Novosibirsk",   // Новосибирск
+	2024000: "Europe/Samara",      // Самара
+	2034130: "Asia/Vladivostok",   // Владивосток
+}
+
+// Resolver определяет часовой пояс станции по её коду Экспресс-3.
+// Порядок поиска: точный код станции, префикс дороги, московское время.
+type Resolver struct {
+	mutex    sync.RWMutex
+	stations map[int]*time.Location
+	regions  map[string]*time.Location
+	fallback *time.Location
+}
+
+// NewResolver создаёт Resolver со встроенной таблицей поясов
+func NewResolver() (*Resolver, error) {
+	moscow, err := time.LoadLocation(MoscowZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", MoscowZone, err)
+	}
+	r := &Resolver{
+		stations: make(map[int]*time.Location, len(defaultStations)),
+		regions:  make(map[string]*time.Location, len(defaultRegions)),
+		fallback: moscow,
+	}
+	for prefix, zone := range defaultRegions {
+		if err := r.SetRegion(prefix, zone); err != nil {
+			return nil, err
+		}
+	}
+	for code, zone := range defaultStations {
+		if err := r.SetStation(code, zone); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Moscow возвращает московский часовой пояс
+func (r *Resolver) Moscow() *time.Location {
+	return r.fallback
+}
+
+// SetStation задаёт часовой пояс конкретной станции
+func (r *Resolver) SetStation(code int, zone string) error {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return fmt.Errorf("unknown time zone %q for station %d: %w", zone, code, err)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.stations[code] = loc
+	return nil
+}
+
+// SetRegion задаёт часовой пояс по умолчанию для дороги по префиксу кода
+func (r *Resolver) SetRegion(prefix, zone string) error {
+	if len(prefix) != regionPrefixLen {
+		return fmt.Errorf("region prefix %q must have %d digits", prefix, regionPrefixLen)
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return fmt.Errorf("unknown time zone %q for region %s: %w", zone, prefix, err)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.regions[prefix] = loc
+	return nil
+}
+
+// Resolve возвращает часовой пояс станции
+func (r *Resolver) Resolve(code int) *time.Location {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if loc, ok := r.stations[code]; ok {
+		return loc
+	}
+	key := strconv.Itoa(code)
+	if len(key) >= regionPrefixLen {
+		if loc, ok := r.regions[key[:regionPrefixLen]]; ok {
+			return loc
+		}
+	}
+	return r.fallback
+}
+
+// LoadFile дополняет таблицу поясов из файла.
+// Формат строки: "<код станции>,<IANA пояс>" или "<4 цифры дороги>*,<IANA пояс>".
+// Пустые строки и строки, начинающиеся с '#', пропускаются.
+func (r *Resolver) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open time zone table: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, zone, ok := strings.Cut(line, ",")
+		if !ok {
+			return fmt.Errorf("time zone table line %d: expected \"code,zone\"", lineNumber)
+		}
+		key, zone = strings.TrimSpace(key), strings.TrimSpace(zone)
+		if prefix, isRegion := strings.CutSuffix(key, "*"); isRegion {
+			err = r.SetRegion(prefix, zone)
+		} else {
+			var code int
+			code, err = strconv.Atoi(key)
+			if err == nil {
+				err = r.SetStation(code, zone)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("time zone table line %d: %w", lineNumber, err)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,46 @@
+package timezone
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	r, err := NewResolver()
+	require.NoError(t, err)
+
+	require.Equal(t, MoscowZone, r.Moscow().String())
+	require.Equal(t, "Asia/Yekaterinburg", r.Resolve(2030000).String(), "station table")
+	require.Equal(t, "Asia/Vladivostok", r.Resolve(2034001).String(), "region prefix")
+	require.Equal(t, MoscowZone, r.Resolve(2999999).String(), "unknown region falls back to Moscow")
+	require.Equal(t, MoscowZone, r.Resolve(42).String(), "short code falls back to Moscow")
+
+	// Станция важнее пояса дороги
+	require.NoError(t, r.SetStation(2030001, "Europe/Samara"))
+	require.Equal(t, "Europe/Samara", r.Resolve(2030001).String())
+	require.Equal(t, "Asia/Yekaterinburg", r.Resolve(2030002).String())
+
+	require.Error(t, r.SetStation(2030001, "Mars/Olympus"))
+	require.Error(t, r.SetRegion("203", "Asia/Omsk"))
+	require.Error(t, r.SetRegion("2030", "Mars/Olympus"))
+}
+
+func TestResolverLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zones.csv")
+	require.NoError(t, os.WriteFile(path, []byte("# Омская область\n\n2050*, Asia/Omsk\n2050100,Asia/Novosibirsk\n"), 0o600))
+
+	r, err := NewResolver()
+	require.NoError(t, err)
+	require.NoError(t, r.LoadFile(path))
+	require.Equal(t, "Asia/Omsk", r.Resolve(2050001).String())
+	require.Equal(t, "Asia/Novosibirsk", r.Resolve(2050100).String())
+
+	require.NoError(t, os.WriteFile(path, []byte("2050*,Asia/Omsk\n2050100 Asia/Omsk\n"), 0o600))
+	require.ErrorContains(t, r.LoadFile(path), "line 2")
+	require.NoError(t, os.WriteFile(path, []byte("station,Asia/Omsk\n"), 0o600))
+	require.ErrorContains(t, r.LoadFile(path), "line 1")
+	require.Error(t, r.LoadFile(filepath.Join(t.TempDir(), "missing.csv")))
+}
@@ -0,0 +1,83 @@
+// internal/infrastructure/tracing/tracing.go
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// Атрибуты спанов обращений к РЖД
+const (
+	AttrEndpoint  = attribute.Key("rzd.endpoint")   // Эндпоинт РЖД: routes, carriages, suggester
+	AttrAttempt   = attribute.Key("rzd.attempt")    // Номер попытки, с 1
+	AttrStatus    = attribute.Key("rzd.status")     // HTTP-статус ответа
+	AttrResult    = attribute.Key("rzd.result")     // Поле result ответа: OK, RID, ...
+	AttrRIDIssued = attribute.Key("rzd.rid_issued") // РЖД выдал RID, нужен повторный запрос
+	AttrRIDCached = attribute.Key("rzd.rid_cached") // Запрос выполнен с ранее выданным RID
+	AttrStale     = attribute.Key("rzd.stale")      // Отдан сохранённый ответ
+)
+
+// Setup настраивает глобальные TracerProvider и распространение контекста (W3C Trace Context и Baggage).
+// При пустом cfg.Endpoint спаны не записываются, но входящий контекст трассировки передаётся дальше.
+// Возвращаемая функция выгружает оставшиеся спаны и должна быть вызвана при завершении.
+func Setup(ctx context.Context, cfg *config.Tracing) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(cfg.Endpoint),
+		otlptracegrpc.WithTimeout(cfg.Timeout),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	log.Printf("Exporting traces to %s (sample ratio %g)", cfg.Endpoint, cfg.SampleRatio)
+	return provider.Shutdown, nil
+}
+
+// Tracer возвращает трассировщик компонента из глобального TracerProvider
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer("github.com/Chaika-Team/ChaikaRzdScraper/" + name)
+}
+
+// End завершает спан, отмечая ошибку, если она есть
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,100 @@
+// internal/infrastructure/upstream/http_client.go
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+)
+
+// HTTPClient HTTP-клиент для запросов к API РЖД, прокси которого можно сменить без перезапуска.
+// Таймауты задаются контекстом каждого запроса, поэтому тоже могут меняться на лету.
+type HTTPClient struct {
+	*http.Client
+	transport *http.Transport
+	proxy     atomic.Pointer[url.URL]
+}
+
+// CookieJar хранилище cookie, которому передаётся контекст запроса
+type CookieJar interface {
+	// Cookies возвращает cookie для запроса к u
+	Cookies(ctx context.Context, u *url.URL) []*http.Cookie
+	// SetCookies сохраняет cookie ответа на запрос к u
+	SetCookies(ctx context.Context, u *url.URL, cookies []*http.Cookie)
+}
+
+// NewHTTPClient создаёт клиент с прокси (пустая строка - без прокси) и необязательным CookieJar
+func NewHTTPClient(proxy string, jar CookieJar) (*HTTPClient, error) {
+	c := &HTTPClient{}
+	if err := c.storeProxy(proxy); err != nil {
+		return nil, err
+	}
+	c.transport = http.DefaultTransport.(*http.Transport).Clone()
+	c.transport.Proxy = func(*http.Request) (*url.URL, error) {
+		return c.proxy.Load(), nil
+	}
+	c.Client = &http.Client{Transport: c.transport}
+	if jar != nil {
+		c.Client.Transport = &cookieTransport{next: c.transport, jar: jar}
+	}
+	return c, nil
+}
+
+// cookieTransport подставляет cookie из CookieJar в каждый запрос (включая переходы
+// по редиректам) и сохраняет cookie ответа, передавая хранилищу контекст запроса
+type cookieTransport struct {
+	next http.RoundTripper
+	jar  CookieJar
+}
+
+func (t *cookieTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if cookies := t.jar.Cookies(ctx, req.URL); len(cookies) > 0 {
+		req = req.Clone(ctx)
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if cookies := resp.Cookies(); len(cookies) > 0 {
+		t.jar.SetCookies(ctx, req.URL, cookies)
+	}
+	return resp, nil
+}
+
+// SetProxy меняет прокси. Открытые соединения через старый прокси закрываются по мере освобождения.
+func (c *HTTPClient) SetProxy(proxy string) error {
+	previous := c.proxy.Load()
+	if err := c.storeProxy(proxy); err != nil {
+		return err
+	}
+	if proxyString(previous) != proxyString(c.proxy.Load()) {
+		c.transport.CloseIdleConnections()
+	}
+	return nil
+}
+
+func (c *HTTPClient) storeProxy(proxy string) error {
+	if proxy == "" {
+		c.proxy.Store(nil)
+		return nil
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %v", err)
+	}
+	c.proxy.Store(proxyURL)
+	return nil
+}
+
+func proxyString(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
@@ -0,0 +1,59 @@
+package upstream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+)
+
+// ctxKey ключ значения контекста, которое должно дойти до хранилища cookie
+type ctxKey struct{}
+
+// recordingStore запоминает, с каким контекстом вызывалось хранилище
+type recordingStore struct {
+	state.Store
+	withValue atomic.Int32
+}
+
+func (s *recordingStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	if ctx.Value(ctxKey{}) != nil {
+		s.withValue.Add(1)
+	}
+	return s.Store.Get(ctx, key)
+}
+
+func TestHTTPClientCookies(t *testing.T) {
+	var session atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("JSESSIONID"); err == nil {
+			session.Store(cookie.Value)
+		}
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "abc", Path: "/"})
+	}))
+	defer server.Close()
+
+	store := &recordingStore{Store: state.NewMemoryStore()}
+	jar, err := state.NewCookieJar(store, "cookies:")
+	require.NoError(t, err)
+	client, err := NewHTTPClient("", jar)
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	// Cookie первого ответа отправлена со вторым запросом, хранилище получило контекст запроса
+	require.Equal(t, "abc", session.Load())
+	require.Positive(t, store.withValue.Load())
+}
@@ -0,0 +1,237 @@
+// internal/service/alternatives.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// Параметры поиска альтернатив по умолчанию
+const (
+	defaultAlternativeDays  = 2  // На сколько дней раньше и позже искать
+	defaultAlternatives     = 10 // Сколько альтернатив вернуть
+	alternativesConcurrency = 4  // Сколько запросов альтернатив выполняется одновременно
+)
+
+// alternativeRank порядок причин при одинаковом сдвиге даты: прямой поезд от другого вокзала
+// удобнее пересадки, пересадка - переноса поездки
+var alternativeRank = map[domain.AlternativeReason]int{
+	domain.AlternativeCityStation:  0,
+	domain.AlternativeWithChange:   1,
+	domain.AlternativeAdjacentDate: 2,
+}
+
+// GetTrainRoutesWithAlternatives возвращает маршруты с местами нужных типов, а если на запрошенную дату
+// таких нет - альтернативы: поезда от других станций тех же городов, маршруты с пересадкой
+// и поезда на соседние даты. Альтернативы упорядочены по сдвигу даты, затем по причине, затем по отправлению.
+// Ошибки дополнительных запросов логируются и не мешают вернуть остальные альтернативы.
+func (s *mainService) GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (domain.RoutesWithAlternatives, error) {
+	if err := requireExpress3(params.Routes.CodeType); err != nil {
+		return domain.RoutesWithAlternatives{}, err
+	}
+	query, err := s.resolveStations(ctx, params.Routes)
+	if err != nil {
+		return domain.RoutesWithAlternatives{}, err
+	}
+	query.CheckSeats = true
+	routes, err := s.GetTrainRoutes(ctx, query)
+	if err != nil {
+		return domain.RoutesWithAlternatives{}, err
+	}
+	if suitable := withFreeSeats(routes, params.SeatTypes); len(suitable) > 0 {
+		return domain.RoutesWithAlternatives{Routes: suitable}, nil
+	}
+
+	var queries []alternativeQuery
+	if cityQuery, ok := s.cityQuery(ctx, query); ok {
+		queries = append(queries, alternativeQuery{reason: domain.AlternativeCityStation, params: cityQuery})
+	}
+	if !query.WithChange {
+		changeQuery := query
+		changeQuery.WithChange = true
+		queries = append(queries, alternativeQuery{reason: domain.AlternativeWithChange, params: changeQuery})
+	}
+	days := params.DateRange
+	if days <= 0 {
+		days = defaultAlternativeDays
+	}
+	today := moscowToday(time.Now())
+	for d := 1; d <= days; d++ {
+		for _, shift := range []int{-d, d} {
+			dateQuery := query
+			dateQuery.FromDate = query.FromDate.AddDate(0, 0, shift)
+			// Прошедшие даты определяются по московским суткам, как и даты в запросах к РЖД
+			year, month, day := dateQuery.FromDate.Date()
+			if time.Date(year, month, day, 0, 0, 0, 0, moscow).Before(today) {
+				continue
+			}
+			queries = append(queries, alternativeQuery{reason: domain.AlternativeAdjacentDate, shift: shift, params: dateQuery})
+		}
+	}
+
+	routesByQuery, errs := s.fetchAlternatives(ctx, queries, alternativesConcurrency)
+	if ctx.Err() != nil {
+		return domain.RoutesWithAlternatives{}, ctx.Err()
+	}
+	found := alternativeSet{seatTypes: params.SeatTypes, seen: make(map[string]struct{})}
+	for i, alternative := range queries {
+		if errs[i] != nil {
+			log.Printf("Failed to search alternative routes (%s, %+d days): %v", alternative.reason, alternative.shift, errs[i])
+			continue
+		}
+		found.add(routesByQuery[i], alternative.reason, alternative.shift)
+	}
+
+	alternatives := found.ranked()
+	limit := params.MaxResults
+	if limit <= 0 {
+		limit = defaultAlternatives
+	}
+	if len(alternatives) > limit {
+		alternatives = alternatives[:limit]
+	}
+	return domain.RoutesWithAlternatives{Alternatives: alternatives}, nil
+}
+
+// alternativeQuery дополнительный запрос маршрутов для поиска альтернатив
+type alternativeQuery struct {
+	reason domain.AlternativeReason
+	shift  int // Сдвиг даты в днях
+	params domain.GetTrainRoutesParams
+}
+
+// fetchAlternatives выполняет запросы альтернатив, не более concurrency одновременно.
+// Результат и ошибка каждого запроса - под его индексом.
+func (s *mainService) fetchAlternatives(ctx context.Context, queries []alternativeQuery, concurrency int) ([][]domain.TrainRoute, []error) {
+	routesByQuery := make([][]domain.TrainRoute, len(queries))
+	errs := make([]error, len(queries))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, query := range queries {
+		wg.Add(1)
+		go func(i int, query alternativeQuery) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			routesByQuery[i], errs[i] = s.GetTrainRoutes(ctx, query.params)
+		}(i, query)
+	}
+	wg.Wait()
+	return routesByQuery, errs
+}
+
+// alternativeSet найденные альтернативы без повторов одного и того же поезда
+type alternativeSet struct {
+	seatTypes    []domain.CarSeatType
+	seen         map[string]struct{}
+	alternatives []domain.RouteAlternative
+}
+
+// add добавляет маршруты с местами нужных типов, которых ещё нет среди альтернатив
+func (a *alternativeSet) add(routes []domain.TrainRoute, reason domain.AlternativeReason, shift int) {
+	for _, route := range withFreeSeats(routes, a.seatTypes) {
+		key := fmt.Sprintf("%s|%d|%d", route.TrainNumber, route.From.Code, route.Departure.Unix())
+		if _, ok := a.seen[key]; ok {
+			continue
+		}
+		a.seen[key] = struct{}{}
+		a.alternatives = append(a.alternatives, domain.RouteAlternative{Route: route, Reason: reason, DaysShift: shift})
+	}
+}
+
+// ranked возвращает альтернативы от лучшей к худшей
+func (a *alternativeSet) ranked() []domain.RouteAlternative {
+	alternatives := a.alternatives
+	sort.SliceStable(alternatives, func(i, j int) bool {
+		x, y := alternatives[i], alternatives[j]
+		if absDays(x.DaysShift) != absDays(y.DaysShift) {
+			return absDays(x.DaysShift) < absDays(y.DaysShift)
+		}
+		if alternativeRank[x.Reason] != alternativeRank[y.Reason] {
+			return alternativeRank[x.Reason] < alternativeRank[y.Reason]
+		}
+		return x.Route.Departure.Before(y.Route.Departure)
+	})
+	return alternatives
+}
+
+// cityQuery запрос маршрутов между городами, к которым относятся станции запроса.
+// Возвращает false, если ни для одной станции город не известен.
+func (s *mainService) cityQuery(ctx context.Context, query domain.GetTrainRoutesParams) (domain.GetTrainRoutesParams, bool) {
+	cityQuery := query
+	cityQuery.FromCode = s.cityOf(ctx, query.FromCode, query.FromStation, query.Language)
+	cityQuery.ToCode = s.cityOf(ctx, query.ToCode, query.ToStation, query.Language)
+	return cityQuery, cityQuery.FromCode != query.FromCode || cityQuery.ToCode != query.ToCode
+}
+
+// cityOf возвращает код города станции: замеченного в маршрутах между городами или найденного
+// поиском по названию станции из запроса. Если город не известен, возвращается код самой станции.
+func (s *mainService) cityOf(ctx context.Context, code int, name, language string) int {
+	if city, ok := s.cities.cityOf(code); ok {
+		return city
+	}
+	if _, numeric := stationCode(name); name == "" || numeric {
+		return code
+	}
+	prefix := normalizeStationName(name)
+	if i := strings.IndexAny(prefix, " -("); i > 0 {
+		prefix = prefix[:i]
+	}
+	found, err := s.provider.SearchStation(ctx, domain.SearchStationParams{Query: prefix, Language: language})
+	if err != nil {
+		log.Printf("Failed to search city of station %q: %v", name, err)
+		return code
+	}
+	city, base := code, ""
+	for _, station := range found {
+		if !station.IsCity() || station.Code == code {
+			continue
+		}
+		// Самый длинный подходящий город: "САНКТ-ПЕТЕРБУРГ", а не "САНКТ"
+		if cityBase := cityBaseName(station.Name); belongsToCity(name, cityBase) && len(cityBase) > len(base) {
+			city, base = station.Code, cityBase
+		}
+	}
+	return city
+}
+
+// withFreeSeats оставляет маршруты, в которых есть свободные места нужных типов (пустой список - любых)
+func withFreeSeats(routes []domain.TrainRoute, seatTypes []domain.CarSeatType) []domain.TrainRoute {
+	var suitable []domain.TrainRoute
+	for _, route := range routes {
+		if hasFreeSeats(route, seatTypes) {
+			suitable = append(suitable, route)
+		}
+	}
+	return suitable
+}
+
+// hasFreeSeats проверяет, есть ли в поезде свободные места нужных типов (пустой список - любых)
+func hasFreeSeats(route domain.TrainRoute, seatTypes []domain.CarSeatType) bool {
+	for _, carType := range route.CarTypes {
+		if carType.FreeSeats > 0 && seatTypeAllowed(carType.Type, seatTypes) {
+			return true
+		}
+	}
+	return false
+}
+
+func absDays(days int) int {
+	if days < 0 {
+		return -days
+	}
+	return days
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// alternativesProvider отвечает на запрос маршрутов в зависимости от его параметров
+// и запоминает запросы и наибольшее число одновременных запросов
+type alternativesProvider struct {
+	stubProvider
+	routes func(params domain.GetTrainRoutesParams) []domain.TrainRoute
+
+	mutex    sync.Mutex
+	requests []domain.GetTrainRoutesParams
+	active   atomic.Int32
+	peak     atomic.Int32
+}
+
+func (p *alternativesProvider) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	active := p.active.Add(1)
+	defer p.active.Add(-1)
+	for peak := p.peak.Load(); active > peak && !p.peak.CompareAndSwap(peak, active); peak = p.peak.Load() {
+	}
+	time.Sleep(time.Millisecond)
+
+	p.mutex.Lock()
+	p.requests = append(p.requests, params)
+	p.mutex.Unlock()
+	return p.routes(params), nil
+}
+
+func TestGetTrainRoutesWithAlternatives(t *testing.T) {
+	date := moscowToday(time.Now()).AddDate(0, 0, 10)
+	train := func(number string, departure time.Time, seatType domain.CarSeatType, free int) domain.TrainRoute {
+		return domain.TrainRoute{
+			TrainNumber: number,
+			From:        domain.Station{Code: 2004001},
+			Departure:   departure,
+			CarTypes:    []domain.CarriageType{{Type: seatType, FreeSeats: free}},
+		}
+	}
+	provider := &alternativesProvider{
+		stubProvider: stubProvider{
+			name: "alternatives",
+			stations: []domain.Station{
+				{Name: "МОСКВА", Code: 2000000, Level: domain.CityLevel},
+				{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004, Level: 4},
+				{Name: "МОСКВАРЕЧЬЕ", Code: 2000999, Level: 1},
+			},
+		},
+		routes: func(params domain.GetTrainRoutesParams) []domain.TrainRoute {
+			switch {
+			case params.WithChange:
+				return []domain.TrainRoute{train("005А", date.Add(10*time.Hour), domain.Coupe, 2)}
+			case params.ToCode == 2000000:
+				return []domain.TrainRoute{
+					train("001А", date.Add(time.Hour), domain.Platz, 5),
+					train("003А", date.Add(3*time.Hour), domain.Coupe, 4),
+				}
+			case params.FromDate.Equal(date.AddDate(0, 0, 1)):
+				return []domain.TrainRoute{train("007А", date.AddDate(0, 0, 1).Add(time.Hour), domain.Coupe, 1)}
+			case params.FromDate.Equal(date.AddDate(0, 0, -1)):
+				return []domain.TrainRoute{train("009А", date.AddDate(0, 0, -1).Add(time.Hour), domain.Coupe, 1)}
+			case params.FromDate.Equal(date):
+				// Купе распродано, остался плацкарт
+				return []domain.TrainRoute{train("001А", date.Add(time.Hour), domain.Platz, 5)}
+			default:
+				return nil
+			}
+		},
+	}
+	svc := New(provider)
+	params := domain.AlternativesParams{
+		Routes:    domain.GetTrainRoutesParams{FromCode: 2004001, ToStation: "Москва Октябрьская", FromDate: date},
+		SeatTypes: []domain.CarSeatType{domain.Coupe},
+	}
+
+	result, err := svc.GetTrainRoutesWithAlternatives(context.Background(), params)
+	require.NoError(t, err)
+	require.Empty(t, result.Routes)
+	var trains []string
+	var reasons []domain.AlternativeReason
+	for _, alternative := range result.Alternatives {
+		trains = append(trains, alternative.Route.TrainNumber)
+		reasons = append(reasons, alternative.Reason)
+	}
+	// Другой вокзал и пересадка в тот же день, затем соседние даты по времени отправления
+	require.Equal(t, []string{"003А", "005А", "009А", "007А"}, trains)
+	require.Equal(t, []domain.AlternativeReason{
+		domain.AlternativeCityStation, domain.AlternativeWithChange, domain.AlternativeAdjacentDate, domain.AlternativeAdjacentDate,
+	}, reasons)
+	require.Equal(t, -1, result.Alternatives[2].DaysShift)
+	// Запрос, другой вокзал, пересадка и четыре соседние даты; одновременно не больше alternativesConcurrency
+	require.Len(t, provider.requests, 7)
+	for _, request := range provider.requests {
+		require.True(t, request.CheckSeats)
+	}
+	require.LessOrEqual(t, provider.peak.Load(), int32(alternativesConcurrency))
+
+	params.MaxResults = 2
+	result, err = svc.GetTrainRoutesWithAlternatives(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, result.Alternatives, 2)
+
+	// Места нужного типа есть - альтернативы не ищутся
+	params.SeatTypes = []domain.CarSeatType{domain.Platz}
+	result, err = svc.GetTrainRoutesWithAlternatives(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, result.Routes, 1)
+	require.Empty(t, result.Alternatives)
+}
+
+// Прошедшие даты определяются по московским суткам, а не по поясу даты запроса
+func TestAlternativesSkipPastMoscowDates(t *testing.T) {
+	provider := &alternativesProvider{
+		stubProvider: stubProvider{name: "alternatives"},
+		routes:       func(domain.GetTrainRoutesParams) []domain.TrainRoute { return nil },
+	}
+	// Сегодня по Москве, но дата запроса во владивостокском поясе: полночь там - ещё вчера в Москве
+	vladivostok, err := time.LoadLocation("Asia/Vladivostok")
+	require.NoError(t, err)
+	year, month, day := moscowToday(time.Now()).Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, vladivostok)
+
+	_, err = New(provider).GetTrainRoutesWithAlternatives(context.Background(), domain.AlternativesParams{
+		Routes:    domain.GetTrainRoutesParams{FromCode: 2004001, ToCode: 2034130, FromDate: date, WithChange: true},
+		DateRange: 1,
+	})
+	require.NoError(t, err)
+	var dates []string
+	for _, request := range provider.requests {
+		dates = append(dates, request.FromDate.Format("2006-01-02"))
+	}
+	// Исходный запрос и завтрашний день; вчерашний пропущен
+	require.Equal(t, []string{date.Format("2006-01-02"), date.AddDate(0, 0, 1).Format("2006-01-02")}, dates)
+}
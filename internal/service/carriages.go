@@ -0,0 +1,128 @@
+// internal/service/carriages.go
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// carriagesConcurrency сколько запросов вагонов выполняется одновременно
+// при обходе всех поездов направления
+const carriagesConcurrency = 4
+
+// GetRoutesWithCarriages возвращает маршруты и заполняет вагоны (TrainRoute.Cars) каждого поезда
+// или только поездов из params.TrainNumbers. Вагоны запрашиваются параллельно, не более
+// params.Concurrency запросов одновременно; поезда без свободных мест не запрашиваются.
+// Ошибка по отдельному поезду не прерывает запрос: поезд остаётся в ответе без вагонов и попадает в Failures.
+func (s *mainService) GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error) {
+	routes, err := s.GetTrainRoutes(ctx, params.Routes)
+	if err != nil {
+		return domain.RoutesWithCarriages{}, err
+	}
+	concurrency := params.Concurrency
+	if concurrency <= 0 {
+		concurrency = carriagesConcurrency
+	}
+
+	selected := make([]domain.TrainRoute, 0, len(routes))
+	var indexes []int
+	for i, route := range routes {
+		if hasFreeSeats(route, nil) && hasTrainNumber(params.TrainNumbers, route) {
+			selected = append(selected, route)
+			indexes = append(indexes, i)
+		}
+	}
+	carsByRoute, errs := s.fetchCarriages(ctx, params.Routes.FromCode, params.Routes.ToCode, params.Routes.Language, selected, concurrency)
+	if err := ctx.Err(); err != nil {
+		return domain.RoutesWithCarriages{}, err
+	}
+
+	// Маршруты копируются: провайдер (например, кэширующий) может отдавать общий срез
+	result := domain.RoutesWithCarriages{Routes: append([]domain.TrainRoute(nil), routes...)}
+	for i, route := range selected {
+		if errs[i] != nil {
+			result.Failures = append(result.Failures, domain.CarriagesFailure{
+				TrainNumber: route.TrainNumber,
+				Departure:   route.Departure,
+				Err:         errs[i],
+			})
+			continue
+		}
+		result.Routes[indexes[i]].Cars = carsByRoute[i]
+	}
+	return result, nil
+}
+
+// hasTrainNumber проверяет, запрошен ли поезд; номер сравнивается с основным и отображаемым.
+// Пустой список означает все поезда.
+func hasTrainNumber(numbers []string, route domain.TrainRoute) bool {
+	if len(numbers) == 0 {
+		return true
+	}
+	for _, number := range numbers {
+		if number == route.TrainNumber || (route.TrainNumber2 != "" && number == route.TrainNumber2) {
+			return true
+		}
+	}
+	return false
+}
+
+// carriagesForRoutes запрашивает вагоны каждого поезда с ограничением параллельности.
+// Результат выровнен по routes; для поездов без вагонов или с ошибкой запроса - nil.
+func (s *mainService) carriagesForRoutes(ctx context.Context, fromCode, toCode int, language string, routes []domain.TrainRoute) [][]domain.Car {
+	carsByRoute, errs := s.fetchCarriages(ctx, fromCode, toCode, language, routes, carriagesConcurrency)
+	for i, err := range errs {
+		if err != nil {
+			log.Printf("Failed to get carriages of train %s: %v", routes[i].TrainNumber, err)
+		}
+	}
+	return carsByRoute
+}
+
+// fetchCarriages запрашивает вагоны поездов, выполняя не более concurrency запросов одновременно.
+// Вагоны и ошибки выровнены по routes. Поезда без мест не запрашиваются. Станции берутся из маршрута,
+// чтобы для города запрос шёл от той станции, от которой отправляется поезд.
+func (s *mainService) fetchCarriages(ctx context.Context, fromCode, toCode int, language string, routes []domain.TrainRoute, concurrency int) ([][]domain.Car, []error) {
+	carsByRoute := make([][]domain.Car, len(routes))
+	errs := make([]error, len(routes))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, route := range routes {
+		if len(route.CarTypes) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, route domain.TrainRoute) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			carsByRoute[i], errs[i] = s.provider.GetTrainCarriages(ctx, domain.GetTrainCarriagesParams{
+				TrainNumber: route.TrainNumber,
+				Direction:   domain.OneWay,
+				FromCode:    routeStationCode(route.From, fromCode),
+				FromTime:    route.Departure,
+				ToCode:      routeStationCode(route.To, toCode),
+				Language:    language,
+			})
+		}(i, route)
+	}
+	wg.Wait()
+	return carsByRoute, errs
+}
+
+// routeStationCode код станции маршрута или код из запроса, если в маршруте его нет
+func routeStationCode(station domain.Station, fallback int) int {
+	if station.Code != 0 {
+		return station.Code
+	}
+	return fallback
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// concurrentCarsProvider отдаёт вагоны по номеру поезда и запоминает наибольшее число одновременных запросов
+type concurrentCarsProvider struct {
+	stubProvider
+	cars map[string][]domain.Car
+
+	mu      sync.Mutex
+	active  int
+	peak    int
+	fetched []string
+}
+
+func (p *concurrentCarsProvider) GetTrainCarriages(_ context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	p.mu.Lock()
+	p.active++
+	p.peak = max(p.peak, p.active)
+	p.fetched = append(p.fetched, params.TrainNumber)
+	p.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	p.mu.Lock()
+	p.active--
+	p.mu.Unlock()
+	cars, ok := p.cars[params.TrainNumber]
+	if !ok {
+		return nil, fmt.Errorf("train %s: %w", params.TrainNumber, domain.ErrTrainNotFound)
+	}
+	return cars, nil
+}
+
+func TestGetRoutesWithCarriages(t *testing.T) {
+	departure := time.Now().Add(48 * time.Hour)
+	route := func(number string, free int) domain.TrainRoute {
+		r := domain.TrainRoute{TrainNumber: number, Departure: departure}
+		if free > 0 {
+			r.CarTypes = []domain.CarriageType{{Type: domain.Coupe, FreeSeats: free}}
+		}
+		return r
+	}
+	provider := &concurrentCarsProvider{
+		stubProvider: stubProvider{
+			name: "cars",
+			routes: []domain.TrainRoute{
+				route("001А", 4), route("003А", 2), route("005А", 0), route("007А", 1), route("009А", 3),
+				// Типы вагонов известны, но места распроданы
+				{TrainNumber: "011А", Departure: departure, CarTypes: []domain.CarriageType{{Type: domain.Platz}}},
+			},
+		},
+		cars: map[string][]domain.Car{
+			"001А": {{CarNumber: "01"}},
+			"003А": {{CarNumber: "02"}},
+			"009А": {{CarNumber: "05"}, {CarNumber: "06"}},
+		},
+	}
+	svc := New(provider)
+
+	result, err := svc.GetRoutesWithCarriages(context.Background(), domain.RoutesWithCarriagesParams{
+		Routes:      domain.GetTrainRoutesParams{FromCode: 2004000, ToCode: 2000000, FromDate: departure},
+		Concurrency: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Routes, 6)
+	require.Len(t, result.Routes[0].Cars, 1)
+	require.Len(t, result.Routes[1].Cars, 1)
+	require.Empty(t, result.Routes[2].Cars, "train without seats is not requested")
+	require.Empty(t, result.Routes[3].Cars)
+	require.Len(t, result.Routes[4].Cars, 2)
+	require.Empty(t, result.Routes[5].Cars, "sold out train is not requested")
+	require.Len(t, result.Failures, 1)
+	require.Equal(t, "007А", result.Failures[0].TrainNumber)
+	require.ErrorIs(t, result.Failures[0].Err, domain.ErrTrainNotFound)
+	require.LessOrEqual(t, provider.peak, 2)
+	require.Len(t, provider.fetched, 4)
+
+	// Только выбранные поезда
+	provider.fetched = nil
+	result, err = svc.GetRoutesWithCarriages(context.Background(), domain.RoutesWithCarriagesParams{
+		Routes:       domain.GetTrainRoutesParams{FromCode: 2004000, ToCode: 2000000, FromDate: departure},
+		TrainNumbers: []string{"009А"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"009А"}, provider.fetched)
+	require.Empty(t, result.Routes[0].Cars)
+	require.Len(t, result.Routes[4].Cars, 2)
+	require.Empty(t, result.Failures)
+}
@@ -0,0 +1,185 @@
+// internal/service/cities.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// cityIndex города, найденные поиском станций, и станции городов, замеченные в ответах
+// на запросы маршрутов между городами
+type cityIndex struct {
+	mutex    sync.RWMutex
+	cities   map[int]domain.Station         // Код города -> город из поиска станций
+	stations map[int]map[int]domain.Station // Код города -> код станции -> станция
+}
+
+func newCityIndex() *cityIndex {
+	return &cityIndex{cities: make(map[int]domain.Station), stations: make(map[int]map[int]domain.Station)}
+}
+
+// remember запоминает города среди найденных поиском станций
+func (c *cityIndex) remember(stations []domain.Station) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, station := range stations {
+		if station.IsCity() {
+			c.cities[station.Code] = station
+		}
+	}
+}
+
+// city возвращает город по коду, если поиск станций находил его как город
+func (c *cityIndex) city(code int) (domain.Station, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	city, ok := c.cities[code]
+	return city, ok
+}
+
+// learn запоминает станции отправления и прибытия маршрутов, относящиеся к городам из запроса
+func (c *cityIndex) learn(routes []domain.TrainRoute) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, route := range routes {
+		c.addLocked(route.FromCity.Code, route.From)
+		c.addLocked(route.ToCity.Code, route.To)
+	}
+}
+
+func (c *cityIndex) addLocked(city int, station domain.Station) {
+	if city == 0 || station.Code == 0 || station.Code == city {
+		return
+	}
+	known, ok := c.stations[city]
+	if !ok {
+		known = make(map[int]domain.Station)
+		c.stations[city] = known
+	}
+	known[station.Code] = station
+}
+
+// get возвращает известные станции города, упорядоченные по коду
+func (c *cityIndex) get(city int) []domain.Station {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	stations := make([]domain.Station, 0, len(c.stations[city]))
+	for _, station := range c.stations[city] {
+		stations = append(stations, station)
+	}
+	sort.Slice(stations, func(i, j int) bool { return stations[i].Code < stations[j].Code })
+	return stations
+}
+
+// cityOf возвращает город, среди станций которого замечена станция
+func (c *cityIndex) cityOf(station int) (int, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for city, stations := range c.stations {
+		if _, ok := stations[station]; ok {
+			return city, true
+		}
+	}
+	return 0, false
+}
+
+// annotateCities отмечает город из запроса у маршрутов, которые идут не от самой запрошенной станции,
+// и запоминает станции городов. Источник данных не сообщает уровень запрошенной станции, поэтому
+// город отмечается, только если поиск станций находил запрошенный код как город.
+func (s *mainService) annotateCities(routes []domain.TrainRoute, params domain.GetTrainRoutesParams) {
+	fromCity, fromKnown := s.cities.city(params.FromCode)
+	toCity, toKnown := s.cities.city(params.ToCode)
+	for i := range routes {
+		route := &routes[i]
+		route.FromCity = routeCity(route.FromCity, route.From, fromCity, fromKnown)
+		route.ToCity = routeCity(route.ToCity, route.To, toCity, toKnown)
+	}
+	s.cities.learn(routes)
+}
+
+// routeCity возвращает город из запроса для станции маршрута: пустой, если поезд идёт от самой
+// запрошенной станции или она не известна как город. Часовой пояс, указанный источником, сохраняется.
+func routeCity(reported, station, city domain.Station, known bool) domain.Station {
+	if !known || station.Code == city.Code {
+		return domain.Station{}
+	}
+	if city.TimeZone == "" && reported.Code == city.Code {
+		city.TimeZone = reported.TimeZone
+	}
+	return city
+}
+
+// GetCityStations возвращает город по названию и его станции: найденные поиском станций
+// (названия начинаются с названия города) и замеченные в маршрутах между городами
+func (s *mainService) GetCityStations(ctx context.Context, params domain.CityStationsParams) (domain.City, error) {
+	found, err := s.provider.SearchStation(ctx, domain.SearchStationParams{
+		Query:    normalizeStationName(params.Query),
+		Language: params.Language,
+	})
+	if err != nil {
+		return domain.City{}, fmt.Errorf("failed to search city %q: %w", params.Query, err)
+	}
+	s.cities.remember(found)
+
+	var cities []domain.Station
+	for _, station := range found {
+		if station.IsCity() {
+			cities = append(cities, station)
+		}
+	}
+	station, err := matchStation(cities, "city", params.Query)
+	if errors.Is(err, domain.ErrStationNotFound) {
+		return domain.City{}, fmt.Errorf("city %q: %w", params.Query, err)
+	}
+	if err != nil {
+		return domain.City{}, err
+	}
+
+	city := domain.City{Station: station}
+	seen := map[int]struct{}{city.Station.Code: {}}
+	base := cityBaseName(city.Station.Name)
+	for _, station := range found {
+		if _, ok := seen[station.Code]; ok || station.IsCity() || !belongsToCity(station.Name, base) {
+			continue
+		}
+		seen[station.Code] = struct{}{}
+		city.Stations = append(city.Stations, station)
+	}
+	for _, station := range s.cities.get(city.Station.Code) {
+		if _, ok := seen[station.Code]; !ok {
+			seen[station.Code] = struct{}{}
+			city.Stations = append(city.Stations, station)
+		}
+	}
+	return city, nil
+}
+
+// cityBaseName название города без пояснения в скобках: "МОСКВА (ВСЕ ВОКЗАЛЫ)" -> "МОСКВА"
+func cityBaseName(name string) string {
+	name = normalizeStationName(name)
+	if i := strings.Index(name, " ("); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// belongsToCity сообщает, что название станции начинается с названия города как отдельного слова:
+// "МОСКВА КУРСКАЯ" и "САНКТ-ПЕТЕРБУРГ-ГЛАВН." относятся к городу, "МОСКВАРЕЧЬЕ" - нет
+func belongsToCity(stationName, base string) bool {
+	name := normalizeStationName(stationName)
+	if !strings.HasPrefix(name, base) || len(name) == len(base) {
+		return false
+	}
+	switch name[len(base)] {
+	case ' ', '-', '(':
+		return true
+	default:
+		return false
+	}
+}
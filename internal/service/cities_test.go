@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+func TestCityStations(t *testing.T) {
+	provider := &stubProvider{
+		name: "cities",
+		routes: []domain.TrainRoute{
+			{TrainNumber: "119А", From: domain.Station{Code: 2004001}, To: domain.Station{Code: 2001025, Name: "МОСКВА ВК ВОСТОЧНЫЙ"}},
+			{TrainNumber: "755А", From: domain.Station{Code: 2004001}, To: domain.Station{Code: 2006004, Name: "МОСКВА ОКТЯБРЬСКАЯ"}},
+		},
+		stations: []domain.Station{
+			{Name: "МОСКВА", Code: 2000000, Level: 5, Score: 5},
+			{Name: "МОСКВА КАЗАНСКАЯ", Code: 2000003, Level: 4},
+			{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004, Level: 4},
+			{Name: "МОСКВАРЕЧЬЕ", Code: 2000999, Level: 1},
+		},
+	}
+	svc := New(provider)
+
+	// Код ещё не встречался в поиске станций: город ли это, неизвестно, и он не отмечается
+	params := domain.GetTrainRoutesParams{
+		FromCode: 2004001,
+		ToCode:   2000000,
+		FromDate: time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	}
+	routes, err := svc.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.Zero(t, routes[0].ToCity.Code)
+
+	// Город, найденный по названию, отмечается у поездов до разных его вокзалов
+	params.ToCode = 0
+	params.ToStation = "москва"
+	routes, err = svc.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.Zero(t, routes[0].FromCity.Code)
+	require.Equal(t, domain.Station{Name: "МОСКВА", Code: 2000000, Level: 5, Score: 5}, routes[0].ToCity)
+	require.True(t, routes[1].ToCity.IsCity())
+
+	// После поиска код города распознаётся и без названия
+	params.ToCode = 2000000
+	params.ToStation = ""
+	routes, err = svc.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 2000000, routes[0].ToCity.Code)
+
+	// Станция не из города не отмечается, даже если источник указал станцию запроса
+	provider.routes[0].FromCity = domain.Station{Code: 2004000, Name: "САНКТ-ПЕТЕРБУРГ"}
+	routes, err = svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{
+		FromCode: 2004000, ToCode: 2000000, FromDate: params.FromDate,
+	})
+	require.NoError(t, err)
+	require.Zero(t, routes[0].FromCity)
+	provider.routes[0].FromCity = domain.Station{}
+
+	// Станции города: найденные по названию и замеченные в маршрутах, без дубликатов
+	city, err := svc.GetCityStations(context.Background(), domain.CityStationsParams{Query: "москва"})
+	require.NoError(t, err)
+	require.Equal(t, 2000000, city.Station.Code)
+	var codes []int
+	for _, station := range city.Stations {
+		codes = append(codes, station.Code)
+	}
+	require.Equal(t, []int{2000003, 2006004, 2001025}, codes)
+
+	provider.stations = provider.stations[1:]
+	_, err = svc.GetCityStations(context.Background(), domain.CityStationsParams{Query: "москва"})
+	require.ErrorIs(t, err, domain.ErrStationNotFound)
+}
@@ -0,0 +1,173 @@
+// internal/service/codes.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// CodeRegistry таблица соответствия кодов станций Экспресс-3, ЕСР и UIC
+type CodeRegistry interface {
+	// Lookup возвращает коды станции по коду, приведённому domain.NormalizeStationCode
+	Lookup(codeType domain.StationCodeType, code string) (domain.StationCodes, bool)
+	// Len возвращает количество станций в таблице
+	Len() int
+}
+
+// LookupStationCodes без таблицы соответствия не поддерживается: см. NewCodesService
+func (s *mainService) LookupStationCodes(context.Context, domain.StationCodeParams) (domain.StationCodes, error) {
+	return domain.StationCodes{}, errCodesNotLoaded
+}
+
+// errCodesNotLoaded коды, отличные от Экспресс-3, без таблицы соответствия не понять
+var errCodesNotLoaded = fmt.Errorf("station code registry is not loaded: %w", domain.ErrNotSupported)
+
+// requireExpress3 проверяет, что коды станций запроса уже в системе Экспресс-3,
+// которую понимает источник данных
+func requireExpress3(codeType domain.StationCodeType) error {
+	if codeType != domain.CodeExpress3 {
+		return fmt.Errorf("%s station codes: %w", codeType, errCodesNotLoaded)
+	}
+	return nil
+}
+
+// codesService декоратор сервиса, переводящий коды станций ЕСР и UIC из запросов в коды Экспресс-3
+type codesService struct {
+	next  Service
+	codes CodeRegistry
+}
+
+// NewCodesService оборачивает сервис так, что в запросах маршрутов, вагонов, тарифов и подбора мест
+// коды станций можно передавать в системах ЕСР и UIC, и включает поиск кодов станции.
+func NewCodesService(next Service, codes CodeRegistry) Service {
+	return &codesService{next: next, codes: codes}
+}
+
+// GetTrainRoutes получение маршрутов поездов по кодам в любой системе
+func (s *codesService) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	var err error
+	if params.FromCode, params.ToCode, err = s.translate(params.CodeType, params.FromCode, params.ToCode); err != nil {
+		return nil, err
+	}
+	params.CodeType = domain.CodeExpress3
+	return s.next.GetTrainRoutes(ctx, params)
+}
+
+// GetTrainRoutesWithAlternatives маршруты с альтернативами по кодам в любой системе
+func (s *codesService) GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (domain.RoutesWithAlternatives, error) {
+	var err error
+	if params.Routes.FromCode, params.Routes.ToCode, err = s.translate(params.Routes.CodeType, params.Routes.FromCode, params.Routes.ToCode); err != nil {
+		return domain.RoutesWithAlternatives{}, err
+	}
+	params.Routes.CodeType = domain.CodeExpress3
+	return s.next.GetTrainRoutesWithAlternatives(ctx, params)
+}
+
+// GetTrainCarriages получение информации о вагонах по кодам в любой системе
+func (s *codesService) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	var err error
+	if params.FromCode, params.ToCode, err = s.translate(params.CodeType, params.FromCode, params.ToCode); err != nil {
+		return nil, err
+	}
+	params.CodeType = domain.CodeExpress3
+	return s.next.GetTrainCarriages(ctx, params)
+}
+
+// GetRoutesWithCarriages маршруты с вагонами по кодам в любой системе
+func (s *codesService) GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error) {
+	var err error
+	if params.Routes.FromCode, params.Routes.ToCode, err = s.translate(params.Routes.CodeType, params.Routes.FromCode, params.Routes.ToCode); err != nil {
+		return domain.RoutesWithCarriages{}, err
+	}
+	params.Routes.CodeType = domain.CodeExpress3
+	return s.next.GetRoutesWithCarriages(ctx, params)
+}
+
+// SearchStation поиск станций; кодов в запросе нет
+func (s *codesService) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	return s.next.SearchStation(ctx, params)
+}
+
+// GetFareSummary сводка тарифов по кодам в любой системе
+func (s *codesService) GetFareSummary(ctx context.Context, params domain.FareSummaryParams) ([]domain.FareSummary, error) {
+	var err error
+	if params.FromCode, params.ToCode, err = s.translate(params.CodeType, params.FromCode, params.ToCode); err != nil {
+		return nil, err
+	}
+	params.CodeType = domain.CodeExpress3
+	return s.next.GetFareSummary(ctx, params)
+}
+
+// RecommendSeats подбор мест по кодам в любой системе
+func (s *codesService) RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) ([]domain.TrainSeatRecommendations, error) {
+	var err error
+	if params.FromCode, params.ToCode, err = s.translate(params.CodeType, params.FromCode, params.ToCode); err != nil {
+		return nil, err
+	}
+	params.CodeType = domain.CodeExpress3
+	return s.next.RecommendSeats(ctx, params)
+}
+
+// FindTrainByNumber поиск поезда по номеру; кодов в запросе нет
+func (s *codesService) FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	return s.next.FindTrainByNumber(ctx, params)
+}
+
+// GetCityStations станции города; кодов в запросе нет
+func (s *codesService) GetCityStations(ctx context.Context, params domain.CityStationsParams) (domain.City, error) {
+	return s.next.GetCityStations(ctx, params)
+}
+
+// GetNearbyStations станции рядом с точкой; кодов в запросе нет
+func (s *codesService) GetNearbyStations(ctx context.Context, params domain.NearbyStationsParams) ([]domain.NearbyStation, error) {
+	return s.next.GetNearbyStations(ctx, params)
+}
+
+// LookupStationCodes коды станции во всех системах по коду в одной из них
+func (s *codesService) LookupStationCodes(_ context.Context, params domain.StationCodeParams) (domain.StationCodes, error) {
+	if s.codes.Len() == 0 {
+		return domain.StationCodes{}, errCodesNotLoaded
+	}
+	code, err := domain.NormalizeStationCode(params.Type, params.Code)
+	if err != nil {
+		return domain.StationCodes{}, fmt.Errorf("%w: %w", domain.ErrInvalidStationCode, err)
+	}
+	codes, ok := s.codes.Lookup(params.Type, code)
+	if !ok {
+		return domain.StationCodes{}, fmt.Errorf("%s code %s: %w", params.Type, code, domain.ErrStationNotFound)
+	}
+	return codes, nil
+}
+
+// GetSchemaDrift диагностика контракта upstream
+func (s *codesService) GetSchemaDrift(ctx context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error) {
+	return s.next.GetSchemaDrift(ctx, params)
+}
+
+// translate переводит коды станций отправления и прибытия в Экспресс-3; незаданные коды остаются нулевыми
+func (s *codesService) translate(codeType domain.StationCodeType, from, to int) (int, int, error) {
+	if codeType == domain.CodeExpress3 {
+		return from, to, nil
+	}
+	if s.codes.Len() == 0 {
+		return 0, 0, requireExpress3(codeType)
+	}
+	codes := [2]int{from, to}
+	for i, code := range codes {
+		if code == 0 {
+			continue
+		}
+		normalized, err := domain.NormalizeStationCode(codeType, domain.StationCodeFromNumber(codeType, code))
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w: %w", domain.ErrInvalidStationCode, err)
+		}
+		station, ok := s.codes.Lookup(codeType, normalized)
+		if !ok {
+			return 0, 0, fmt.Errorf("%s code %s: %w", codeType, normalized, domain.ErrStationNotFound)
+		}
+		codes[i] = station.Express3
+	}
+	return codes[0], codes[1], nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/stationcodes"
+)
+
+// codesProvider запоминает коды станций запроса маршрутов
+type codesProvider struct {
+	stubProvider
+	params domain.GetTrainRoutesParams
+}
+
+func (p *codesProvider) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	p.params = params
+	return nil, nil
+}
+
+func TestStationCodes(t *testing.T) {
+	provider := &codesProvider{stubProvider: stubProvider{name: "codes"}}
+	date := time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC)
+
+	// Без таблицы соответствия понятны только коды Экспресс-3
+	registry := stationcodes.NewRegistry()
+	svc := NewCodesService(New(provider), registry)
+	_, err := svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 60073, ToCode: 194013, FromDate: date, CodeType: domain.CodeESR})
+	require.ErrorIs(t, err, domain.ErrNotSupported)
+	_, err = New(provider).GetFareSummary(context.Background(), domain.FareSummaryParams{FromCode: 60073, ToCode: 194013, Date: date, CodeType: domain.CodeESR})
+	require.ErrorIs(t, err, domain.ErrNotSupported)
+
+	registry.Add(
+		domain.StationCodes{Express3: 2006004, ESR: "060073"},
+		domain.StationCodes{Express3: 2000003, ESR: "194013"},
+	)
+	// Ведущий ноль кода ЕСР теряется в числовом поле запроса
+	_, err = svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 60073, ToCode: 194013, FromDate: date, CodeType: domain.CodeESR})
+	require.NoError(t, err)
+	require.Equal(t, 2006004, provider.params.FromCode)
+	require.Equal(t, 2000003, provider.params.ToCode)
+	require.Equal(t, domain.CodeExpress3, provider.params.CodeType)
+
+	_, err = svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 60073, ToCode: 194028, FromDate: date, CodeType: domain.CodeESR})
+	require.ErrorIs(t, err, domain.ErrStationNotFound)
+	_, err = svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 60073, ToCode: 194014, FromDate: date, CodeType: domain.CodeESR})
+	require.ErrorIs(t, err, domain.ErrInvalidStationCode)
+
+	codes, err := svc.LookupStationCodes(context.Background(), domain.StationCodeParams{Type: domain.CodeESR, Code: "19401"})
+	require.NoError(t, err)
+	require.Equal(t, 2000003, codes.Express3)
+	codes, err = svc.LookupStationCodes(context.Background(), domain.StationCodeParams{Type: domain.CodeExpress3, Code: "2006004"})
+	require.NoError(t, err)
+	require.Equal(t, "060073", codes.ESR)
+}
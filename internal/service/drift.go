@@ -0,0 +1,47 @@
+// internal/service/drift.go
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// DriftReporter провайдер, который сверяет ответы upstream с контрактом схем
+// и хранит последние расхождения
+type DriftReporter interface {
+	SchemaDrift(endpoint string, limit int) []domain.SchemaDriftEvent
+}
+
+// GetSchemaDrift возвращает последние расхождения ответов upstream с контрактом схем.
+// Провайдеры без проверки контракта событий не дают.
+func (s *mainService) GetSchemaDrift(_ context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error) {
+	return collectSchemaDrift([]Provider{s.provider}, params.Endpoint, params.Limit), nil
+}
+
+// SchemaDrift объединяет расхождения провайдеров цепочки
+func (p *fallbackProvider) SchemaDrift(endpoint string, limit int) []domain.SchemaDriftEvent {
+	return collectSchemaDrift(p.providers, endpoint, limit)
+}
+
+// SchemaDrift объединяет расхождения объединяемых провайдеров
+func (p *mergingProvider) SchemaDrift(endpoint string, limit int) []domain.SchemaDriftEvent {
+	return collectSchemaDrift(p.providers, endpoint, limit)
+}
+
+// collectSchemaDrift собирает события провайдеров, реализующих DriftReporter,
+// от последнего к первому. limit <= 0 означает все события.
+func collectSchemaDrift(providers []Provider, endpoint string, limit int) []domain.SchemaDriftEvent {
+	var events []domain.SchemaDriftEvent
+	for _, provider := range providers {
+		if reporter, ok := provider.(DriftReporter); ok {
+			events = append(events, reporter.SchemaDrift(endpoint, limit)...)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].LastSeen.After(events[j].LastSeen) })
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events
+}
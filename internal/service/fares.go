@@ -0,0 +1,157 @@
+// internal/service/fares.go
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// GetFareSummary сводка тарифов по типам мест среди поездов направления на дату
+func (s *mainService) GetFareSummary(ctx context.Context, params domain.FareSummaryParams) ([]domain.FareSummary, error) {
+	if err := requireExpress3(params.CodeType); err != nil {
+		return nil, err
+	}
+	routes, err := s.GetTrainRoutes(ctx, domain.GetTrainRoutesParams{
+		FromCode:   params.FromCode,
+		ToCode:     params.ToCode,
+		Direction:  domain.OneWay,
+		TrainType:  params.TrainType,
+		CheckSeats: true,
+		FromDate:   params.Date,
+		TrainTypes: params.TrainTypes,
+		Language:   params.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	summaries := summarizeFares(routes)
+	if params.WithSeatPrices && len(summaries) > 0 {
+		s.addSeatPrices(ctx, params, routes, summaries)
+	}
+	return summaries, nil
+}
+
+// summarizeFares группирует тарифы из агрегированных данных о вагонах по типам мест.
+// Классы одного типа мест в поезде объединяются, чтобы поезд попадал в список не более одного раза.
+func summarizeFares(routes []domain.TrainRoute) []domain.FareSummary {
+	byType := make(map[domain.CarSeatType]*domain.FareSummary)
+	for _, route := range routes {
+		train := domain.FareTrain{TrainNumber: route.TrainNumber, Departure: route.Departure}
+		for seatType, fare := range routeFares(route.CarTypes) {
+			summary, ok := byType[seatType]
+			if !ok {
+				summary = &domain.FareSummary{SeatType: seatType, MinTariff: fare.min, MaxTariff: fare.max}
+				byType[seatType] = summary
+			}
+			summary.Trains++
+			summary.FreeSeats += fare.free
+
+			switch {
+			case fare.min < summary.MinTariff:
+				summary.MinTariff = fare.min
+				summary.MinTrains = []domain.FareTrain{train}
+			case fare.min == summary.MinTariff:
+				summary.MinTrains = append(summary.MinTrains, train)
+			}
+			switch {
+			case fare.max > summary.MaxTariff:
+				summary.MaxTariff = fare.max
+				summary.MaxTrains = []domain.FareTrain{train}
+			case fare.max == summary.MaxTariff:
+				summary.MaxTrains = append(summary.MaxTrains, train)
+			}
+		}
+	}
+
+	summaries := make([]domain.FareSummary, 0, len(byType))
+	for _, summary := range byType {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].SeatType < summaries[j].SeatType })
+	return summaries
+}
+
+// trainFare тарифы одного типа мест в одном поезде
+type trainFare struct {
+	min, max, free int
+	tierFree       int // Свободные места по ценовым ступеням: учитываются, только если сводки по типу нет
+}
+
+// routeFares возвращает тарифы поезда по типам мест. Максимальный тариф - наибольший
+// из основного и дополнительного, типы без цены пропускаются. Ценовые ступени сидячих мест
+// уточняют тарифы, но их свободные места уже учтены в сводке по типу вагона.
+func routeFares(carTypes []domain.CarriageType) map[domain.CarSeatType]trainFare {
+	fares := make(map[domain.CarSeatType]trainFare)
+	for _, carType := range carTypes {
+		if carType.Tariff <= 0 {
+			continue
+		}
+		high := carType.Tariff
+		if carType.TariffExtra > high {
+			high = carType.TariffExtra
+		}
+		fare, ok := fares[carType.Type]
+		if !ok {
+			fare = trainFare{min: carType.Tariff, max: high}
+		}
+		if carType.Tariff < fare.min {
+			fare.min = carType.Tariff
+		}
+		if high > fare.max {
+			fare.max = high
+		}
+		if carType.DataType == domain.CarDataSeatTier {
+			fare.tierFree += carType.FreeSeats
+		} else {
+			fare.free += carType.FreeSeats
+		}
+		fares[carType.Type] = fare
+	}
+	for seatType, fare := range fares {
+		if fare.free == 0 {
+			fare.free = fare.tierFree
+			fares[seatType] = fare
+		}
+	}
+	return fares
+}
+
+// addSeatPrices запрашивает вагоны каждого поезда и дополняет сводку минимальными ценами
+// нижних и верхних мест. Поезда, для которых вагоны получить не удалось, пропускаются.
+func (s *mainService) addSeatPrices(ctx context.Context, params domain.FareSummaryParams, routes []domain.TrainRoute, summaries []domain.FareSummary) {
+	carsByRoute := s.carriagesForRoutes(ctx, params.FromCode, params.ToCode, params.Language, routes)
+
+	index := make(map[domain.CarSeatType]*domain.FareSummary, len(summaries))
+	for i := range summaries {
+		index[summaries[i].SeatType] = &summaries[i]
+	}
+	for _, cars := range carsByRoute {
+		for _, car := range cars {
+			summary, ok := index[car.SeatType]
+			if !ok {
+				continue
+			}
+			for _, seat := range car.Seats {
+				if seat.Free <= 0 || seat.Tariff <= 0 {
+					continue
+				}
+				switch seat.Type {
+				case domain.SeatLower:
+					summary.LowerSeatMinTariff = minPositive(summary.LowerSeatMinTariff, seat.Tariff)
+				case domain.SeatUpper:
+					summary.UpperSeatMinTariff = minPositive(summary.UpperSeatMinTariff, seat.Tariff)
+				}
+			}
+		}
+	}
+}
+
+// minPositive возвращает меньшее из значений, считая 0 отсутствием значения
+func minPositive(current, value int) int {
+	if current == 0 || value < current {
+		return value
+	}
+	return current
+}
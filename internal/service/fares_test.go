@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/mappers"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+// carsProvider отдаёт вагоны по номеру поезда; остальные ответы берутся из stubProvider
+type carsProvider struct {
+	*stubProvider
+	cars map[string][]domain.Car
+}
+
+func (p *carsProvider) GetTrainCarriages(_ context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	return p.cars[params.TrainNumber], nil
+}
+
+// legacyCars собирает вагоны из схемы ответа РЖД через маппер, чтобы тип вагона
+// задавался настоящими кодами ctype/ctypei (см. docs/data_templates/GetTrainCarriages.json)
+func legacyCars(t *testing.T, cars ...schemas.Car) []domain.Car {
+	t.Helper()
+	mapped, err := mappers.MapTrainCarriagesResponse(schemas.TrainCarriagesResponse{
+		Lst: []schemas.TrainResult{{Cars: cars}},
+	})
+	require.NoError(t, err)
+	return mapped
+}
+
+func TestGetFareSummary(t *testing.T) {
+	departure := time.Date(2025, 2, 13, 0, 12, 0, 0, time.UTC)
+	provider := &carsProvider{
+		stubProvider: &stubProvider{routes: []domain.TrainRoute{
+			{TrainNumber: "119А", Departure: departure, CarTypes: []domain.CarriageType{
+				{Type: domain.Platz, Tariff: 1822, FreeSeats: 41},
+				{Type: domain.Coupe, Class: "2Э", Tariff: 2533, TariffExtra: 3463, FreeSeats: 12},
+				{Type: domain.Coupe, Class: "2К", Tariff: 2900, FreeSeats: 4},
+			}},
+			{TrainNumber: "021А", Departure: departure.Add(time.Hour), CarTypes: []domain.CarriageType{
+				{Type: domain.Coupe, Tariff: 2533, TariffExtra: 5100, FreeSeats: 20},
+				{Type: domain.Lux, Tariff: 9000},
+			}},
+		}},
+		cars: map[string][]domain.Car{
+			"119А": legacyCars(t,
+				schemas.Car{Type: "Купе", Ctype: 4, Ctypei: 4, Seats: []schemas.Seat{
+					{Type: domain.SeatLower, Tariff: 3463, Free: 2},
+					{Type: domain.SeatUpper, Tariff: 2533, Free: 11},
+				}},
+				schemas.Car{Type: "Плац", Ctype: 3, Ctypei: 1, Seats: []schemas.Seat{
+					{Type: domain.SeatUpper, Tariff: 2100, Free: 3},
+				}},
+			),
+			"021А": legacyCars(t,
+				schemas.Car{Type: "Купе", Ctype: 4, Ctypei: 4, Seats: []schemas.Seat{
+					{Type: domain.SeatLower, Tariff: 3100, Free: 1},
+					{Type: domain.SeatUpper, Tariff: 2400, Free: 0},
+				}},
+			),
+		},
+	}
+
+	summaries, err := New(provider).GetFareSummary(context.Background(), domain.FareSummaryParams{
+		FromCode: 2004000, ToCode: 2000000, Date: departure, WithSeatPrices: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+
+	require.Equal(t, domain.FareSummary{
+		SeatType: domain.Platz, MinTariff: 1822, MaxTariff: 1822, FreeSeats: 41, Trains: 1,
+		UpperSeatMinTariff: 2100,
+		MinTrains:          []domain.FareTrain{{TrainNumber: "119А", Departure: departure}},
+		MaxTrains:          []domain.FareTrain{{TrainNumber: "119А", Departure: departure}},
+	}, summaries[0])
+
+	// Оба поезда предлагают минимальный тариф купе, классы одного поезда объединены
+	coupe := summaries[1]
+	require.Equal(t, domain.Coupe, coupe.SeatType)
+	require.Equal(t, 2533, coupe.MinTariff)
+	require.Equal(t, 5100, coupe.MaxTariff)
+	require.Equal(t, 36, coupe.FreeSeats)
+	require.Equal(t, 2, coupe.Trains)
+	require.Len(t, coupe.MinTrains, 2)
+	require.Equal(t, []domain.FareTrain{{TrainNumber: "021А", Departure: departure.Add(time.Hour)}}, coupe.MaxTrains)
+	// Распроданные места не учитываются
+	require.Equal(t, 3100, coupe.LowerSeatMinTariff)
+	require.Equal(t, 2533, coupe.UpperSeatMinTariff)
+
+	require.Equal(t, domain.Lux, summaries[2].SeatType)
+	require.Zero(t, summaries[2].LowerSeatMinTariff)
+}
+
+// Ценовые ступени сидячих мест уточняют тарифы, но их места уже учтены в сводке по типу
+func TestRouteFaresSeatTiers(t *testing.T) {
+	fares := routeFares([]domain.CarriageType{
+		{Type: domain.Side, Tariff: 10426, FreeSeats: 8, DataType: domain.CarDataSummary},
+		{Type: domain.Side, Tariff: 10426, FreeSeats: 5, DataType: domain.CarDataSeatTier},
+		{Type: domain.Side, Tariff: 29138, TariffExtra: 31170, FreeSeats: 3, DataType: domain.CarDataSeatTier},
+		{Type: domain.Soft, Tariff: 36655, FreeSeats: 2, DataType: domain.CarDataSeatTier},
+	})
+	require.Equal(t, trainFare{min: 10426, max: 31170, free: 8, tierFree: 8}, fares[domain.Side])
+	require.Equal(t, 2, fares[domain.Soft].free)
+}
@@ -0,0 +1,183 @@
+// internal/service/geo.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// defaultNearbyLimit сколько станций возвращает поиск рядом с точкой, если лимит не задан
+const defaultNearbyLimit = 20
+
+// GeoData географические данные станций по коду Экспресс-3
+type GeoData interface {
+	// Lookup возвращает станцию с координатами, регионом и кодом ЕСР
+	Lookup(code int) (domain.Station, bool)
+	// Nearby возвращает до limit станций не дальше radiusKm от точки, от ближайшей к дальней
+	Nearby(point domain.GeoPoint, radiusKm float64, limit int) []domain.NearbyStation
+	// Len возвращает количество станций в реестре
+	Len() int
+}
+
+// GetNearbyStations без географических данных не поддерживается: см. NewGeoService
+func (s *mainService) GetNearbyStations(context.Context, domain.NearbyStationsParams) ([]domain.NearbyStation, error) {
+	return nil, errGeoNotLoaded
+}
+
+// errGeoNotLoaded без реестра с координатами станции рядом с точкой не найти
+var errGeoNotLoaded = fmt.Errorf("nearby stations: geodata is not loaded: %w", domain.ErrNotSupported)
+
+// geoService декоратор сервиса, дополняющий станции в ответах географическими данными
+type geoService struct {
+	next Service
+	geo  GeoData
+}
+
+// NewGeoService оборачивает сервис так, что у станций в ответах заполняются координаты, регион
+// и код ЕСР (а также название и часовой пояс, если источник их не сообщил), и включает поиск станций рядом с точкой.
+func NewGeoService(next Service, geo GeoData) Service {
+	return &geoService{next: next, geo: geo}
+}
+
+// GetTrainRoutes получение маршрутов поездов с координатами станций
+func (s *geoService) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	routes, err := s.next.GetTrainRoutes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for i := range routes {
+		s.enrichRoute(&routes[i])
+	}
+	return routes, nil
+}
+
+// GetTrainRoutesWithAlternatives маршруты и альтернативы с координатами станций
+func (s *geoService) GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (domain.RoutesWithAlternatives, error) {
+	result, err := s.next.GetTrainRoutesWithAlternatives(ctx, params)
+	if err != nil {
+		return result, err
+	}
+	for i := range result.Routes {
+		s.enrichRoute(&result.Routes[i])
+	}
+	for i := range result.Alternatives {
+		s.enrichRoute(&result.Alternatives[i].Route)
+	}
+	return result, nil
+}
+
+// GetTrainCarriages получение информации о вагонах; станций в ответе нет
+func (s *geoService) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	return s.next.GetTrainCarriages(ctx, params)
+}
+
+// GetRoutesWithCarriages маршруты с вагонами и координатами станций
+func (s *geoService) GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error) {
+	result, err := s.next.GetRoutesWithCarriages(ctx, params)
+	if err != nil {
+		return result, err
+	}
+	for i := range result.Routes {
+		s.enrichRoute(&result.Routes[i])
+	}
+	return result, nil
+}
+
+// SearchStation поиск станций с координатами
+func (s *geoService) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	stations, err := s.next.SearchStation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stations {
+		s.enrich(&stations[i])
+	}
+	return stations, nil
+}
+
+// GetFareSummary сводка тарифов; станций в ответе нет
+func (s *geoService) GetFareSummary(ctx context.Context, params domain.FareSummaryParams) ([]domain.FareSummary, error) {
+	return s.next.GetFareSummary(ctx, params)
+}
+
+// RecommendSeats подбор мест; станций в ответе нет
+func (s *geoService) RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) ([]domain.TrainSeatRecommendations, error) {
+	return s.next.RecommendSeats(ctx, params)
+}
+
+// FindTrainByNumber поиск поезда по номеру с координатами остановок
+func (s *geoService) FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	schedule, err := s.next.FindTrainByNumber(ctx, params)
+	if err != nil {
+		return schedule, err
+	}
+	s.enrichRoute(&schedule.Route)
+	for i := range schedule.Stops {
+		s.enrich(&schedule.Stops[i].Station)
+	}
+	return schedule, nil
+}
+
+// GetCityStations станции города с координатами
+func (s *geoService) GetCityStations(ctx context.Context, params domain.CityStationsParams) (domain.City, error) {
+	city, err := s.next.GetCityStations(ctx, params)
+	if err != nil {
+		return city, err
+	}
+	s.enrich(&city.Station)
+	for i := range city.Stations {
+		s.enrich(&city.Stations[i])
+	}
+	return city, nil
+}
+
+// GetNearbyStations станции не дальше радиуса от точки, от ближайшей к дальней.
+// Пустой реестр (файл не загружен) - то же, что отсутствие геоданных.
+func (s *geoService) GetNearbyStations(_ context.Context, params domain.NearbyStationsParams) ([]domain.NearbyStation, error) {
+	if s.geo.Len() == 0 {
+		return nil, errGeoNotLoaded
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultNearbyLimit
+	}
+	return s.geo.Nearby(params.Point, params.RadiusKm, limit), nil
+}
+
+// LookupStationCodes коды станции в разных системах; станций в ответе нет
+func (s *geoService) LookupStationCodes(ctx context.Context, params domain.StationCodeParams) (domain.StationCodes, error) {
+	return s.next.LookupStationCodes(ctx, params)
+}
+
+// GetSchemaDrift диагностика контракта upstream
+func (s *geoService) GetSchemaDrift(ctx context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error) {
+	return s.next.GetSchemaDrift(ctx, params)
+}
+
+// enrichRoute дополняет станции маршрута
+func (s *geoService) enrichRoute(route *domain.TrainRoute) {
+	for _, station := range []*domain.Station{&route.From, &route.To, &route.FromCity, &route.ToCity} {
+		if station.Code != 0 {
+			s.enrich(station)
+		}
+	}
+}
+
+// enrich дополняет станцию данными из реестра; данные источника имеют приоритет для названия и пояса
+func (s *geoService) enrich(station *domain.Station) {
+	geo, ok := s.geo.Lookup(station.Code)
+	if !ok {
+		return
+	}
+	station.Location = geo.Location
+	station.Region = geo.Region
+	station.ESRCode = geo.ESRCode
+	if station.Name == "" {
+		station.Name = geo.Name
+	}
+	if station.TimeZone == "" {
+		station.TimeZone = geo.TimeZone
+	}
+}
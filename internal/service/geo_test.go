@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// stubGeoData географические данные в памяти
+type stubGeoData struct {
+	stations map[int]domain.Station
+	limit    int
+}
+
+func (g *stubGeoData) Lookup(code int) (domain.Station, bool) {
+	station, ok := g.stations[code]
+	return station, ok
+}
+
+func (g *stubGeoData) Nearby(_ domain.GeoPoint, _ float64, limit int) []domain.NearbyStation {
+	g.limit = limit
+	var nearby []domain.NearbyStation
+	for _, station := range g.stations {
+		nearby = append(nearby, domain.NearbyStation{Station: station})
+	}
+	return nearby
+}
+
+func (g *stubGeoData) Len() int {
+	return len(g.stations)
+}
+
+func TestGeoService(t *testing.T) {
+	provider := &stubProvider{
+		name: "geo",
+		stations: []domain.Station{
+			{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004},
+			{Name: "ТВЕРЬ", Code: 2004600},
+		},
+	}
+	geo := &stubGeoData{stations: map[int]domain.Station{
+		2006004: {Name: "МОСКВА ОКТ.", Code: 2006004, ESRCode: "060073", Region: "Москва", TimeZone: "Europe/Moscow", Location: domain.GeoPoint{Lat: 55.7764, Lon: 37.6553}},
+	}}
+
+	_, err := New(provider).GetNearbyStations(context.Background(), domain.NearbyStationsParams{RadiusKm: 10})
+	require.ErrorIs(t, err, domain.ErrNotSupported)
+
+	svc := NewGeoService(New(provider), geo)
+	stations, err := svc.SearchStation(context.Background(), domain.SearchStationParams{Query: "москва"})
+	require.NoError(t, err)
+	// Название источника сохраняется, недостающее берётся из геоданных
+	require.Equal(t, "МОСКВА ОКТЯБРЬСКАЯ", stations[0].Name)
+	require.Equal(t, "060073", stations[0].ESRCode)
+	require.Equal(t, "Europe/Moscow", stations[0].TimeZone)
+	require.False(t, stations[0].Location.IsZero())
+	require.True(t, stations[1].Location.IsZero())
+
+	nearby, err := svc.GetNearbyStations(context.Background(), domain.NearbyStationsParams{RadiusKm: 10})
+	require.NoError(t, err)
+	require.Len(t, nearby, 1)
+	require.Equal(t, defaultNearbyLimit, geo.limit)
+
+	// Реестр пуст: поиск рядом с точкой не поддерживается, как и без декоратора
+	_, err = NewGeoService(New(provider), &stubGeoData{}).GetNearbyStations(context.Background(), domain.NearbyStationsParams{RadiusKm: 10})
+	require.ErrorIs(t, err, domain.ErrNotSupported)
+}
@@ -11,8 +11,40 @@ import (
 type Service interface {
 	// GetTrainRoutes возвращает маршруты поездов
 	GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error)
+	// GetTrainRoutesWithAlternatives возвращает маршруты с местами нужных типов, а если их нет - альтернативы
+	GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (domain.RoutesWithAlternatives, error)
 	// GetTrainCarriages возвращает информацию о вагонах поезда
 	GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error)
+	// GetRoutesWithCarriages возвращает маршруты с вагонами поездов и ошибки по отдельным поездам
+	GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error)
 	// SearchStation возвращает коды станций основываясь на поисковом запросе
 	SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error)
+	// GetFareSummary возвращает минимальные и максимальные тарифы по типам мест на направлении
+	GetFareSummary(ctx context.Context, params domain.FareSummaryParams) ([]domain.FareSummary, error)
+	// RecommendSeats подбирает места для группы пассажиров в поездах направления
+	RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) ([]domain.TrainSeatRecommendations, error)
+	// FindTrainByNumber возвращает маршрут поезда от начальной до конечной станции и его остановки
+	FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error)
+	// GetCityStations возвращает город по названию и станции, которые он объединяет
+	GetCityStations(ctx context.Context, params domain.CityStationsParams) (domain.City, error)
+	// GetNearbyStations возвращает станции не дальше радиуса от точки, от ближайшей к дальней
+	GetNearbyStations(ctx context.Context, params domain.NearbyStationsParams) ([]domain.NearbyStation, error)
+	// LookupStationCodes возвращает коды станции во всех системах по коду в одной из них
+	LookupStationCodes(ctx context.Context, params domain.StationCodeParams) (domain.StationCodes, error)
+	// GetSchemaDrift возвращает последние расхождения ответов upstream с контрактом схем
+	GetSchemaDrift(ctx context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error)
+}
+
+// Provider источник данных о маршрутах, вагонах и станциях (API РЖД или его альтернатива).
+// Сервис не зависит от конкретного клиента: провайдеры можно комбинировать через
+// NewFallbackProvider и NewMergingProvider.
+type Provider interface {
+	// Name возвращает имя провайдера для логов и диагностики
+	Name() string
+	// GetTrainRoutes возвращает маршруты поездов
+	GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error)
+	// GetTrainCarriages возвращает информацию о вагонах поезда
+	GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error)
+	// SearchStation возвращает станции по поисковому запросу
+	SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error)
 }
@@ -0,0 +1,200 @@
+// internal/service/providers.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// fallbackProvider опрашивает провайдеров по очереди до первого успешного ответа
+type fallbackProvider struct {
+	providers []Provider
+}
+
+// NewFallbackProvider возвращает провайдер, который обращается к primary, а при ошибке -
+// к резервным провайдерам в указанном порядке. Ошибка возвращается, только если отказали все.
+func NewFallbackProvider(primary Provider, fallbacks ...Provider) Provider {
+	return &fallbackProvider{providers: append([]Provider{primary}, fallbacks...)}
+}
+
+// Name возвращает имена провайдеров цепочки
+func (p *fallbackProvider) Name() string {
+	return "fallback(" + providerNames(p.providers) + ")"
+}
+
+// GetTrainRoutes возвращает маршруты первого ответившего провайдера
+func (p *fallbackProvider) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	return firstSuccessful(ctx, p.providers, func(provider Provider) ([]domain.TrainRoute, error) {
+		return provider.GetTrainRoutes(ctx, params)
+	})
+}
+
+// GetTrainCarriages возвращает вагоны первого ответившего провайдера
+func (p *fallbackProvider) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	return firstSuccessful(ctx, p.providers, func(provider Provider) ([]domain.Car, error) {
+		return provider.GetTrainCarriages(ctx, params)
+	})
+}
+
+// SearchStation возвращает станции первого ответившего провайдера
+func (p *fallbackProvider) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	return firstSuccessful(ctx, p.providers, func(provider Provider) ([]domain.Station, error) {
+		return provider.SearchStation(ctx, params)
+	})
+}
+
+func firstSuccessful[T any](ctx context.Context, providers []Provider, call func(Provider) (T, error)) (T, error) {
+	var errs []error
+	for _, provider := range providers {
+		result, err := call(provider)
+		if err == nil {
+			return result, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+		if ctx.Err() != nil {
+			break
+		}
+		log.Printf("Provider %s failed, trying next: %v", provider.Name(), err)
+	}
+	var zero T
+	return zero, errors.Join(errs...)
+}
+
+// mergingProvider опрашивает всех провайдеров параллельно и объединяет результаты
+type mergingProvider struct {
+	providers []Provider
+}
+
+// NewMergingProvider возвращает провайдер, объединяющий ответы всех провайдеров без дубликатов.
+// При совпадении записей приоритет у провайдера, указанного раньше; недостающие у него
+// типы вагонов и вагоны дополняются из остальных. Ошибка возвращается, только если отказали все.
+func NewMergingProvider(providers ...Provider) Provider {
+	return &mergingProvider{providers: providers}
+}
+
+// Name возвращает имена объединяемых провайдеров
+func (p *mergingProvider) Name() string {
+	return "merge(" + providerNames(p.providers) + ")"
+}
+
+// GetTrainRoutes объединяет маршруты, совпадающие по номеру поезда, станциям и времени отправления
+func (p *mergingProvider) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	results, err := collectAll(p.providers, func(provider Provider) ([]domain.TrainRoute, error) {
+		return provider.GetTrainRoutes(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []domain.TrainRoute
+	index := make(map[string]int)
+	for _, routes := range results {
+		for _, route := range routes {
+			key := fmt.Sprintf("%s|%d|%d|%d", route.TrainNumber, route.From.Code, route.To.Code, route.Departure.Unix())
+			i, exists := index[key]
+			if !exists {
+				index[key] = len(merged)
+				merged = append(merged, route)
+				continue
+			}
+			if len(merged[i].CarTypes) == 0 {
+				merged[i].CarTypes = route.CarTypes
+			}
+			if len(merged[i].Cars) == 0 {
+				merged[i].Cars = route.Cars
+			}
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Departure.Before(merged[j].Departure)
+	})
+	return merged, nil
+}
+
+// GetTrainCarriages объединяет вагоны, совпадающие по номеру, классу и типу
+func (p *mergingProvider) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	results, err := collectAll(p.providers, func(provider Provider) ([]domain.Car, error) {
+		return provider.GetTrainCarriages(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeUnique(results, func(c domain.Car) string {
+		return c.CarNumber + "|" + c.ClassType + "|" + c.Type
+	}), nil
+}
+
+// SearchStation объединяет станции по коду
+func (p *mergingProvider) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	results, err := collectAll(p.providers, func(provider Provider) ([]domain.Station, error) {
+		return provider.SearchStation(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeUnique(results, func(s domain.Station) string {
+		return fmt.Sprintf("%d", s.Code)
+	}), nil
+}
+
+// collectAll вызывает всех провайдеров параллельно и возвращает успешные результаты в порядке провайдеров
+func collectAll[T any](providers []Provider, call func(Provider) (T, error)) ([]T, error) {
+	results := make([]T, len(providers))
+	errs := make([]error, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func(i int, provider Provider) {
+			defer wg.Done()
+			results[i], errs[i] = call(provider)
+		}(i, provider)
+	}
+	wg.Wait()
+
+	var successful []T
+	var failed []error
+	for i, err := range errs {
+		if err != nil {
+			log.Printf("Provider %s failed: %v", providers[i].Name(), err)
+			failed = append(failed, fmt.Errorf("%s: %w", providers[i].Name(), err))
+			continue
+		}
+		successful = append(successful, results[i])
+	}
+	if len(successful) == 0 {
+		return nil, errors.Join(failed...)
+	}
+	return successful, nil
+}
+
+// mergeUnique объединяет списки, оставляя первое вхождение каждого ключа
+func mergeUnique[T any](lists [][]T, key func(T) string) []T {
+	var merged []T
+	seen := make(map[string]struct{})
+	for _, list := range lists {
+		for _, item := range list {
+			k := key(item)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+func providerNames(providers []Provider) string {
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// stubProvider провайдер с заранее заданными ответами
+type stubProvider struct {
+	name     string
+	routes   []domain.TrainRoute
+	stations []domain.Station
+	err      error
+	calls    int
+}
+
+func (p *stubProvider) Name() string { return p.name }
+
+func (p *stubProvider) GetTrainRoutes(context.Context, domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	p.calls++
+	return p.routes, p.err
+}
+
+func (p *stubProvider) GetTrainCarriages(context.Context, domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	p.calls++
+	return nil, p.err
+}
+
+func (p *stubProvider) SearchStation(context.Context, domain.SearchStationParams) ([]domain.Station, error) {
+	p.calls++
+	return p.stations, p.err
+}
+
+func TestFallbackProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary", err: errors.New("unavailable")}
+	backup := &stubProvider{name: "backup", stations: []domain.Station{{Code: 2000000, Name: "МОСКВА"}}}
+
+	stations, err := New(NewFallbackProvider(primary, backup)).SearchStation(context.Background(), domain.SearchStationParams{Query: "МОС"})
+	require.NoError(t, err)
+	require.Len(t, stations, 1)
+	require.Equal(t, 1, primary.calls)
+
+	backup.err = errors.New("also unavailable")
+	_, err = NewFallbackProvider(primary, backup).SearchStation(context.Background(), domain.SearchStationParams{Query: "МОС"})
+	require.ErrorContains(t, err, "primary: unavailable")
+	require.ErrorContains(t, err, "backup: also unavailable")
+}
+
+func TestMergingProviderDeduplicatesRoutes(t *testing.T) {
+	departure := time.Date(2025, 2, 13, 0, 12, 0, 0, time.UTC)
+	route := domain.TrainRoute{
+		TrainNumber: "119А",
+		TrainType:   domain.Train,
+		From:        domain.Station{Code: 2004001},
+		To:          domain.Station{Code: 2001025},
+		Departure:   departure,
+	}
+	withSeats := route
+	withSeats.CarTypes = []domain.CarriageType{{Type: domain.Coupe, FreeSeats: 12}}
+	sapsan := domain.TrainRoute{TrainNumber: "752А", TrainType: domain.HighSpeed, Departure: departure.Add(-time.Hour)}
+
+	primary := &stubProvider{name: "primary", routes: []domain.TrainRoute{route}}
+	secondary := &stubProvider{name: "secondary", routes: []domain.TrainRoute{withSeats, sapsan}}
+	failing := &stubProvider{name: "failing", err: errors.New("timeout")}
+
+	svc := New(NewMergingProvider(primary, secondary, failing))
+	routes, err := svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{TrainTypes: []domain.TrainType{domain.Train}})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, "119А", routes[0].TrainNumber)
+	require.Len(t, routes[0].CarTypes, 1, "car types are filled from the secondary provider")
+
+	routes, err = svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"752А", "119А"}, []string{routes[0].TrainNumber, routes[1].TrainNumber})
+}
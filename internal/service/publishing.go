@@ -0,0 +1,96 @@
+// internal/service/publishing.go
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/sink"
+)
+
+// publishingService декоратор сервиса, экспортирующий успешные результаты в sink.Sink
+type publishingService struct {
+	next Service
+	sink sink.Sink
+}
+
+// NewPublishingService оборачивает сервис так, что каждый успешный ответ публикуется как событие.
+// Ошибки экспорта логируются и не влияют на ответ клиенту.
+func NewPublishingService(next Service, s sink.Sink) Service {
+	return &publishingService{next: next, sink: s}
+}
+
+// GetTrainRoutes получение маршрутов поездов с публикацией результата
+func (s *publishingService) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	routes, err := s.next.GetTrainRoutes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sink.Publish(ctx, sink.NewRoutesEvent(params, routes)); err != nil {
+		log.Printf("Failed to publish train routes: %v", err)
+	}
+	return routes, nil
+}
+
+// GetTrainRoutesWithAlternatives маршруты с альтернативами; результаты не экспортируются
+func (s *publishingService) GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (domain.RoutesWithAlternatives, error) {
+	return s.next.GetTrainRoutesWithAlternatives(ctx, params)
+}
+
+// GetTrainCarriages получение информации о вагонах с публикацией результата
+func (s *publishingService) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	cars, err := s.next.GetTrainCarriages(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sink.Publish(ctx, sink.NewCarriagesEvent(params, cars)); err != nil {
+		log.Printf("Failed to publish train carriages: %v", err)
+	}
+	return cars, nil
+}
+
+// GetRoutesWithCarriages маршруты с вагонами; результаты не экспортируются
+func (s *publishingService) GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error) {
+	return s.next.GetRoutesWithCarriages(ctx, params)
+}
+
+// SearchStation поиск станций; результаты не экспортируются
+func (s *publishingService) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
+	return s.next.SearchStation(ctx, params)
+}
+
+// GetFareSummary сводка тарифов; результаты не экспортируются
+func (s *publishingService) GetFareSummary(ctx context.Context, params domain.FareSummaryParams) ([]domain.FareSummary, error) {
+	return s.next.GetFareSummary(ctx, params)
+}
+
+// RecommendSeats подбор мест; результаты не экспортируются
+func (s *publishingService) RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) ([]domain.TrainSeatRecommendations, error) {
+	return s.next.RecommendSeats(ctx, params)
+}
+
+// FindTrainByNumber поиск поезда по номеру; результаты не экспортируются
+func (s *publishingService) FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	return s.next.FindTrainByNumber(ctx, params)
+}
+
+// GetCityStations станции города; результаты не экспортируются
+func (s *publishingService) GetCityStations(ctx context.Context, params domain.CityStationsParams) (domain.City, error) {
+	return s.next.GetCityStations(ctx, params)
+}
+
+// GetNearbyStations станции рядом с точкой; результаты не экспортируются
+func (s *publishingService) GetNearbyStations(ctx context.Context, params domain.NearbyStationsParams) ([]domain.NearbyStation, error) {
+	return s.next.GetNearbyStations(ctx, params)
+}
+
+// LookupStationCodes коды станции в разных системах; результаты не экспортируются
+func (s *publishingService) LookupStationCodes(ctx context.Context, params domain.StationCodeParams) (domain.StationCodes, error) {
+	return s.next.LookupStationCodes(ctx, params)
+}
+
+// GetSchemaDrift диагностика контракта upstream; результаты не экспортируются
+func (s *publishingService) GetSchemaDrift(ctx context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error) {
+	return s.next.GetSchemaDrift(ctx, params)
+}
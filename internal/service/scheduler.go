@@ -0,0 +1,96 @@
+// internal/service/scheduler.go
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/timezone"
+)
+
+// moscow часовой пояс, по которому РЖД считает даты отправления.
+// База поясов встроена пакетом timezone, поэтому загрузка не падает; смещение - на крайний случай.
+var moscow = func() *time.Location {
+	loc, err := time.LoadLocation(timezone.MoscowZone)
+	if err != nil {
+		return time.FixedZone("MSK", 3*60*60)
+	}
+	return loc
+}()
+
+// moscowToday полночь текущих суток по московскому времени
+func moscowToday(now time.Time) time.Time {
+	now = now.In(moscow)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, moscow)
+}
+
+// ScrapeJob периодическое задание сбора маршрутов между двумя станциями
+type ScrapeJob struct {
+	FromCode  int                    // Код станции отправления
+	ToCode    int                    // Код станции прибытия
+	TrainType domain.TrainSearchType // Тип поезда
+	Days      int                    // Количество дней, начиная с сегодняшнего
+	Interval  time.Duration          // Период запуска
+}
+
+// RunScrapeJobs запускает задания и блокируется до отмены контекста.
+// Результаты расходятся через сервис, поэтому при использовании NewPublishingService они экспортируются.
+func RunScrapeJobs(ctx context.Context, svc Service, jobs []ScrapeJob) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			log.Printf("Skipping scrape job %d -> %d: interval is not set", job.FromCode, job.ToCode)
+			continue
+		}
+		wg.Add(1)
+		go func(job ScrapeJob) {
+			defer wg.Done()
+			runScrapeJob(ctx, svc, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+func runScrapeJob(ctx context.Context, svc Service, job ScrapeJob) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		scrapeOnce(ctx, svc, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scrapeOnce опрашивает маршруты на каждый день из окна задания
+func scrapeOnce(ctx context.Context, svc Service, job ScrapeJob) {
+	days := job.Days
+	if days <= 0 {
+		days = 1
+	}
+	trainType := job.TrainType
+	if trainType == 0 {
+		trainType = domain.AllTrains
+	}
+	today := moscowToday(time.Now())
+	for day := 0; day < days; day++ {
+		if ctx.Err() != nil {
+			return
+		}
+		params := domain.GetTrainRoutesParams{
+			FromCode:  job.FromCode,
+			ToCode:    job.ToCode,
+			Direction: domain.OneWay,
+			TrainType: trainType,
+			FromDate:  today.AddDate(0, 0, day),
+		}
+		if _, err := svc.GetTrainRoutes(ctx, params); err != nil {
+			log.Printf("Scrape job %d -> %d for %s failed: %v", job.FromCode, job.ToCode, params.FromDate.Format("02.01.2006"), err)
+		}
+	}
+}
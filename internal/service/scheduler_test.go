@@ -0,0 +1,17 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoscowToday(t *testing.T) {
+	// 01:30 МСК 14 февраля - ещё 13 февраля по UTC
+	now := time.Date(2025, 2, 13, 22, 30, 0, 0, time.UTC)
+	today := moscowToday(now)
+	require.Equal(t, "2025-02-14", today.Format("2006-01-02"))
+	require.Equal(t, moscow, today.Location())
+	require.Equal(t, time.Date(2025, 2, 13, 21, 0, 0, 0, time.UTC), today.UTC())
+}
@@ -0,0 +1,319 @@
+// internal/service/seats.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// defaultSeatRecommendations сколько вариантов размещения возвращается для поезда по умолчанию
+const defaultSeatRecommendations = 5
+
+// RecommendSeats подбирает места для группы в поездах направления и ранжирует варианты:
+// меньше вагонов и купе, меньше боковых и верхних мест, дальше от туалета, дешевле.
+func (s *mainService) RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) ([]domain.TrainSeatRecommendations, error) {
+	if err := requireExpress3(params.CodeType); err != nil {
+		return nil, err
+	}
+	routes, err := s.GetTrainRoutes(ctx, domain.GetTrainRoutesParams{
+		FromCode:   params.FromCode,
+		ToCode:     params.ToCode,
+		Direction:  domain.OneWay,
+		TrainType:  domain.AllTrains,
+		CheckSeats: true,
+		FromDate:   params.Date,
+		Language:   params.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []domain.TrainRoute
+	for _, route := range routes {
+		if params.TrainNumber != "" && !strings.EqualFold(route.TrainNumber, params.TrainNumber) {
+			continue
+		}
+		if !hasSeatType(route.CarTypes, params.SeatTypes) {
+			continue
+		}
+		candidates = append(candidates, route)
+	}
+
+	limit := params.MaxResults
+	if limit <= 0 {
+		limit = defaultSeatRecommendations
+	}
+	carsByRoute := s.carriagesForRoutes(ctx, params.FromCode, params.ToCode, params.Language, candidates)
+	var result []domain.TrainSeatRecommendations
+	for i, route := range candidates {
+		combinations := recommendSeats(carsByRoute[i], params)
+		if len(combinations) == 0 {
+			continue
+		}
+		if len(combinations) > limit {
+			combinations = combinations[:limit]
+		}
+		result = append(result, domain.TrainSeatRecommendations{
+			TrainNumber:  route.TrainNumber,
+			Departure:    route.Departure,
+			Combinations: combinations,
+		})
+	}
+	return result, nil
+}
+
+// hasSeatType проверяет, есть ли в поезде вагоны допустимых типов
+func hasSeatType(carTypes []domain.CarriageType, allowed []domain.CarSeatType) bool {
+	if len(allowed) == 0 {
+		return len(carTypes) > 0
+	}
+	for _, carType := range carTypes {
+		if seatTypeAllowed(carType.Type, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func seatTypeAllowed(seatType domain.CarSeatType, allowed []domain.CarSeatType) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, t := range allowed {
+		if t == seatType {
+			return true
+		}
+	}
+	return false
+}
+
+// recommendSeats возвращает варианты размещения группы в вагонах одного поезда, от лучшего к худшему
+func recommendSeats(cars []domain.Car, params domain.RecommendSeatsParams) []domain.SeatCombination {
+	var combinations []domain.SeatCombination
+	placesByCar := make([][]domain.SeatPlace, 0, len(cars))
+	for _, car := range cars {
+		places := freePlaces(car, params)
+		if len(places) == 0 {
+			continue
+		}
+		placesByCar = append(placesByCar, places)
+		combinations = append(combinations, carCombinations(places, params.PartySize, params.Preferences.SameCompartment)...)
+	}
+	// Если в одном вагоне группа не помещается, а разные вагоны допустимы - распределяем по вагонам
+	if len(combinations) == 0 && !params.Preferences.SameCar && !params.Preferences.SameCompartment {
+		if combination, ok := splitCombination(placesByCar, params.PartySize); ok {
+			combinations = append(combinations, combination)
+		}
+	}
+
+	sort.SliceStable(combinations, func(i, j int) bool {
+		return combinationLess(combinations[i], combinations[j])
+	})
+	return uniqueCombinations(combinations)
+}
+
+// freePlaces возвращает свободные места вагона, удовлетворяющие обязательным пожеланиям.
+// Списки мест разных групп могут пересекаться, каждое место учитывается один раз.
+func freePlaces(car domain.Car, params domain.RecommendSeatsParams) []domain.SeatPlace {
+	carType := car.SeatType
+	if !seatTypeAllowed(carType, params.SeatTypes) {
+		return nil
+	}
+	prefs := params.Preferences
+	seen := make(map[int]struct{})
+	var places []domain.SeatPlace
+	for _, group := range car.Seats {
+		if group.Free <= 0 {
+			continue
+		}
+		parsed, err := domain.ParsePlaces(group.Places)
+		if err != nil {
+			log.Printf("Failed to parse places of car %s: %v", car.CarNumber, err)
+			continue
+		}
+		tariff := group.Tariff
+		if tariff == 0 {
+			tariff = car.Tariff
+		}
+		for _, p := range parsed {
+			if _, ok := seen[p.Number]; ok {
+				continue
+			}
+			seen[p.Number] = struct{}{}
+
+			place := domain.LayoutSeat(carType, p.Number, group.Type)
+			if (prefs.LowerOnly && !place.Lower) || (prefs.AvoidSide && place.Side) || (prefs.AvoidToilet && place.NearToilet) {
+				continue
+			}
+			place.CarNumber = car.CarNumber
+			place.Label = p.Label
+			place.Tariff = tariff
+			places = append(places, place)
+		}
+	}
+	return places
+}
+
+// carCombinations перебирает подряд идущие купе вагона и для каждого начального купе
+// берёт минимальный набор соседних купе, вмещающий группу
+func carCombinations(places []domain.SeatPlace, partySize int, sameCompartment bool) []domain.SeatCombination {
+	byCompartment := make(map[int][]domain.SeatPlace)
+	for _, place := range places {
+		byCompartment[place.Compartment] = append(byCompartment[place.Compartment], place)
+	}
+	compartments := make([]int, 0, len(byCompartment))
+	for compartment := range byCompartment {
+		compartments = append(compartments, compartment)
+	}
+	sort.Ints(compartments)
+
+	var combinations []domain.SeatCombination
+	for i := range compartments {
+		var pool []domain.SeatPlace
+		for j := i; j < len(compartments); j++ {
+			if j > i && (sameCompartment || compartments[j] != compartments[j-1]+1) {
+				break
+			}
+			pool = append(pool, byCompartment[compartments[j]]...)
+			if len(pool) >= partySize {
+				combinations = append(combinations, newCombination(bestPlaces(pool, partySize)))
+				break
+			}
+		}
+	}
+	return combinations
+}
+
+// splitCombination размещает группу в нескольких вагонах, начиная с вагонов с наибольшим числом мест
+func splitCombination(placesByCar [][]domain.SeatPlace, partySize int) (domain.SeatCombination, bool) {
+	ordered := append([][]domain.SeatPlace{}, placesByCar...)
+	sort.SliceStable(ordered, func(i, j int) bool { return len(ordered[i]) > len(ordered[j]) })
+
+	var seats []domain.SeatPlace
+	for _, places := range ordered {
+		need := partySize - len(seats)
+		if need <= 0 {
+			break
+		}
+		if need > len(places) {
+			need = len(places)
+		}
+		seats = append(seats, bestPlaces(places, need)...)
+	}
+	if len(seats) < partySize {
+		return domain.SeatCombination{}, false
+	}
+	return newCombination(seats), true
+}
+
+// bestPlaces выбирает n лучших мест: не боковые, нижние, не у туалета, дешевле
+func bestPlaces(places []domain.SeatPlace, n int) []domain.SeatPlace {
+	sorted := append([]domain.SeatPlace{}, places...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Side != b.Side {
+			return !a.Side
+		}
+		if a.Lower != b.Lower {
+			return a.Lower
+		}
+		if a.NearToilet != b.NearToilet {
+			return !a.NearToilet
+		}
+		if a.Tariff != b.Tariff {
+			return a.Tariff < b.Tariff
+		}
+		return a.Number < b.Number
+	})
+	best := sorted[:n]
+	sort.SliceStable(best, func(i, j int) bool {
+		if best[i].CarNumber != best[j].CarNumber {
+			return best[i].CarNumber < best[j].CarNumber
+		}
+		return best[i].Number < best[j].Number
+	})
+	return best
+}
+
+func newCombination(seats []domain.SeatPlace) domain.SeatCombination {
+	combination := domain.SeatCombination{Seats: seats}
+	compartments := make(map[string]struct{})
+	cars := make(map[string]struct{})
+	for _, seat := range seats {
+		combination.TotalTariff += seat.Tariff
+		compartments[fmt.Sprintf("%s/%d", seat.CarNumber, seat.Compartment)] = struct{}{}
+		cars[seat.CarNumber] = struct{}{}
+	}
+	combination.Compartments = len(compartments)
+	combination.Cars = len(cars)
+	return combination
+}
+
+// combinationLess порядок вариантов: меньше вагонов и купе, меньше боковых, верхних и мест
+// у туалета, дешевле; при равенстве - по номеру вагона и мест
+func combinationLess(a, b domain.SeatCombination) bool {
+	if a.Cars != b.Cars {
+		return a.Cars < b.Cars
+	}
+	if a.Compartments != b.Compartments {
+		return a.Compartments < b.Compartments
+	}
+	aSide, aUpper, aToilet := seatCounts(a.Seats)
+	bSide, bUpper, bToilet := seatCounts(b.Seats)
+	if aSide != bSide {
+		return aSide < bSide
+	}
+	if aUpper != bUpper {
+		return aUpper < bUpper
+	}
+	if aToilet != bToilet {
+		return aToilet < bToilet
+	}
+	if a.TotalTariff != b.TotalTariff {
+		return a.TotalTariff < b.TotalTariff
+	}
+	return combinationKey(a) < combinationKey(b)
+}
+
+func seatCounts(seats []domain.SeatPlace) (side, upper, nearToilet int) {
+	for _, seat := range seats {
+		if seat.Side {
+			side++
+		}
+		if !seat.Lower {
+			upper++
+		}
+		if seat.NearToilet {
+			nearToilet++
+		}
+	}
+	return side, upper, nearToilet
+}
+
+// uniqueCombinations удаляет повторы из отсортированного списка
+func uniqueCombinations(combinations []domain.SeatCombination) []domain.SeatCombination {
+	seen := make(map[string]struct{}, len(combinations))
+	unique := combinations[:0]
+	for _, combination := range combinations {
+		key := combinationKey(combination)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, combination)
+	}
+	return unique
+}
+
+func combinationKey(combination domain.SeatCombination) string {
+	parts := make([]string, 0, len(combination.Seats))
+	for _, seat := range combination.Seats {
+		parts = append(parts, fmt.Sprintf("%s:%03d", seat.CarNumber, seat.Number))
+	}
+	return strings.Join(parts, ",")
+}
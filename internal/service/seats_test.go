@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd/schemas"
+)
+
+func TestRecommendSeats(t *testing.T) {
+	departure := time.Date(2025, 2, 13, 0, 12, 0, 0, time.UTC)
+	provider := &carsProvider{
+		stubProvider: &stubProvider{routes: []domain.TrainRoute{
+			{TrainNumber: "119А", Departure: departure, CarTypes: []domain.CarriageType{{Type: domain.Coupe, Tariff: 2533}}},
+			{TrainNumber: "021А", Departure: departure, CarTypes: []domain.CarriageType{{Type: domain.Platz, Tariff: 1800}}},
+		}},
+		cars: map[string][]domain.Car{
+			"119А": legacyCars(t,
+				// Купе 1 (у туалета) свободно целиком, в купе 2 и 3 - по два места
+				schemas.Car{Cnumber: "05", Type: "Купе", Ctype: 4, Ctypei: 4, Seats: []schemas.Seat{
+					{Type: domain.SeatLower, Tariff: 3463, Free: 4, Places: "001,003,005,009"},
+					{Type: domain.SeatUpper, Tariff: 2533, Free: 4, Places: "002,004,006,010"},
+				}},
+				schemas.Car{Cnumber: "06", Type: "Купе", Ctype: 4, Ctypei: 4, Seats: []schemas.Seat{
+					{Type: domain.SeatLower, Tariff: 3000, Free: 2, Places: "021,023"},
+				}},
+			),
+			"021А": legacyCars(t,
+				schemas.Car{Cnumber: "10", Type: "Плац", Ctype: 3, Ctypei: 1, Seats: []schemas.Seat{
+					{Type: domain.SeatLower, Tariff: 1800, Free: 2, Places: "037,039"},
+				}},
+			),
+		},
+	}
+	svc := New(provider)
+	params := domain.RecommendSeatsParams{FromCode: 2004000, ToCode: 2000000, Date: departure, PartySize: 4}
+
+	// Четыре места в одном купе: подходит только первое купе вагона 05
+	params.Preferences = domain.SeatPreferences{SameCompartment: true}
+	trains, err := svc.RecommendSeats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, trains, 1)
+	require.Equal(t, "119А", trains[0].TrainNumber)
+	require.Len(t, trains[0].Combinations, 1)
+	best := trains[0].Combinations[0]
+	require.Equal(t, 1, best.Compartments)
+	require.Equal(t, 2*3463+2*2533, best.TotalTariff)
+	require.Equal(t, []int{1, 2, 3, 4}, seatNumbers(best.Seats))
+
+	// Не у туалета: группа занимает соседние купе 2 и 3
+	params.Preferences = domain.SeatPreferences{AvoidToilet: true, SameCar: true}
+	trains, err = svc.RecommendSeats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, trains, 1)
+	require.Equal(t, []int{5, 6, 9, 10}, seatNumbers(trains[0].Combinations[0].Seats))
+	require.Equal(t, 2, trains[0].Combinations[0].Compartments)
+
+	// Два нижних места без боковых: плацкарт не подходит, лучший вариант - одно купе не у туалета
+	params.PartySize = 2
+	params.Preferences = domain.SeatPreferences{LowerOnly: true, AvoidSide: true}
+	trains, err = svc.RecommendSeats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, trains, 1)
+	combinations := trains[0].Combinations
+	require.Equal(t, "06", combinations[0].Seats[0].CarNumber)
+	require.Equal(t, []int{21, 23}, seatNumbers(combinations[0].Seats))
+	require.Equal(t, []int{1, 3}, seatNumbers(combinations[1].Seats))
+	for _, combination := range combinations {
+		for _, seat := range combination.Seats {
+			require.True(t, seat.Lower)
+		}
+	}
+
+	// Только плацкарт: вагон 10 с ctype 3 должен определяться как плацкартный
+	params.SeatTypes = []domain.CarSeatType{domain.Platz}
+	params.Preferences = domain.SeatPreferences{}
+	trains, err = svc.RecommendSeats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, trains, 1)
+	require.Equal(t, "021А", trains[0].TrainNumber)
+	require.Equal(t, []int{37, 39}, seatNumbers(trains[0].Combinations[0].Seats))
+	params.SeatTypes = nil
+
+	// Группа из шести мест не помещается в один вагон и распределяется по двум
+	params.TrainNumber = "119а"
+	params.PartySize = 6
+	params.Preferences = domain.SeatPreferences{LowerOnly: true}
+	trains, err = svc.RecommendSeats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, trains, 1)
+	require.Equal(t, 2, trains[0].Combinations[0].Cars)
+}
+
+func seatNumbers(seats []domain.SeatPlace) []int {
+	numbers := make([]int, 0, len(seats))
+	for _, seat := range seats {
+		numbers = append(numbers, seat.Number)
+	}
+	return numbers
+}
@@ -5,30 +5,77 @@ import (
 	"context"
 
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
-	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/rzd"
 )
 
 // mainService реализует интерфейс Service
 type mainService struct {
-	rzdClient *rzd.Client
+	provider Provider
+	cities   *cityIndex
 }
 
-// New возвращает новый экземпляр сервиса
-func New(rzdClient *rzd.Client) Service {
-	return &mainService{rzdClient: rzdClient}
+// New возвращает новый экземпляр сервиса поверх провайдера данных
+func New(provider Provider) Service {
+	return &mainService{provider: provider, cities: newCityIndex()}
 }
 
-// GetTrainRoutes получение маршрутов поездов
+// GetTrainRoutes получение маршрутов поездов.
+// Названия станций, переданные вместо кодов, определяются поиском станций. Если запрошен город
+// (известный по поиску станций), у маршрутов отмечается, от какой его станции (или до какой) идёт поезд.
 func (s *mainService) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
-	return s.rzdClient.GetTrainRoutes(ctx, params)
+	if err := requireExpress3(params.CodeType); err != nil {
+		return nil, err
+	}
+	params, err := s.resolveStations(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := s.provider.GetTrainRoutes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	s.annotateCities(routes, params)
+	return filterByTrainType(routes, params.TrainTypes), nil
 }
 
-// GetTrainCarriages получение информации о вагонах
+// GetTrainCarriages получение информации о вагонах.
+// Если вместо кода станции передано название, сегмент ищется среди остановок поезда.
 func (s *mainService) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
-	return s.rzdClient.GetTrainCarriages(ctx, params)
+	if err := requireExpress3(params.CodeType); err != nil {
+		return nil, err
+	}
+	params, err := s.resolveSegment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.provider.GetTrainCarriages(ctx, params)
 }
 
-// SearchStation получение кодов станций по поисковому запросу
+// SearchStation получение кодов станций по поисковому запросу.
+// Найденные города запоминаются, чтобы отмечать их в маршрутах.
 func (s *mainService) SearchStation(ctx context.Context, params domain.SearchStationParams) ([]domain.Station, error) {
-	return s.rzdClient.SearchStation(ctx, params)
+	stations, err := s.provider.SearchStation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	s.cities.remember(stations)
+	return stations, nil
+}
+
+// filterByTrainType оставляет только маршруты поездов указанных категорий.
+// Пустой список категорий означает отсутствие фильтра.
+func filterByTrainType(routes []domain.TrainRoute, types []domain.TrainType) []domain.TrainRoute {
+	if len(types) == 0 {
+		return routes
+	}
+	allowed := make(map[domain.TrainType]struct{}, len(types))
+	for _, t := range types {
+		allowed[t] = struct{}{}
+	}
+	filtered := make([]domain.TrainRoute, 0, len(routes))
+	for _, r := range routes {
+		if _, ok := allowed[r.TrainType]; ok {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
 }
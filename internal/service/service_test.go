@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+func TestFilterByTrainType(t *testing.T) {
+	routes := []domain.TrainRoute{
+		{TrainNumber: "119А", TrainType: domain.Train},
+		{TrainNumber: "752А", TrainType: domain.HighSpeed},
+		{TrainNumber: "6001", TrainType: domain.Suburban},
+		{TrainNumber: "7001", TrainType: domain.ExpressSuburban},
+		{TrainNumber: "Б01", TrainType: domain.Bus},
+		{TrainNumber: "П01", TrainType: domain.Ferry},
+	}
+	numbers := func(routes []domain.TrainRoute) []string {
+		var result []string
+		for _, r := range routes {
+			result = append(result, r.TrainNumber)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		types []domain.TrainType
+		want  []string
+	}{
+		{"no filter", nil, []string{"119А", "752А", "6001", "7001", "Б01", "П01"}},
+		{"long distance", []domain.TrainType{domain.Train}, []string{"119А"}},
+		{"high speed", []domain.TrainType{domain.HighSpeed}, []string{"752А"}},
+		{"suburban", []domain.TrainType{domain.Suburban, domain.ExpressSuburban}, []string{"6001", "7001"}},
+		{"multimodal", []domain.TrainType{domain.Bus, domain.Ferry}, []string{"Б01", "П01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, numbers(filterByTrainType(routes, tt.types)))
+		})
+	}
+	require.Empty(t, filterByTrainType(routes[:1], []domain.TrainType{domain.Ferry}))
+}
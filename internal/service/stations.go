@@ -0,0 +1,124 @@
+// internal/service/stations.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// maxStationCandidates сколько станций перечисляется в ошибке неоднозначного названия
+const maxStationCandidates = 10
+
+// resolveStations заменяет названия станций маршрута кодами
+func (s *mainService) resolveStations(ctx context.Context, params domain.GetTrainRoutesParams) (domain.GetTrainRoutesParams, error) {
+	var err error
+	if params.FromCode == 0 && params.FromStation != "" {
+		if params.FromCode, err = s.resolveStation(ctx, "from", params.FromStation, params.Language); err != nil {
+			return params, err
+		}
+	}
+	if params.ToCode == 0 && params.ToStation != "" {
+		if params.ToCode, err = s.resolveStation(ctx, "to", params.ToStation, params.Language); err != nil {
+			return params, err
+		}
+	}
+	return params, nil
+}
+
+// resolveStation возвращает код станции по названию (или по коду, переданному строкой).
+// Станция выбирается matchStation.
+func (s *mainService) resolveStation(ctx context.Context, field, name, language string) (int, error) {
+	if code, ok := stationCode(name); ok {
+		return code, nil
+	}
+	stations, err := s.provider.SearchStation(ctx, domain.SearchStationParams{
+		Query:    normalizeStationName(name),
+		Language: language,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve %s station %q: %w", field, name, err)
+	}
+	s.cities.remember(stations)
+
+	station, err := matchStation(stations, field, name)
+	if errors.Is(err, domain.ErrStationNotFound) {
+		return 0, fmt.Errorf("%s station %q: %w", field, name, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return station.Code, nil
+}
+
+// matchStation выбирает станцию по названию среди найденных поиском.
+// Сначала отбираются совпадающие с названием точно, затем начинающиеся с него; из них - станция
+// с наибольшим уровнем, при равенстве - с наибольшим значением сортировки.
+// Если лучших станций несколько или ни одна не совпадает с названием, возвращается
+// AmbiguousStationError со списком кандидатов; если поиск ничего не нашёл - ErrStationNotFound.
+func matchStation(stations []domain.Station, field, name string) (domain.Station, error) {
+	if len(stations) == 0 {
+		return domain.Station{}, domain.ErrStationNotFound
+	}
+	candidates := bestStationMatches(stations, name)
+	if len(candidates) == 1 || (len(candidates) > 1 && rankedHigher(candidates[0], candidates[1])) {
+		return candidates[0], nil
+	}
+	if len(candidates) == 0 {
+		// Название не совпадает ни с одной станцией: найденные поиском предлагаются на выбор
+		candidates = rankStations(stations)
+	}
+	if len(candidates) > maxStationCandidates {
+		candidates = candidates[:maxStationCandidates]
+	}
+	return domain.Station{}, &domain.AmbiguousStationError{Field: field, Query: name, Candidates: candidates}
+}
+
+// stationCode возвращает код станции, если вместо названия передан код
+func stationCode(name string) (int, bool) {
+	code, err := strconv.Atoi(strings.TrimSpace(name))
+	return code, err == nil && code > 0
+}
+
+// bestStationMatches отбирает станции с самым точным совпадением названия: точным,
+// а если таких нет - по началу названия. Результат отсортирован rankStations.
+func bestStationMatches(stations []domain.Station, name string) []domain.Station {
+	query := normalizeStationName(name)
+	var exact, prefixed []domain.Station
+	for _, station := range stations {
+		stationName := normalizeStationName(station.Name)
+		switch {
+		case stationName == query:
+			exact = append(exact, station)
+		case strings.HasPrefix(stationName, query):
+			prefixed = append(prefixed, station)
+		}
+	}
+	if len(exact) > 0 {
+		return rankStations(exact)
+	}
+	return rankStations(prefixed)
+}
+
+// rankStations возвращает копию станций, отсортированную по уровню и значению сортировки
+func rankStations(stations []domain.Station) []domain.Station {
+	if len(stations) == 0 {
+		return nil
+	}
+	ranked := append([]domain.Station(nil), stations...)
+	sort.SliceStable(ranked, func(i, j int) bool { return rankedHigher(ranked[i], ranked[j]) })
+	return ranked
+}
+
+// rankedHigher сравнивает станции по уровню, затем по значению сортировки
+func rankedHigher(a, b domain.Station) bool {
+	if a.Level != b.Level {
+		return a.Level > b.Level
+	}
+	return a.Score > b.Score
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// routesProvider провайдер, запоминающий параметры запроса маршрутов
+type routesProvider struct {
+	stubProvider
+	routesParams domain.GetTrainRoutesParams
+}
+
+func (p *routesProvider) GetTrainRoutes(_ context.Context, params domain.GetTrainRoutesParams) ([]domain.TrainRoute, error) {
+	p.routesParams = params
+	return nil, nil
+}
+
+func TestGetTrainRoutesByStationNames(t *testing.T) {
+	provider := &routesProvider{stubProvider: stubProvider{name: "stations", stations: []domain.Station{
+		{Name: "МОСКВА (ВСЕ ВОКЗАЛЫ)", Code: 2000000, Level: 5, Score: 5},
+		{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004, Level: 3, Score: 5},
+		{Name: "МОСКВА", Code: 2000003, Level: 1, Score: 1},
+		{Name: "МОСКВА", Code: 2000001, Level: 1, Score: 1},
+		{Name: "МОСКВА", Code: 2000002, Level: 1, Score: 0},
+	}}}
+	svc := New(provider)
+	params := domain.GetTrainRoutesParams{
+		FromStation: "москва (все вокзалы)",
+		ToStation:   "2004000",
+		FromDate:    time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Точное совпадение важнее уровня станции, код принимается строкой
+	_, err := svc.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 2000000, provider.routesParams.FromCode)
+	require.Equal(t, 2004000, provider.routesParams.ToCode)
+
+	// Среди начинающихся с запроса выбирается станция с наибольшим уровнем
+	params.FromStation = "Москва Окт"
+	_, err = svc.GetTrainRoutes(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 2006004, provider.routesParams.FromCode)
+
+	// Лучшие станции равны по уровню и значению сортировки: кандидаты перечисляются от лучшей к худшей
+	params.FromStation = "МОСКВА"
+	_, err = svc.GetTrainRoutes(context.Background(), params)
+	var ambiguous *domain.AmbiguousStationError
+	require.True(t, errors.As(err, &ambiguous))
+	require.Equal(t, "from", ambiguous.Field)
+	require.Len(t, ambiguous.Candidates, 3)
+	require.Equal(t, 2000003, ambiguous.Candidates[0].Code)
+	require.Equal(t, 2000002, ambiguous.Candidates[2].Code)
+
+	// Ни одна найденная станция не совпадает с названием: станция не выбирается по уровню,
+	// а найденные предлагаются на выбор
+	provider.stations = []domain.Station{
+		{Name: "САНКТ-ПЕТЕРБУРГ-ГЛАВН.", Code: 2004001, Level: 3, Score: 5},
+		{Name: "САНКТ-ПЕТЕРБУРГ", Code: 2004000, Level: 5, Score: 5},
+	}
+	params.FromStation = "Петербург"
+	_, err = svc.GetTrainRoutes(context.Background(), params)
+	require.True(t, errors.As(err, &ambiguous))
+	require.Equal(t, []int{2004000, 2004001}, []int{ambiguous.Candidates[0].Code, ambiguous.Candidates[1].Code})
+
+	provider.stations = nil
+	_, err = svc.GetTrainRoutes(context.Background(), params)
+	require.ErrorIs(t, err, domain.ErrStationNotFound)
+}
@@ -0,0 +1,159 @@
+// internal/service/tracing.go
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+)
+
+var tracer = tracing.Tracer("service")
+
+// Атрибуты спанов сервиса
+const (
+	attrFromCode     = attribute.Key("rzd.from_code")
+	attrToCode       = attribute.Key("rzd.to_code")
+	attrTrainNumber  = attribute.Key("rzd.train_number")
+	attrCodeType     = attribute.Key("rzd.code_type")
+	attrAlternatives = attribute.Key("rzd.alternatives") // Количество предложенных альтернатив
+	attrFailures     = attribute.Key("rzd.failures")     // Количество поездов, вагоны которых получить не удалось
+	attrResults      = attribute.Key("rzd.results")      // Количество элементов в ответе
+)
+
+// tracingService декоратор сервиса, записывающий каждый вызов в спан "service.<метод>"
+type tracingService struct {
+	next Service
+}
+
+// NewTracingService оборачивает сервис трассировкой OpenTelemetry
+func NewTracingService(next Service) Service {
+	return &tracingService{next: next}
+}
+
+// start открывает спан метода сервиса с атрибутами запроса
+func (s *tracingService) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "service."+method, trace.WithAttributes(attrs...))
+}
+
+// GetTrainRoutes получение маршрутов поездов
+func (s *tracingService) GetTrainRoutes(ctx context.Context, params domain.GetTrainRoutesParams) (routes []domain.TrainRoute, err error) {
+	ctx, span := s.start(ctx, "GetTrainRoutes", attrFromCode.Int(params.FromCode), attrToCode.Int(params.ToCode))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(routes)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetTrainRoutes(ctx, params)
+}
+
+// GetTrainRoutesWithAlternatives получение маршрутов поездов с альтернативами
+func (s *tracingService) GetTrainRoutesWithAlternatives(ctx context.Context, params domain.AlternativesParams) (result domain.RoutesWithAlternatives, err error) {
+	ctx, span := s.start(ctx, "GetTrainRoutesWithAlternatives", attrFromCode.Int(params.Routes.FromCode), attrToCode.Int(params.Routes.ToCode))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(result.Routes)), attrAlternatives.Int(len(result.Alternatives)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetTrainRoutesWithAlternatives(ctx, params)
+}
+
+// GetTrainCarriages получение информации о вагонах
+func (s *tracingService) GetTrainCarriages(ctx context.Context, params domain.GetTrainCarriagesParams) (cars []domain.Car, err error) {
+	ctx, span := s.start(ctx, "GetTrainCarriages",
+		attrFromCode.Int(params.FromCode), attrToCode.Int(params.ToCode), attrTrainNumber.String(params.TrainNumber))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(cars)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetTrainCarriages(ctx, params)
+}
+
+// GetRoutesWithCarriages получение маршрутов поездов с вагонами
+func (s *tracingService) GetRoutesWithCarriages(ctx context.Context, params domain.RoutesWithCarriagesParams) (result domain.RoutesWithCarriages, err error) {
+	ctx, span := s.start(ctx, "GetRoutesWithCarriages", attrFromCode.Int(params.Routes.FromCode), attrToCode.Int(params.Routes.ToCode))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(result.Routes)), attrFailures.Int(len(result.Failures)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetRoutesWithCarriages(ctx, params)
+}
+
+// SearchStation поиск станций
+func (s *tracingService) SearchStation(ctx context.Context, params domain.SearchStationParams) (stations []domain.Station, err error) {
+	ctx, span := s.start(ctx, "SearchStation", attribute.String("rzd.query", params.Query))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(stations)))
+		tracing.End(span, err)
+	}()
+	return s.next.SearchStation(ctx, params)
+}
+
+// GetFareSummary сводка тарифов
+func (s *tracingService) GetFareSummary(ctx context.Context, params domain.FareSummaryParams) (summaries []domain.FareSummary, err error) {
+	ctx, span := s.start(ctx, "GetFareSummary", attrFromCode.Int(params.FromCode), attrToCode.Int(params.ToCode))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(summaries)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetFareSummary(ctx, params)
+}
+
+// RecommendSeats подбор мест
+func (s *tracingService) RecommendSeats(ctx context.Context, params domain.RecommendSeatsParams) (trains []domain.TrainSeatRecommendations, err error) {
+	ctx, span := s.start(ctx, "RecommendSeats",
+		attrFromCode.Int(params.FromCode), attrToCode.Int(params.ToCode), attrTrainNumber.String(params.TrainNumber))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(trains)))
+		tracing.End(span, err)
+	}()
+	return s.next.RecommendSeats(ctx, params)
+}
+
+// FindTrainByNumber поиск поезда по номеру
+func (s *tracingService) FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (schedule domain.TrainSchedule, err error) {
+	ctx, span := s.start(ctx, "FindTrainByNumber", attrTrainNumber.String(params.TrainNumber))
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(schedule.Stops)))
+		tracing.End(span, err)
+	}()
+	return s.next.FindTrainByNumber(ctx, params)
+}
+
+// GetCityStations станции города
+func (s *tracingService) GetCityStations(ctx context.Context, params domain.CityStationsParams) (city domain.City, err error) {
+	ctx, span := s.start(ctx, "GetCityStations")
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(city.Stations)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetCityStations(ctx, params)
+}
+
+// GetNearbyStations станции рядом с точкой
+func (s *tracingService) GetNearbyStations(ctx context.Context, params domain.NearbyStationsParams) (stations []domain.NearbyStation, err error) {
+	ctx, span := s.start(ctx, "GetNearbyStations")
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(stations)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetNearbyStations(ctx, params)
+}
+
+// LookupStationCodes коды станции в разных системах
+func (s *tracingService) LookupStationCodes(ctx context.Context, params domain.StationCodeParams) (codes domain.StationCodes, err error) {
+	ctx, span := s.start(ctx, "LookupStationCodes", attrCodeType.String(params.Type.String()))
+	defer func() { tracing.End(span, err) }()
+	return s.next.LookupStationCodes(ctx, params)
+}
+
+// GetSchemaDrift диагностика контракта upstream
+func (s *tracingService) GetSchemaDrift(ctx context.Context, params domain.SchemaDriftParams) (events []domain.SchemaDriftEvent, err error) {
+	ctx, span := s.start(ctx, "GetSchemaDrift")
+	defer func() {
+		span.SetAttributes(attrResults.Int(len(events)))
+		tracing.End(span, err)
+	}()
+	return s.next.GetSchemaDrift(ctx, params)
+}
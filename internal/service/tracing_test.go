@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+func TestTracingService(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	stub := &stubProvider{routes: []domain.TrainRoute{{TrainNumber: "119А"}, {TrainNumber: "021А"}}}
+	svc := NewTracingService(New(stub))
+
+	routes, err := svc.GetTrainRoutes(context.Background(), domain.GetTrainRoutesParams{FromCode: 2004000, ToCode: 2000000})
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	stub.err = errors.New("upstream is down")
+	_, err = svc.SearchStation(context.Background(), domain.SearchStationParams{Query: "МОС"})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	routesSpan, stationsSpan := spans[0], spans[1]
+
+	require.Equal(t, "service.GetTrainRoutes", routesSpan.Name())
+	require.Contains(t, routesSpan.Attributes(), attrFromCode.Int(2004000))
+	require.Contains(t, routesSpan.Attributes(), attrToCode.Int(2000000))
+	require.Contains(t, routesSpan.Attributes(), attrResults.Int(2))
+	require.Equal(t, codes.Unset, routesSpan.Status().Code)
+
+	// Ошибка вызова отмечается в спане статусом и событием
+	require.Equal(t, "service.SearchStation", stationsSpan.Name())
+	require.Contains(t, stationsSpan.Attributes(), attrResults.Int(0))
+	require.Equal(t, codes.Error, stationsSpan.Status().Code)
+	require.Len(t, stationsSpan.Events(), 1)
+}
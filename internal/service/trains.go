@@ -0,0 +1,150 @@
+// internal/service/trains.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// TrainFinder провайдер, который умеет искать поезд по номеру и дате
+type TrainFinder interface {
+	FindTrain(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error)
+}
+
+// FindTrainByNumber поиск поезда по номеру: маршрут от начальной до конечной станции и остановки
+func (s *mainService) FindTrainByNumber(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	finder, ok := s.provider.(TrainFinder)
+	if !ok {
+		return domain.TrainSchedule{}, fmt.Errorf("%s: train search: %w", s.provider.Name(), domain.ErrNotSupported)
+	}
+	return finder.FindTrain(ctx, params)
+}
+
+// FindTrain ищет поезд у провайдеров цепочки, которые это умеют, до первого успешного ответа
+func (p *fallbackProvider) FindTrain(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	return findTrain(ctx, p.providers, params)
+}
+
+// FindTrain ищет поезд у объединяемых провайдеров в порядке приоритета
+func (p *mergingProvider) FindTrain(ctx context.Context, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	return findTrain(ctx, p.providers, params)
+}
+
+// findTrain опрашивает по очереди провайдеров, реализующих TrainFinder
+func findTrain(ctx context.Context, providers []Provider, params domain.FindTrainParams) (domain.TrainSchedule, error) {
+	var finders []Provider
+	for _, provider := range providers {
+		if _, ok := provider.(TrainFinder); ok {
+			finders = append(finders, provider)
+		}
+	}
+	if len(finders) == 0 {
+		return domain.TrainSchedule{}, fmt.Errorf("%s: train search: %w", providerNames(providers), domain.ErrNotSupported)
+	}
+	return firstSuccessful(ctx, finders, func(provider Provider) (domain.TrainSchedule, error) {
+		return provider.(TrainFinder).FindTrain(ctx, params)
+	})
+}
+
+// resolveSegment заполняет коды станций и время отправления по остановкам поезда,
+// если вместо кода передано название станции
+func (s *mainService) resolveSegment(ctx context.Context, params domain.GetTrainCarriagesParams) (domain.GetTrainCarriagesParams, error) {
+	if params.FromCode != 0 && params.ToCode != 0 {
+		return params, nil
+	}
+	schedule, err := s.FindTrainByNumber(ctx, domain.FindTrainParams{
+		TrainNumber: params.TrainNumber,
+		Date:        params.FromTime,
+		Language:    params.Language,
+	})
+	if errors.Is(err, domain.ErrNotSupported) {
+		// Остановки поезда узнать не у кого: станции определяются поиском по названию
+		return s.resolveSegmentStations(ctx, params)
+	}
+	if err != nil {
+		return params, err
+	}
+
+	from, err := findStop(schedule.Stops, params.FromCode, params.FromStation)
+	if err != nil {
+		return params, err
+	}
+	to, err := findStop(schedule.Stops, params.ToCode, params.ToStation)
+	if err != nil {
+		return params, err
+	}
+	if from >= to {
+		return params, fmt.Errorf("%q must come before %q on train %s: %w",
+			schedule.Stops[from].Station.Name, schedule.Stops[to].Station.Name, params.TrainNumber, domain.ErrStationNotOnRoute)
+	}
+
+	params.FromCode = schedule.Stops[from].Station.Code
+	params.FromTime = schedule.Stops[from].Departure
+	params.ToCode = schedule.Stops[to].Station.Code
+	return params, nil
+}
+
+// resolveSegmentStations заменяет названия станций сегмента кодами через поиск станций
+func (s *mainService) resolveSegmentStations(ctx context.Context, params domain.GetTrainCarriagesParams) (domain.GetTrainCarriagesParams, error) {
+	var err error
+	if params.FromCode == 0 {
+		if params.FromCode, err = s.resolveStation(ctx, "from", params.FromStation, params.Language); err != nil {
+			return params, err
+		}
+	}
+	if params.ToCode == 0 {
+		if params.ToCode, err = s.resolveStation(ctx, "to", params.ToStation, params.Language); err != nil {
+			return params, err
+		}
+	}
+	return params, nil
+}
+
+// findStop возвращает индекс остановки по коду станции, а без кода - по названию:
+// сначала точное совпадение, затем единственная остановка, название которой начинается с запроса.
+// Код станции можно передать и строкой вместо названия.
+func findStop(stops []domain.TrainStop, code int, name string) (int, error) {
+	if nameCode, ok := stationCode(name); ok && code == 0 {
+		code = nameCode
+	}
+	if code != 0 {
+		for i, stop := range stops {
+			if stop.Station.Code == code {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("station %d: %w", code, domain.ErrStationNotOnRoute)
+	}
+
+	query := normalizeStationName(name)
+	prefixed := -1
+	for i, stop := range stops {
+		stopName := normalizeStationName(stop.Station.Name)
+		if stopName == query {
+			return i, nil
+		}
+		if strings.HasPrefix(stopName, query) {
+			if prefixed >= 0 {
+				return 0, fmt.Errorf("station %q is ambiguous: %q or %q: %w",
+					name, stops[prefixed].Station.Name, stop.Station.Name, domain.ErrStationNotOnRoute)
+			}
+			prefixed = i
+		}
+	}
+	if prefixed < 0 || query == "" {
+		return 0, fmt.Errorf("station %q: %w", name, domain.ErrStationNotOnRoute)
+	}
+	return prefixed, nil
+}
+
+// normalizeStationName приводит название станции к виду для сравнения:
+// верхний регистр, Ё как Е, одиночные пробелы
+func normalizeStationName(name string) string {
+	name = strings.ToUpper(strings.ReplaceAll(name, "ё", "е"))
+	name = strings.ReplaceAll(name, "Ё", "Е")
+	return strings.Join(strings.Fields(name), " ")
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// finderProvider провайдер с расписанием поезда, запоминающий параметры запроса вагонов
+type finderProvider struct {
+	stubProvider
+	schedule  domain.TrainSchedule
+	carriages domain.GetTrainCarriagesParams
+}
+
+func (p *finderProvider) FindTrain(context.Context, domain.FindTrainParams) (domain.TrainSchedule, error) {
+	return p.schedule, nil
+}
+
+func (p *finderProvider) GetTrainCarriages(_ context.Context, params domain.GetTrainCarriagesParams) ([]domain.Car, error) {
+	p.carriages = params
+	return nil, nil
+}
+
+func TestGetTrainCarriagesByStationNames(t *testing.T) {
+	origin := time.Date(2025, 2, 13, 21, 50, 0, 0, time.UTC)
+	provider := &finderProvider{
+		stubProvider: stubProvider{name: "finder"},
+		schedule: domain.TrainSchedule{Stops: []domain.TrainStop{
+			{Station: domain.Station{Code: 2000002, Name: "МОСКВА ЯРОСЛАВСКАЯ"}, Departure: origin},
+			{Station: domain.Station{Code: 2060600, Name: "КИРОВ"}, Departure: origin.Add(11 * time.Hour)},
+			{Station: domain.Station{Code: 2060601, Name: "КИРОВО-ЧЕПЕЦКАЯ"}, Departure: origin.Add(12 * time.Hour)},
+			{Station: domain.Station{Code: 2030000, Name: "ЕКАТЕРИНБУРГ-ПАСС."}},
+		}},
+	}
+	svc := New(NewFallbackProvider(provider))
+	params := domain.GetTrainCarriagesParams{
+		TrainNumber: "010Э",
+		FromTime:    time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC),
+		FromStation: "киров",
+		ToStation:   "  екатеринбург ",
+	}
+
+	// Точное совпадение названия важнее префикса, префикс - если он единственный
+	_, err := svc.GetTrainCarriages(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 2060600, provider.carriages.FromCode)
+	require.Equal(t, origin.Add(11*time.Hour), provider.carriages.FromTime)
+	require.Equal(t, 2030000, provider.carriages.ToCode)
+
+	params.FromStation, params.FromCode = "", 2000002
+	_, err = svc.GetTrainCarriages(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, origin, provider.carriages.FromTime)
+
+	params.FromStation, params.FromCode = "КИР", 0
+	_, err = svc.GetTrainCarriages(context.Background(), params)
+	require.ErrorIs(t, err, domain.ErrStationNotOnRoute)
+	require.ErrorContains(t, err, "ambiguous")
+
+	params.FromStation, params.ToStation = "Екатеринбург", "Киров"
+	_, err = svc.GetTrainCarriages(context.Background(), params)
+	require.ErrorIs(t, err, domain.ErrStationNotOnRoute)
+
+	// Без поиска по номеру у провайдера названия определяются поиском станций
+	plain := &stubProvider{name: "plain"}
+	_, err = New(plain).GetTrainCarriages(context.Background(), params)
+	require.ErrorIs(t, err, domain.ErrStationNotFound)
+	require.Equal(t, 1, plain.calls)
+}
@@ -61,17 +61,23 @@ func newTestGRPCClient(t *testing.T) pb.RzdServiceClient {
 			UserAgent:   "Mozilla/5.0 (compatible; RzdClient/1.0)",
 			Language:    "ru",
 			Proxy:       "",
-			Timeout:     1700,
-			RIDLifetime: 300000,
+			Timeout:     30 * time.Second,
+			RetryDelay:  1700 * time.Millisecond,
+			RIDLifetime: 5 * time.Minute,
 			MaxRetries:  5,
 			DebugMode:   false,
+			Breaker: config.RZDBreaker{
+				FailureThreshold: 5,
+				OpenTimeout:      30 * time.Second,
+				StaleTTL:         time.Hour,
+			},
 		},
 		GRPC: config.GRPC{
 			Port: testGRPCPort,
 		},
 	}
 	// Создаем реальный клиент RZD (используется в сервисном слое)
-	rzdClient, err := rzd.NewRzdClient(&cfg.RZD)
+	rzdClient, err := rzd.NewRzdClient(&cfg.RZD, nil)
 	require.NoError(t, err)
 
 	// Создаем сервисный слой
@@ -102,8 +108,8 @@ func TestGetTrainRoutes(t *testing.T) {
 	req := &pb.GetTrainRoutesRequest{
 		FromCode:   2004000,
 		ToCode:     2000000,
-		Direction:  0, // OneWay
-		TrainType:  1, // AllTrains
+		Direction:  pb.Direction_DIRECTION_ONE_WAY,
+		TrainType:  pb.TrainSearchType_TRAIN_SEARCH_TYPE_ALL,
 		CheckSeats: false,
 		FromDate:   timestamppb.New(time.Now().Add(48 * time.Hour)),
 		WithChange: false,
@@ -127,8 +133,8 @@ func TestGetTrainCarriages(t *testing.T) {
 	reqRoute := &pb.GetTrainRoutesRequest{
 		FromCode:   2004000,
 		ToCode:     2000000,
-		Direction:  0, // OneWay
-		TrainType:  1, // AllTrains
+		Direction:  pb.Direction_DIRECTION_ONE_WAY,
+		TrainType:  pb.TrainSearchType_TRAIN_SEARCH_TYPE_ALL,
 		CheckSeats: false,
 		FromDate:   timestamppb.New(time.Now().Add(48 * time.Hour)),
 		WithChange: false,
@@ -144,7 +150,7 @@ func TestGetTrainCarriages(t *testing.T) {
 
 	req := &pb.GetTrainCarriagesRequest{
 		TrainNumber: respRoute.Routes[0].TrainNumber,
-		Direction:   0,
+		Direction:   pb.Direction_DIRECTION_ONE_WAY,
 		FromCode:    respRoute.Routes[0].From.Code,
 		FromTime:    respRoute.Routes[0].Departure,
 		ToCode:      respRoute.Routes[0].To.Code,
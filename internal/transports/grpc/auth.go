@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// Режимы аутентификации клиентов
+const (
+	AuthModeNone   = ""
+	AuthModeAPIKey = "apikey"
+	AuthModeJWT    = "jwt"
+)
+
+// Заголовки метаданных, в которых клиент передаёт учётные данные
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+)
+
+// errUnauthenticated причина отказа, возвращаемая клиенту без подробностей
+var errUnauthenticated = errors.New("missing or invalid credentials")
+
+// Authenticator проверяет учётные данные из метаданных вызова и возвращает идентификатор клиента
+type Authenticator interface {
+	Authenticate(md metadata.MD) (string, error)
+}
+
+// NewAuthenticator создаёт Authenticator по конфигурации. Для режима без аутентификации возвращает nil.
+func NewAuthenticator(cfg *config.GRPCAuth) (Authenticator, error) {
+	switch strings.ToLower(cfg.Mode) {
+	case AuthModeNone:
+		return nil, nil
+	case AuthModeAPIKey:
+		if len(cfg.APIKeys) == 0 {
+			return nil, errors.New("auth mode apikey requires at least one API key")
+		}
+		return newAPIKeyAuthenticator(cfg.APIKeys), nil
+	case AuthModeJWT:
+		if cfg.JWTSecret == "" {
+			return nil, errors.New("auth mode jwt requires JWT secret")
+		}
+		return &jwtAuthenticator{secret: []byte(cfg.JWTSecret), issuer: cfg.JWTIssuer, audience: cfg.JWTAudience}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode %q", cfg.Mode)
+	}
+}
+
+// apiKeyAuthenticator проверяет статический API-ключ из заголовка x-api-key
+type apiKeyAuthenticator struct {
+	keys [][]byte
+}
+
+func newAPIKeyAuthenticator(keys []string) *apiKeyAuthenticator {
+	a := &apiKeyAuthenticator{}
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			a.keys = append(a.keys, []byte(key))
+		}
+	}
+	return a
+}
+
+// Authenticate возвращает в качестве идентификатора клиента префикс хэша ключа, чтобы не раскрывать ключ в логах
+func (a *apiKeyAuthenticator) Authenticate(md metadata.MD) (string, error) {
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 {
+		return "", errUnauthenticated
+	}
+	provided := []byte(values[0])
+	for _, key := range a.keys {
+		if subtle.ConstantTimeCompare(provided, key) == 1 {
+			sum := sha256.Sum256(key)
+			return "key:" + hex.EncodeToString(sum[:4]), nil
+		}
+	}
+	return "", errUnauthenticated
+}
+
+// jwtAuthenticator проверяет Bearer токен, подписанный HS256
+type jwtAuthenticator struct {
+	secret   []byte
+	issuer   string
+	audience string
+	now      func() time.Time // Для тестов; nil - time.Now
+}
+
+// jwtClaims поддерживаемые стандартные поля токена
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"` // Строка или массив строк
+	ExpiresAt *int64          `json:"exp"`
+	NotBefore *int64          `json:"nbf"`
+}
+
+// Authenticate возвращает subject токена в качестве идентификатора клиента
+func (a *jwtAuthenticator) Authenticate(md metadata.MD) (string, error) {
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", errUnauthenticated
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return "", errUnauthenticated
+	}
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return "", err
+	}
+	return "sub:" + claims.Subject, nil
+}
+
+func (a *jwtAuthenticator) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errUnauthenticated
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, errUnauthenticated
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errUnauthenticated
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errUnauthenticated
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, errUnauthenticated
+	}
+	now := time.Now()
+	if a.now != nil {
+		now = a.now()
+	}
+	if claims.ExpiresAt != nil && now.Unix() >= *claims.ExpiresAt {
+		return nil, errUnauthenticated
+	}
+	if claims.NotBefore != nil && now.Unix() < *claims.NotBefore {
+		return nil, errUnauthenticated
+	}
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return nil, errUnauthenticated
+	}
+	if a.audience != "" && !claims.hasAudience(a.audience) {
+		return nil, errUnauthenticated
+	}
+	if claims.Subject == "" {
+		return nil, errUnauthenticated
+	}
+	return &claims, nil
+}
+
+func (c *jwtClaims) hasAudience(audience string) bool {
+	var single string
+	if err := json.Unmarshal(c.Audience, &single); err == nil {
+		return single == audience
+	}
+	var list []string
+	if err := json.Unmarshal(c.Audience, &list); err == nil {
+		for _, a := range list {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// clientIDKey ключ контекста с идентификатором аутентифицированного клиента
+type clientIDKey struct{}
+
+// ClientIDFromContext возвращает идентификатор клиента, установленный интерсептором аутентификации
+func ClientIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(clientIDKey{}).(string)
+	return id, ok
+}
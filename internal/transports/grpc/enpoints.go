@@ -3,8 +3,11 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/status"
 
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/mappers"
 
@@ -15,17 +18,33 @@ import (
 
 // Endpoints собраны для gRPC сервиса.
 type Endpoints struct {
-	GetTrainRoutes    endpoint.Endpoint
-	GetTrainCarriages endpoint.Endpoint
-	SearchStation     endpoint.Endpoint
+	GetTrainRoutes         endpoint.Endpoint
+	GetTrainCarriages      endpoint.Endpoint
+	GetRoutesWithCarriages endpoint.Endpoint
+	SearchStation          endpoint.Endpoint
+	GetFareSummary         endpoint.Endpoint
+	RecommendSeats         endpoint.Endpoint
+	GetSchemaDrift         endpoint.Endpoint
+	FindTrainByNumber      endpoint.Endpoint
+	GetCityStations        endpoint.Endpoint
+	GetNearbyStations      endpoint.Endpoint
+	LookupStationCodes     endpoint.Endpoint
 }
 
-// MakeEndpoints создаёт эндпоинты из сервиса.
+// MakeEndpoints создаёт эндпоинты из сервиса; каждый вызов эндпоинта записывается в спан.
 func MakeEndpoints(svc service.Service) Endpoints {
 	return Endpoints{
-		GetTrainRoutes:    makeGetTrainRoutesEndpoint(svc),
-		GetTrainCarriages: makeGetTrainCarriagesEndpoint(svc),
-		SearchStation:     makeSearchStationEndpoint(svc),
+		GetTrainRoutes:         EndpointTracing("GetTrainRoutes")(makeGetTrainRoutesEndpoint(svc)),
+		GetTrainCarriages:      EndpointTracing("GetTrainCarriages")(makeGetTrainCarriagesEndpoint(svc)),
+		GetRoutesWithCarriages: EndpointTracing("GetRoutesWithCarriages")(makeGetRoutesWithCarriagesEndpoint(svc)),
+		SearchStation:          EndpointTracing("SearchStation")(makeSearchStationEndpoint(svc)),
+		GetFareSummary:         EndpointTracing("GetFareSummary")(makeGetFareSummaryEndpoint(svc)),
+		RecommendSeats:         EndpointTracing("RecommendSeats")(makeRecommendSeatsEndpoint(svc)),
+		GetSchemaDrift:         EndpointTracing("GetSchemaDrift")(makeGetSchemaDriftEndpoint(svc)),
+		FindTrainByNumber:      EndpointTracing("FindTrainByNumber")(makeFindTrainByNumberEndpoint(svc)),
+		GetCityStations:        EndpointTracing("GetCityStations")(makeGetCityStationsEndpoint(svc)),
+		GetNearbyStations:      EndpointTracing("GetNearbyStations")(makeGetNearbyStationsEndpoint(svc)),
+		LookupStationCodes:     EndpointTracing("LookupStationCodes")(makeLookupStationCodesEndpoint(svc)),
 	}
 }
 
@@ -35,14 +54,34 @@ func makeGetTrainRoutesEndpoint(svc service.Service) endpoint.Endpoint {
 		if !ok {
 			return nil, fmt.Errorf("expected *pb.GetTrainRoutesRequest, got %T", request)
 		}
+		if err := validateGetTrainRoutesRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
 		params := domain.GetTrainRoutesParams{
-			FromCode:   int(req.FromCode),
-			ToCode:     int(req.ToCode),
-			Direction:  domain.Direction(req.Direction),
-			TrainType:  domain.TrainSearchType(req.TrainType),
-			CheckSeats: req.CheckSeats,
-			FromDate:   mappers.ParseDateRequest(req.FromDate),
-			WithChange: req.WithChange,
+			FromCode:    int(req.FromCode),
+			ToCode:      int(req.ToCode),
+			Direction:   mappers.MapDirectionFromPb(req.Direction),
+			TrainType:   mappers.MapTrainSearchTypeFromPb(req.TrainType),
+			CheckSeats:  req.CheckSeats,
+			FromDate:    mappers.ParseDateRequest(req.FromDate),
+			WithChange:  req.WithChange,
+			TrainTypes:  mappers.MapTrainTypesFromPb(req.Categories),
+			Language:    normalizeLanguage(req.Lang),
+			FromStation: strings.TrimSpace(req.FromStation),
+			ToStation:   strings.TrimSpace(req.ToStation),
+			CodeType:    mappers.MapStationCodeTypeFromPb(req.CodeType),
+		}
+		if req.Alternatives {
+			result, err := svc.GetTrainRoutesWithAlternatives(ctx, domain.AlternativesParams{
+				Routes:     params,
+				SeatTypes:  mappers.MapCarSeatTypesFromPb(req.SeatTypes),
+				DateRange:  int(req.AlternativeDays),
+				MaxResults: int(req.MaxAlternatives),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mappers.MapRoutesWithAlternativesToPb(result), nil
 		}
 		routes, err := svc.GetTrainRoutes(ctx, params)
 		if err != nil {
@@ -58,12 +97,19 @@ func makeGetTrainCarriagesEndpoint(svc service.Service) endpoint.Endpoint {
 		if !ok {
 			return nil, fmt.Errorf("expected *pb.GetTrainCarriagesRequest, got %T", request)
 		}
+		if err := validateGetTrainCarriagesRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
 		params := domain.GetTrainCarriagesParams{
-			TrainNumber: req.TrainNumber,
-			Direction:   domain.Direction(req.Direction),
+			TrainNumber: strings.TrimSpace(req.TrainNumber),
+			Direction:   mappers.MapDirectionFromPb(req.Direction),
 			FromCode:    int(req.FromCode),
 			FromTime:    mappers.ParseTimeRequest(req.FromTime),
 			ToCode:      int(req.ToCode),
+			Language:    normalizeLanguage(req.Lang),
+			FromStation: strings.TrimSpace(req.FromStation),
+			ToStation:   strings.TrimSpace(req.ToStation),
+			CodeType:    mappers.MapStationCodeTypeFromPb(req.CodeType),
 		}
 		cars, err := svc.GetTrainCarriages(ctx, params)
 		if err != nil {
@@ -73,15 +119,60 @@ func makeGetTrainCarriagesEndpoint(svc service.Service) endpoint.Endpoint {
 	}
 }
 
+func makeGetRoutesWithCarriagesEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.GetRoutesWithCarriagesRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.GetRoutesWithCarriagesRequest, got %T", request)
+		}
+		if err := validateGetRoutesWithCarriagesRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
+		trainNumbers := make([]string, 0, len(req.TrainNumbers))
+		for _, number := range req.TrainNumbers {
+			trainNumbers = append(trainNumbers, strings.TrimSpace(number))
+		}
+		result, err := svc.GetRoutesWithCarriages(ctx, domain.RoutesWithCarriagesParams{
+			Routes: domain.GetTrainRoutesParams{
+				FromCode:    int(req.FromCode),
+				ToCode:      int(req.ToCode),
+				Direction:   domain.OneWay,
+				TrainType:   mappers.MapTrainSearchTypeFromPb(req.TrainType),
+				CheckSeats:  req.CheckSeats,
+				FromDate:    mappers.ParseDateRequest(req.FromDate),
+				TrainTypes:  mappers.MapTrainTypesFromPb(req.Categories),
+				Language:    normalizeLanguage(req.Lang),
+				FromStation: strings.TrimSpace(req.FromStation),
+				ToStation:   strings.TrimSpace(req.ToStation),
+				CodeType:    mappers.MapStationCodeTypeFromPb(req.CodeType),
+			},
+			TrainNumbers: trainNumbers,
+			Concurrency:  int(req.Concurrency),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp := mappers.MapRoutesWithCarriagesToPb(result)
+		for i, failure := range result.Failures {
+			resp.Failures[i].Code = int32(status.Code(toStatusError(failure.Err)))
+		}
+		return resp, nil
+	}
+}
+
 func makeSearchStationEndpoint(svc service.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(*pb.SearchStationRequest)
 		if !ok {
 			return nil, fmt.Errorf("expected *pb.SearchStationRequest, got %T", request)
 		}
+		if err := validateSearchStationRequest(req); err != nil {
+			return nil, err
+		}
 		params := domain.SearchStationParams{
 			Query:       req.Query,
 			CompactMode: req.CompactMode,
+			Language:    normalizeLanguage(req.Lang),
 		}
 		stations, err := svc.SearchStation(ctx, params)
 		if err != nil {
@@ -90,3 +181,168 @@ func makeSearchStationEndpoint(svc service.Service) endpoint.Endpoint {
 		return mappers.MapStationsToPb(stations), nil
 	}
 }
+
+func makeGetFareSummaryEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.GetFareSummaryRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.GetFareSummaryRequest, got %T", request)
+		}
+		if err := validateGetFareSummaryRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
+		params := domain.FareSummaryParams{
+			FromCode:       int(req.FromCode),
+			ToCode:         int(req.ToCode),
+			Date:           mappers.ParseDateRequest(req.Date),
+			TrainType:      mappers.MapTrainSearchTypeFromPb(req.TrainType),
+			TrainTypes:     mappers.MapTrainTypesFromPb(req.Categories),
+			WithSeatPrices: req.WithSeatPrices,
+			Language:       normalizeLanguage(req.Lang),
+			CodeType:       mappers.MapStationCodeTypeFromPb(req.CodeType),
+		}
+		summaries, err := svc.GetFareSummary(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapFareSummariesToPb(summaries), nil
+	}
+}
+
+func makeRecommendSeatsEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.RecommendSeatsRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.RecommendSeatsRequest, got %T", request)
+		}
+		if err := validateRecommendSeatsRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
+		prefs := req.GetPreferences()
+		params := domain.RecommendSeatsParams{
+			FromCode:    int(req.FromCode),
+			ToCode:      int(req.ToCode),
+			Date:        mappers.ParseDateRequest(req.Date),
+			TrainNumber: strings.TrimSpace(req.TrainNumber),
+			SeatTypes:   mappers.MapCarSeatTypesFromPb(req.SeatTypes),
+			PartySize:   int(req.PartySize),
+			Preferences: domain.SeatPreferences{
+				LowerOnly:       prefs.GetLowerOnly(),
+				SameCompartment: prefs.GetSameCompartment(),
+				AvoidToilet:     prefs.GetAvoidToilet(),
+				AvoidSide:       prefs.GetAvoidSide(),
+				SameCar:         prefs.GetSameCar(),
+			},
+			MaxResults: int(req.MaxResults),
+			Language:   normalizeLanguage(req.Lang),
+			CodeType:   mappers.MapStationCodeTypeFromPb(req.CodeType),
+		}
+		recommendations, err := svc.RecommendSeats(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapSeatRecommendationsToPb(recommendations), nil
+	}
+}
+
+func makeGetSchemaDriftEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.GetSchemaDriftRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.GetSchemaDriftRequest, got %T", request)
+		}
+		if err := validateGetSchemaDriftRequest(req); err != nil {
+			return nil, err
+		}
+		events, err := svc.GetSchemaDrift(ctx, domain.SchemaDriftParams{
+			Endpoint: strings.TrimSpace(req.Endpoint),
+			Limit:    int(req.Limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapSchemaDriftToPb(events), nil
+	}
+}
+
+func makeFindTrainByNumberEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.FindTrainByNumberRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.FindTrainByNumberRequest, got %T", request)
+		}
+		if err := validateFindTrainByNumberRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
+		schedule, err := svc.FindTrainByNumber(ctx, domain.FindTrainParams{
+			TrainNumber: strings.TrimSpace(req.TrainNumber),
+			Date:        mappers.ParseDateRequest(req.Date),
+			Language:    normalizeLanguage(req.Lang),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapTrainScheduleToPb(schedule), nil
+	}
+}
+
+func makeGetCityStationsEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.GetCityStationsRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.GetCityStationsRequest, got %T", request)
+		}
+		if err := validateGetCityStationsRequest(req); err != nil {
+			return nil, err
+		}
+		city, err := svc.GetCityStations(ctx, domain.CityStationsParams{
+			Query:    strings.TrimSpace(req.Query),
+			Language: normalizeLanguage(req.Lang),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapCityToPb(city), nil
+	}
+}
+
+func makeGetNearbyStationsEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.GetNearbyStationsRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.GetNearbyStationsRequest, got %T", request)
+		}
+		if err := validateGetNearbyStationsRequest(req); err != nil {
+			return nil, err
+		}
+		stations, err := svc.GetNearbyStations(ctx, domain.NearbyStationsParams{
+			Point:    domain.GeoPoint{Lat: req.Lat, Lon: req.Lon},
+			RadiusKm: req.RadiusKm,
+			Limit:    int(req.Limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapNearbyStationsToPb(stations), nil
+	}
+}
+
+func makeLookupStationCodesEndpoint(svc service.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*pb.LookupStationCodesRequest)
+		if !ok {
+			return nil, fmt.Errorf("expected *pb.LookupStationCodesRequest, got %T", request)
+		}
+		if err := validateLookupStationCodesRequest(req); err != nil {
+			return nil, err
+		}
+		codes, err := svc.LookupStationCodes(ctx, domain.StationCodeParams{
+			Type: mappers.MapStationCodeTypeFromPb(req.CodeType),
+			Code: strings.TrimSpace(req.Code),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mappers.MapStationCodesToPb(codes), nil
+	}
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/service"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+)
+
+// stubService сервис для тестов эндпоинтов: переопределённые методы запоминают параметры
+// и возвращают заданные ответы, остальные не вызываются
+type stubService struct {
+	service.Service
+	driftParams domain.SchemaDriftParams
+	drift       []domain.SchemaDriftEvent
+	carriages   domain.RoutesWithCarriages
+}
+
+func (s *stubService) GetRoutesWithCarriages(context.Context, domain.RoutesWithCarriagesParams) (domain.RoutesWithCarriages, error) {
+	return s.carriages, nil
+}
+
+func (s *stubService) GetSchemaDrift(_ context.Context, params domain.SchemaDriftParams) ([]domain.SchemaDriftEvent, error) {
+	s.driftParams = params
+	return s.drift, nil
+}
+
+func TestGetSchemaDriftEndpoint(t *testing.T) {
+	svc := &stubService{drift: []domain.SchemaDriftEvent{{Provider: "rzd", Endpoint: "routes", Count: 2}}}
+	endpoint := makeGetSchemaDriftEndpoint(svc)
+
+	response, err := endpoint(context.Background(), &pb.GetSchemaDriftRequest{Endpoint: " routes ", Limit: 5})
+	require.NoError(t, err)
+	require.Equal(t, domain.SchemaDriftParams{Endpoint: "routes", Limit: 5}, svc.driftParams)
+	resp, ok := response.(*pb.GetSchemaDriftResponse)
+	require.True(t, ok)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, int32(2), resp.Events[0].Count)
+
+	svc.driftParams = domain.SchemaDriftParams{}
+	_, err = endpoint(context.Background(), &pb.GetSchemaDriftRequest{Limit: -1})
+	require.Equal(t, []string{"limit"}, violatedFields(t, err))
+	require.Zero(t, svc.driftParams, "invalid request does not reach the service")
+
+	_, err = endpoint(context.Background(), &pb.GetTrainRoutesRequest{})
+	require.Error(t, err)
+}
+
+// Код статуса ошибки каждого поезда совпадает с кодом, который вернул бы GetTrainCarriages
+func TestGetRoutesWithCarriagesEndpointFailureCodes(t *testing.T) {
+	departure := time.Now().Add(48 * time.Hour)
+	svc := &stubService{carriages: domain.RoutesWithCarriages{
+		Routes: []domain.TrainRoute{{TrainNumber: "001А"}, {TrainNumber: "003А"}, {TrainNumber: "005А"}},
+		Failures: []domain.CarriagesFailure{
+			{TrainNumber: "001А", Departure: departure, Err: fmt.Errorf("train 001А: %w", domain.ErrTrainNotFound)},
+			{TrainNumber: "003А", Departure: departure, Err: fmt.Errorf("carriages: %w", domain.ErrUpstreamUnavailable)},
+			{TrainNumber: "005А", Departure: departure, Err: errors.New("unexpected response")},
+		},
+	}}
+	endpoint := makeGetRoutesWithCarriagesEndpoint(svc)
+
+	response, err := endpoint(context.Background(), &pb.GetRoutesWithCarriagesRequest{
+		FromCode: 2004000,
+		ToCode:   2000000,
+		FromDate: timestamppb.New(departure),
+	})
+	require.NoError(t, err)
+	resp, ok := response.(*pb.GetRoutesWithCarriagesResponse)
+	require.True(t, ok)
+	var trains []string
+	var statusCodes []codes.Code
+	for _, failure := range resp.Failures {
+		trains = append(trains, failure.TrainNumber)
+		statusCodes = append(statusCodes, codes.Code(failure.Code))
+	}
+	require.Equal(t, []string{"001А", "003А", "005А"}, trains)
+	require.Equal(t, []codes.Code{codes.NotFound, codes.Unavailable, codes.Unknown}, statusCodes)
+}
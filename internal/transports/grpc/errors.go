@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+)
+
+// toStatusError преобразует ошибку сервиса в gRPC статус. Ошибки, уже являющиеся статусом
+// (например, ошибки валидации), возвращаются без изменений.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var ambiguous *domain.AmbiguousStationError
+	switch {
+	case errors.As(err, &ambiguous):
+		return ambiguousStationStatus(ambiguous)
+	case errors.Is(err, domain.ErrUpstreamUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, domain.ErrTrainNotFound), errors.Is(err, domain.ErrStationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrStationNotOnRoute), errors.Is(err, domain.ErrInvalidStationCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrNotSupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return err
+	}
+}
+
+// ambiguousStationStatus возвращает InvalidArgument, в деталях которого (google.rpc.BadRequest)
+// для поля запроса с названием перечислены подходящие станции
+func ambiguousStationStatus(err *domain.AmbiguousStationError) error {
+	var v fieldViolations
+	for _, candidate := range err.Candidates {
+		v.add(ambiguousStationField(err.Field), fmt.Sprintf("candidate: %s (%d)", candidate.Name, candidate.Code))
+	}
+	st := status.New(codes.InvalidArgument, err.Error())
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// ambiguousStationField поле запроса, в котором передано неоднозначное название
+func ambiguousStationField(field string) string {
+	if field == "city" {
+		return "query"
+	}
+	return field + "Station"
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+)
+
+// UpstreamChecker проверяет доступность вышестоящего API (РЖД)
+type UpstreamChecker interface {
+	Ping(ctx context.Context) error
+}
+
+// BreakerReporter сообщает состояние предохранителей эндпоинтов upstream ("closed", "half-open", "open")
+type BreakerReporter interface {
+	BreakerStates() map[string]string
+}
+
+// breakerServicePrefix префикс имён в grpc.health.v1 для эндпоинтов upstream,
+// например "rzd.upstream.routes"
+const breakerServicePrefix = "rzd.upstream."
+
+// RunHealthMonitor периодически проверяет upstream и обновляет статус grpc.health.v1
+// для всего сервера ("") и для rzd.RzdService. Если checker реализует BreakerReporter,
+// каждый эндпоинт upstream получает свой статус: NOT_SERVING, пока его предохранитель разомкнут.
+// Блокируется до отмены контекста.
+func RunHealthMonitor(ctx context.Context, hs *health.Server, checker UpstreamChecker, interval, timeout time.Duration) {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := checker.Ping(checkCtx)
+		cancel()
+
+		current := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			current = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if current != last {
+			if err != nil {
+				log.Printf("RZD upstream is unreachable: %v", err)
+			} else {
+				log.Printf("RZD upstream is reachable")
+			}
+			hs.SetServingStatus("", current)
+			hs.SetServingStatus(pb.RzdService_ServiceDesc.ServiceName, current)
+			last = current
+		}
+		if reporter, ok := checker.(BreakerReporter); ok {
+			for endpoint, state := range reporter.BreakerStates() {
+				endpointStatus := healthpb.HealthCheckResponse_SERVING
+				if state == "open" {
+					endpointStatus = healthpb.HealthCheckResponse_NOT_SERVING
+				}
+				hs.SetServingStatus(breakerServicePrefix+endpoint, endpointStatus)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			hs.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
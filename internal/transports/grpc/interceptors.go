@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"net"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// publicMethodPrefixes служебные сервисы, доступные без аутентификации и ограничения частоты
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryRecovery перехватывает панику в обработчике и возвращает клиенту Internal
+func UnaryRecovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoveredError(info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery перехватывает панику в потоковом обработчике
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoveredError(info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recoveredError(method string, r interface{}) error {
+	log.Printf("panic in %s: %v\n%s", method, r, debug.Stack())
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// UnaryLogging логирует метод, клиента, код ответа и длительность каждого вызова,
+// пока enabled установлен (nil - всегда)
+func UnaryLogging(enabled *atomic.Bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if enabled != nil && !enabled.Load() {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamLogging логирует потоковые вызовы
+func StreamLogging(enabled *atomic.Bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if enabled != nil && !enabled.Load() {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	client, ok := ClientIDFromContext(ctx)
+	if !ok {
+		client = peerAddress(ctx)
+	}
+	log.Printf("gRPC %s client=%s code=%s duration=%s", method, client, status.Code(err), time.Since(start))
+	if err != nil && status.Code(err) != codes.InvalidArgument {
+		log.Printf("gRPC %s error: %v", method, err)
+	}
+}
+
+// UnaryAdmission аутентифицирует клиента (если задан auth) и ограничивает частоту его вызовов (если задан limiter)
+func UnaryAdmission(auth Authenticator, limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := admit(ctx, info.FullMethod, auth, limiter)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAdmission потоковый вариант UnaryAdmission
+func StreamAdmission(auth Authenticator, limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := admit(ss.Context(), info.FullMethod, auth, limiter)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// admit возвращает контекст с идентификатором клиента или ошибку Unauthenticated/ResourceExhausted
+func admit(ctx context.Context, method string, auth Authenticator, limiter *RateLimiter) (context.Context, error) {
+	if isPublicMethod(method) {
+		return ctx, nil
+	}
+	client := peerAddress(ctx)
+	if auth != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		id, err := auth.Authenticate(md)
+		if err != nil {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		client = id
+		ctx = context.WithValue(ctx, clientIDKey{}, id)
+	}
+	if limiter != nil && !limiter.Allow(ctx, client) {
+		return ctx, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return ctx, nil
+}
+
+// peerAddress возвращает IP-адрес клиента без порта
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// contextServerStream подменяет контекст потока
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
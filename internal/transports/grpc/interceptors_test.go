@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+func signTestJWT(secret, claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(header + "." + payload))
+	return header + "." + payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	auth, err := NewAuthenticator(&config.GRPCAuth{Mode: AuthModeJWT, JWTSecret: "secret", JWTAudience: "rzd"})
+	require.NoError(t, err)
+	jwtAuth := auth.(*jwtAuthenticator)
+	jwtAuth.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	valid := signTestJWT("secret", `{"sub":"bot","aud":["rzd"],"exp":1700000100}`)
+	id, err := auth.Authenticate(metadata.Pairs("authorization", "Bearer "+valid))
+	require.NoError(t, err)
+	require.Equal(t, "sub:bot", id)
+
+	expired := signTestJWT("secret", `{"sub":"bot","aud":"rzd","exp":1699999999}`)
+	_, err = auth.Authenticate(metadata.Pairs("authorization", "Bearer "+expired))
+	require.Error(t, err)
+
+	forged := signTestJWT("other", `{"sub":"bot","aud":"rzd"}`)
+	_, err = auth.Authenticate(metadata.Pairs("authorization", "Bearer "+forged))
+	require.Error(t, err)
+}
+
+func TestAdmissionAuthAndRateLimit(t *testing.T) {
+	auth, err := NewAuthenticator(&config.GRPCAuth{Mode: AuthModeAPIKey, APIKeys: []string{"k1"}})
+	require.NoError(t, err)
+	limiter := NewRateLimiter(1, 2)
+	now := time.Unix(0, 0)
+	limiter.now = func() time.Time { return now }
+
+	interceptor := UnaryAdmission(auth, limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: "/rzd.RzdService/SearchStation"}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		id, _ := ClientIDFromContext(ctx)
+		return id, nil
+	}
+	call := func(md metadata.MD, method string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.Equal(t, codes.Unauthenticated, status.Code(call(metadata.Pairs("x-api-key", "bad"), info.FullMethod)))
+	require.NoError(t, call(metadata.Pairs("x-api-key", "k1"), info.FullMethod))
+	require.NoError(t, call(metadata.Pairs("x-api-key", "k1"), info.FullMethod))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(metadata.Pairs("x-api-key", "k1"), info.FullMethod)))
+
+	// Токен восстанавливается со временем, а health-проверки не требуют ключа
+	now = now.Add(time.Second)
+	require.NoError(t, call(metadata.Pairs("x-api-key", "k1"), info.FullMethod))
+	require.NoError(t, call(metadata.MD{}, "/grpc.health.v1.Health/Check"))
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	_, err := UnaryRecovery()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+		func(context.Context, interface{}) (interface{}, error) { panic("boom") })
+	require.Equal(t, codes.Internal, status.Code(err))
+}
@@ -6,76 +6,329 @@ import (
 
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MapTrainRoutesToPb преобразует срез доменных TrainRoute в pb.GetTrainRoutesResponse.
 // В данной реализации используются google.protobuf.Timestamp для полей времени.
+// Маршруты дополнительно группируются по паре станций отправления и прибытия в порядке первого появления.
 func MapTrainRoutesToPb(routes []domain.TrainRoute) *pb.GetTrainRoutesResponse {
 	var pbRoutes []*pb.TrainRoute
-	for _, r := range routes {
-		pbRoute := &pb.TrainRoute{
-			TrainNumber: r.TrainNumber,
-			TrainType:   int32(r.TrainType),
-			Departure:   timestamppb.New(r.Departure),
-			Arrival:     timestamppb.New(r.Arrival),
-			From:        MapStationToPb(r.From),
-			To:          MapStationToPb(r.To),
-		}
-		// Маппим агрегированные типы вагонов
-		for _, ct := range r.CarTypes {
-			pbCT := &pb.CarriageType{
-				Type:           int32(ct.Type),
-				TypeShortLabel: ct.TypeShortLabel,
-				TypeLabel:      ct.TypeLabel,
-				Class:          ct.Class,
-				Tariff:         int32(ct.Tariff),
-				TariffExtra:    int32(ct.TariffExtra),
-				FreeSeats:      int32(ct.FreeSeats),
-				Disabled:       ct.Disabled,
-			}
-			pbRoute.CarTypes = append(pbRoute.CarTypes, pbCT)
-		}
+	var groups []*pb.StationGroup
+	groupIndex := make(map[[2]int]int)
+	for i, r := range routes {
+		pbRoute := MapTrainRouteToPb(r)
 		pbRoutes = append(pbRoutes, pbRoute)
+
+		key := [2]int{r.From.Code, r.To.Code}
+		g, ok := groupIndex[key]
+		if !ok {
+			g = len(groups)
+			groupIndex[key] = g
+			groups = append(groups, &pb.StationGroup{From: pbRoute.From, To: pbRoute.To})
+		}
+		groups[g].RouteIndexes = append(groups[g].RouteIndexes, int32(i))
 	}
 	return &pb.GetTrainRoutesResponse{
 		Routes: pbRoutes,
+		Groups: groups,
 	}
 }
 
+// MapRoutesWithAlternativesToPb преобразует маршруты с альтернативами в pb.GetTrainRoutesResponse.
+func MapRoutesWithAlternativesToPb(result domain.RoutesWithAlternatives) *pb.GetTrainRoutesResponse {
+	resp := MapTrainRoutesToPb(result.Routes)
+	for _, a := range result.Alternatives {
+		resp.Alternatives = append(resp.Alternatives, &pb.RouteAlternative{
+			Route:     MapTrainRouteToPb(a.Route),
+			Reason:    MapAlternativeReasonToPb(a.Reason),
+			DaysShift: int32(a.DaysShift),
+		})
+	}
+	return resp
+}
+
+// MapRoutesWithCarriagesToPb преобразует маршруты с вагонами в pb.GetRoutesWithCarriagesResponse.
+// Код статуса ошибок по поездам не заполняется: его определяет транспорт.
+func MapRoutesWithCarriagesToPb(result domain.RoutesWithCarriages) *pb.GetRoutesWithCarriagesResponse {
+	resp := &pb.GetRoutesWithCarriagesResponse{
+		Routes: MapTrainRoutesToPb(result.Routes).Routes,
+	}
+	for _, f := range result.Failures {
+		resp.Failures = append(resp.Failures, &pb.CarriagesFailure{
+			TrainNumber: f.TrainNumber,
+			Departure:   timestamppb.New(f.Departure),
+			Message:     f.Err.Error(),
+		})
+	}
+	return resp
+}
+
+// MapAlternativeReasonToPb преобразует причину альтернативы в pb.AlternativeReason.
+func MapAlternativeReasonToPb(r domain.AlternativeReason) pb.AlternativeReason {
+	switch r {
+	case domain.AlternativeAdjacentDate:
+		return pb.AlternativeReason_ALTERNATIVE_REASON_ADJACENT_DATE
+	case domain.AlternativeCityStation:
+		return pb.AlternativeReason_ALTERNATIVE_REASON_CITY_STATION
+	case domain.AlternativeWithChange:
+		return pb.AlternativeReason_ALTERNATIVE_REASON_WITH_CHANGE
+	default:
+		return pb.AlternativeReason_ALTERNATIVE_REASON_UNSPECIFIED
+	}
+}
+
+// MapTrainRouteToPb преобразует доменный TrainRoute в pb.TrainRoute.
+func MapTrainRouteToPb(r domain.TrainRoute) *pb.TrainRoute {
+	pbRoute := &pb.TrainRoute{
+		TrainNumber:     r.TrainNumber,
+		TrainNumber2:    r.TrainNumber2,
+		TrainName:       r.TrainName,
+		TrainType:       MapTrainTypeToPb(r.TrainType),
+		Duration:        durationpb.New(r.Duration),
+		Brand:           r.Brand,
+		Carrier:         MapCarrierToPb(r.Carrier),
+		Firm:            r.Firm,
+		ElReg:           r.ElReg,
+		VarPrice:        r.VarPrice,
+		DeferredPayment: r.DeferredPayment,
+		CarNumeration:   MapCarNumerationToPb(r.CarNumeration),
+		SaleDepth:       int32(r.SaleDepth),
+		Departure:       timestamppb.New(r.Departure),
+		Arrival:         timestamppb.New(r.Arrival),
+		From:            MapStationToPb(r.From),
+		To:              MapStationToPb(r.To),
+	}
+	if !r.OriginDeparture.IsZero() {
+		pbRoute.OriginDeparture = timestamppb.New(r.OriginDeparture)
+	}
+	if r.FromCity.Code != 0 {
+		pbRoute.FromCity = MapStationToPb(r.FromCity)
+	}
+	if r.ToCity.Code != 0 {
+		pbRoute.ToCity = MapStationToPb(r.ToCity)
+	}
+	// Маппим агрегированные типы вагонов
+	for _, ct := range r.CarTypes {
+		pbCT := &pb.CarriageType{
+			Type:           MapCarSeatTypeToPb(ct.Type),
+			TypeShortLabel: ct.TypeShortLabel,
+			TypeLabel:      ct.TypeLabel,
+			Class:          ct.Class,
+			Tariff:         int32(ct.Tariff),
+			TariffExtra:    int32(ct.TariffExtra),
+			FreeSeats:      int32(ct.FreeSeats),
+			Disabled:       ct.Disabled,
+			DataType:       MapCarDataTypeToPb(ct.DataType),
+			BonusPoints:    int32(ct.BonusPoints),
+			LastSeats:      ct.LastSeats,
+		}
+		pbRoute.CarTypes = append(pbRoute.CarTypes, pbCT)
+	}
+	for _, c := range r.Cars {
+		pbRoute.Cars = append(pbRoute.Cars, MapCarToPb(c))
+	}
+	return pbRoute
+}
+
+// MapCityToPb преобразует город и его станции в pb.GetCityStationsResponse.
+func MapCityToPb(city domain.City) *pb.GetCityStationsResponse {
+	resp := &pb.GetCityStationsResponse{City: MapStationToPb(city.Station)}
+	for _, station := range city.Stations {
+		resp.Stations = append(resp.Stations, MapStationToPb(station))
+	}
+	return resp
+}
+
+// MapTrainScheduleToPb преобразует найденный по номеру поезд в pb.FindTrainByNumberResponse.
+// Время прибытия и отправления не задаётся, если поезд на станцию не прибывает или с неё не отправляется.
+func MapTrainScheduleToPb(schedule domain.TrainSchedule) *pb.FindTrainByNumberResponse {
+	resp := &pb.FindTrainByNumberResponse{Route: MapTrainRouteToPb(schedule.Route)}
+	for _, stop := range schedule.Stops {
+		pbStop := &pb.TrainStop{
+			Station:     MapStationToPb(stop.Station),
+			StayMinutes: int32(stop.Stay / time.Minute),
+			Distance:    int32(stop.Distance),
+		}
+		if !stop.Arrival.IsZero() {
+			pbStop.Arrival = timestamppb.New(stop.Arrival)
+		}
+		if !stop.Departure.IsZero() {
+			pbStop.Departure = timestamppb.New(stop.Departure)
+		}
+		resp.Stops = append(resp.Stops, pbStop)
+	}
+	return resp
+}
+
 // MapTrainCarriagesToPb преобразует срез доменных Car в pb.GetTrainCarriagesResponse.
 func MapTrainCarriagesToPb(cars []domain.Car) *pb.GetTrainCarriagesResponse {
 	var pbCars []*pb.Car
 	for _, c := range cars {
-		pbCar := &pb.Car{
-			CarNumber:          c.CarNumber,
-			Type:               c.Type,
-			CategoryLabelLocal: c.CategoryLabelLocal,
-			TypeLabel:          c.TypeLabel,
-			CategoryCode:       c.CategoryCode,
-			CarTypeId:          int32(c.CarTypeID),
-			CarType:            int32(c.CarType),
-			Letter:             c.Letter,
-			ClassType:          c.ClassType,
-			Tariff:             int32(c.Tariff),
-			TariffExtra:        int32(c.Tariff2),
-			Carrier:            MapCarrierToPb(c.Carrier),
-			CarNumeration:      int32(MapCarNumerationToPb(c.CarNumeration)),
+		pbCars = append(pbCars, MapCarToPb(c))
+	}
+	return &pb.GetTrainCarriagesResponse{
+		Carriages: pbCars,
+	}
+}
+
+// MapCarToPb преобразует доменный Car в pb.Car.
+func MapCarToPb(c domain.Car) *pb.Car {
+	pbCar := &pb.Car{
+		CarNumber:          c.CarNumber,
+		Type:               c.Type,
+		CategoryLabelLocal: c.CategoryLabelLocal,
+		TypeLabel:          c.TypeLabel,
+		CategoryCode:       c.CategoryCode,
+		CarTypeId:          int32(c.CarTypeID),
+		CarType:            int32(c.CarType),
+		SeatType:           MapCarSeatTypeToPb(c.SeatType),
+		Letter:             c.Letter,
+		ClassType:          c.ClassType,
+		Tariff:             int32(c.Tariff),
+		TariffExtra:        int32(c.Tariff2),
+		Carrier:            MapCarrierToPb(c.Carrier),
+		CarNumeration:      MapCarNumerationToPb(c.CarNumeration),
+	}
+	// Маппим список услуг
+	for _, s := range c.Services {
+		pbCar.Services = append(pbCar.Services, &pb.Service{
+			Id:          s.ID,
+			Name:        s.Name,
+			Description: s.Description,
+		})
+	}
+	// Маппим группы мест
+	for _, seat := range c.Seats {
+		pbCar.Seats = append(pbCar.Seats, &pb.SeatGroup{
+			Type:        seat.Type,
+			Label:       seat.Label,
+			Tariff:      int32(seat.Tariff),
+			TariffExtra: int32(seat.Tariff2),
+			Free:        int32(seat.Free),
+			Places:      seat.Places,
+		})
+	}
+	return pbCar
+}
+
+// MapFareSummariesToPb преобразует сводку тарифов в pb.GetFareSummaryResponse.
+func MapFareSummariesToPb(summaries []domain.FareSummary) *pb.GetFareSummaryResponse {
+	var fares []*pb.FareSummary
+	for _, s := range summaries {
+		fares = append(fares, &pb.FareSummary{
+			Type:               MapCarSeatTypeToPb(s.SeatType),
+			MinTariff:          int32(s.MinTariff),
+			MaxTariff:          int32(s.MaxTariff),
+			MinTrains:          mapFareTrainsToPb(s.MinTrains),
+			MaxTrains:          mapFareTrainsToPb(s.MaxTrains),
+			FreeSeats:          int32(s.FreeSeats),
+			Trains:             int32(s.Trains),
+			LowerSeatMinTariff: int32(s.LowerSeatMinTariff),
+			UpperSeatMinTariff: int32(s.UpperSeatMinTariff),
+		})
+	}
+	return &pb.GetFareSummaryResponse{
+		Fares: fares,
+	}
+}
+
+// MapSchemaDriftToPb преобразует расхождения с контрактом в pb.GetSchemaDriftResponse.
+func MapSchemaDriftToPb(events []domain.SchemaDriftEvent) *pb.GetSchemaDriftResponse {
+	var pbEvents []*pb.SchemaDriftEvent
+	for _, e := range events {
+		pbEvent := &pb.SchemaDriftEvent{
+			Provider:      e.Provider,
+			Endpoint:      e.Endpoint,
+			FirstSeen:     timestamppb.New(e.FirstSeen),
+			LastSeen:      timestamppb.New(e.LastSeen),
+			Count:         int32(e.Count),
+			UnknownFields: e.UnknownFields,
+			MissingFields: e.MissingFields,
+			Sample:        e.Sample,
 		}
-		// Маппим список услуг
-		for _, s := range c.Services {
-			pbCar.Services = append(pbCar.Services, &pb.Service{
-				Id:          s.ID,
-				Name:        s.Name,
-				Description: s.Description,
+		for _, change := range e.ChangedFields {
+			pbEvent.ChangedFields = append(pbEvent.ChangedFields, &pb.SchemaFieldChange{
+				Path:   change.Path,
+				Reason: change.Reason,
 			})
 		}
-		// Если потребуется маппить места, их можно добавить сюда.
-		pbCars = append(pbCars, pbCar)
+		pbEvents = append(pbEvents, pbEvent)
 	}
-	return &pb.GetTrainCarriagesResponse{
-		Carriages: pbCars,
+	return &pb.GetSchemaDriftResponse{
+		Events: pbEvents,
+	}
+}
+
+// MapSeatRecommendationsToPb преобразует варианты размещения в pb.RecommendSeatsResponse.
+func MapSeatRecommendationsToPb(trains []domain.TrainSeatRecommendations) *pb.RecommendSeatsResponse {
+	var pbTrains []*pb.TrainSeatRecommendations
+	for _, t := range trains {
+		pbTrain := &pb.TrainSeatRecommendations{
+			TrainNumber: t.TrainNumber,
+			Departure:   timestamppb.New(t.Departure),
+		}
+		for _, c := range t.Combinations {
+			pbCombination := &pb.SeatCombination{
+				TotalTariff:  int32(c.TotalTariff),
+				Compartments: int32(c.Compartments),
+				Cars:         int32(c.Cars),
+			}
+			for _, seat := range c.Seats {
+				pbCombination.Seats = append(pbCombination.Seats, &pb.RecommendedSeat{
+					CarNumber:   seat.CarNumber,
+					CarType:     MapCarSeatTypeToPb(seat.CarType),
+					Number:      int32(seat.Number),
+					Label:       seat.Label,
+					Compartment: int32(seat.Compartment),
+					Lower:       seat.Lower,
+					Side:        seat.Side,
+					NearToilet:  seat.NearToilet,
+					Tariff:      int32(seat.Tariff),
+				})
+			}
+			pbTrain.Combinations = append(pbTrain.Combinations, pbCombination)
+		}
+		pbTrains = append(pbTrains, pbTrain)
+	}
+	return &pb.RecommendSeatsResponse{
+		Trains: pbTrains,
+	}
+}
+
+// MapCarSeatTypesFromPb преобразует список pb.CarSeatType из запроса в доменные типы мест.
+// Значения должны быть заранее проверены валидацией запроса.
+func MapCarSeatTypesFromPb(types []pb.CarSeatType) []domain.CarSeatType {
+	var result []domain.CarSeatType
+	for _, t := range types {
+		switch t {
+		case pb.CarSeatType_CAR_SEAT_TYPE_PLATZ:
+			result = append(result, domain.Platz)
+		case pb.CarSeatType_CAR_SEAT_TYPE_GENERAL:
+			result = append(result, domain.General)
+		case pb.CarSeatType_CAR_SEAT_TYPE_SIDE:
+			result = append(result, domain.Side)
+		case pb.CarSeatType_CAR_SEAT_TYPE_COUPE:
+			result = append(result, domain.Coupe)
+		case pb.CarSeatType_CAR_SEAT_TYPE_SOFT:
+			result = append(result, domain.Soft)
+		case pb.CarSeatType_CAR_SEAT_TYPE_LUX:
+			result = append(result, domain.Lux)
+		}
+	}
+	return result
+}
+
+func mapFareTrainsToPb(trains []domain.FareTrain) []*pb.FareTrain {
+	result := make([]*pb.FareTrain, 0, len(trains))
+	for _, t := range trains {
+		result = append(result, &pb.FareTrain{
+			TrainNumber: t.TrainNumber,
+			Departure:   timestamppb.New(t.Departure),
+		})
 	}
+	return result
 }
 
 // MapStationsToPb преобразует срез доменных Station в pb.SearchStationResponse.
@@ -97,12 +350,44 @@ func MapStationsToPb(stations []domain.Station) *pb.SearchStationResponse {
 
 // MapStationToPb преобразует доменную Station в pb.Station.
 func MapStationToPb(s domain.Station) *pb.Station {
-	return &pb.Station{
+	pbStation := &pb.Station{
 		Name:      s.Name,
 		Code:      int32(s.Code),
 		RouteName: s.RouteName,
 		Level:     int32(s.Level),
 		Score:     int32(s.Score),
+		TimeZone:  s.TimeZone,
+		City:      s.IsCity(),
+		Region:    s.Region,
+		EsrCode:   s.ESRCode,
+	}
+	if !s.Location.IsZero() {
+		pbStation.Location = &pb.GeoPoint{Lat: s.Location.Lat, Lon: s.Location.Lon}
+	}
+	return pbStation
+}
+
+// MapNearbyStationsToPb преобразует станции рядом с точкой в pb.GetNearbyStationsResponse.
+func MapNearbyStationsToPb(stations []domain.NearbyStation) *pb.GetNearbyStationsResponse {
+	var pbStations []*pb.NearbyStation
+	for _, s := range stations {
+		pbStations = append(pbStations, &pb.NearbyStation{
+			Station:    MapStationToPb(s.Station),
+			DistanceKm: s.DistanceKm,
+		})
+	}
+	return &pb.GetNearbyStationsResponse{
+		Stations: pbStations,
+	}
+}
+
+// MapStationCodesToPb преобразует коды станции в pb.LookupStationCodesResponse.
+func MapStationCodesToPb(codes domain.StationCodes) *pb.LookupStationCodesResponse {
+	return &pb.LookupStationCodesResponse{
+		Express3Code: int32(codes.Express3),
+		EsrCode:      codes.ESR,
+		UicCode:      codes.UIC,
+		Name:         codes.Name,
 	}
 }
 
@@ -114,21 +399,125 @@ func MapCarrierToPb(c domain.Carrier) *pb.Carrier {
 	}
 }
 
-// MapCarNumerationToPb преобразует доменное CarNumeration в int32 для pb.
-// Используем определённые константы: Head=0, Tail=1, Unknown=2.
-func MapCarNumerationToPb(cn domain.CarNumeration) int32 {
-	// Если типы совпадают, можно вернуть значение напрямую.
-	// В данном случае возвращаем cn, и в endpoint-е результат приведём к int32.
+// MapCarNumerationToPb преобразует доменное CarNumeration в pb.CarNumeration.
+func MapCarNumerationToPb(cn domain.CarNumeration) pb.CarNumeration {
 	switch cn {
 	case domain.Head:
-		return 0 // Head
+		return pb.CarNumeration_CAR_NUMERATION_HEAD
 	case domain.Tail:
-		return 1 // Tail
+		return pb.CarNumeration_CAR_NUMERATION_TAIL
 	default:
-		return 2 // Unknown
+		return pb.CarNumeration_CAR_NUMERATION_UNKNOWN
 	}
 }
 
+// MapCarSeatTypeToPb преобразует доменный CarSeatType в pb.CarSeatType.
+func MapCarSeatTypeToPb(t domain.CarSeatType) pb.CarSeatType {
+	switch t {
+	case domain.Platz:
+		return pb.CarSeatType_CAR_SEAT_TYPE_PLATZ
+	case domain.General:
+		return pb.CarSeatType_CAR_SEAT_TYPE_GENERAL
+	case domain.Side:
+		return pb.CarSeatType_CAR_SEAT_TYPE_SIDE
+	case domain.Coupe:
+		return pb.CarSeatType_CAR_SEAT_TYPE_COUPE
+	case domain.Soft:
+		return pb.CarSeatType_CAR_SEAT_TYPE_SOFT
+	case domain.Lux:
+		return pb.CarSeatType_CAR_SEAT_TYPE_LUX
+	default:
+		return pb.CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED
+	}
+}
+
+// MapDirectionFromPb преобразует pb.Direction в доменное направление.
+// Значение должно быть заранее проверено валидацией запроса.
+func MapDirectionFromPb(d pb.Direction) domain.Direction {
+	if d == pb.Direction_DIRECTION_RETURN {
+		return domain.Return
+	}
+	return domain.OneWay
+}
+
+// MapCarDataTypeToPb преобразует вид записи о типе вагона в pb.CarDataType.
+func MapCarDataTypeToPb(t domain.CarDataType) pb.CarDataType {
+	switch t {
+	case domain.CarDataSummary:
+		return pb.CarDataType_CAR_DATA_TYPE_SUMMARY
+	case domain.CarDataSeatTier:
+		return pb.CarDataType_CAR_DATA_TYPE_SEAT_TIER
+	default:
+		return pb.CarDataType_CAR_DATA_TYPE_UNSPECIFIED
+	}
+}
+
+// MapStationCodeTypeFromPb преобразует pb.StationCodeType в доменную систему кодов.
+// Значение должно быть заранее проверено валидацией запроса.
+func MapStationCodeTypeFromPb(t pb.StationCodeType) domain.StationCodeType {
+	switch t {
+	case pb.StationCodeType_STATION_CODE_TYPE_ESR:
+		return domain.CodeESR
+	case pb.StationCodeType_STATION_CODE_TYPE_UIC:
+		return domain.CodeUIC
+	default:
+		return domain.CodeExpress3
+	}
+}
+
+// MapTrainSearchTypeFromPb преобразует pb.TrainSearchType в доменный тип поиска.
+// Неуказанный тип трактуется как поиск всех поездов.
+func MapTrainSearchTypeFromPb(t pb.TrainSearchType) domain.TrainSearchType {
+	switch t {
+	case pb.TrainSearchType_TRAIN_SEARCH_TYPE_TRAINS:
+		return domain.Trains
+	case pb.TrainSearchType_TRAIN_SEARCH_TYPE_ELECTRICS:
+		return domain.Electrics
+	default:
+		return domain.AllTrains
+	}
+}
+
+// MapTrainTypeToPb преобразует доменную категорию поезда в pb.TrainCategory.
+func MapTrainTypeToPb(t domain.TrainType) pb.TrainCategory {
+	switch t {
+	case domain.Suburban:
+		return pb.TrainCategory_TRAIN_CATEGORY_SUBURBAN
+	case domain.HighSpeed:
+		return pb.TrainCategory_TRAIN_CATEGORY_HIGH_SPEED
+	case domain.ExpressSuburban:
+		return pb.TrainCategory_TRAIN_CATEGORY_EXPRESS_SUBURBAN
+	case domain.Bus:
+		return pb.TrainCategory_TRAIN_CATEGORY_BUS
+	case domain.Ferry:
+		return pb.TrainCategory_TRAIN_CATEGORY_FERRY
+	default:
+		return pb.TrainCategory_TRAIN_CATEGORY_LONG_DISTANCE
+	}
+}
+
+// MapTrainTypesFromPb преобразует список pb.TrainCategory из запроса в доменные категории.
+func MapTrainTypesFromPb(categories []pb.TrainCategory) []domain.TrainType {
+	var result []domain.TrainType
+	for _, c := range categories {
+		switch c {
+		case pb.TrainCategory_TRAIN_CATEGORY_SUBURBAN:
+			result = append(result, domain.Suburban)
+		case pb.TrainCategory_TRAIN_CATEGORY_HIGH_SPEED:
+			result = append(result, domain.HighSpeed)
+		case pb.TrainCategory_TRAIN_CATEGORY_EXPRESS_SUBURBAN:
+			result = append(result, domain.ExpressSuburban)
+		case pb.TrainCategory_TRAIN_CATEGORY_BUS:
+			result = append(result, domain.Bus)
+		case pb.TrainCategory_TRAIN_CATEGORY_FERRY:
+			result = append(result, domain.Ferry)
+		default:
+			result = append(result, domain.Train)
+		}
+	}
+	return result
+}
+
 // ParseTimestampToTime преобразует protobuf Timestamp в time.Time.
 func ParseTimestampToTime(ts *timestamppb.Timestamp) time.Time {
 	if ts == nil {
@@ -143,7 +532,12 @@ func ParseTimeRequest(ts *timestamppb.Timestamp) time.Time {
 	return ParseTimestampToTime(ts)
 }
 
-// ParseDateRequest аналогично преобразует Timestamp в дату (time.Time).
+// ParseDateRequest преобразует Timestamp в календарную дату: полночь дня по UTC.
+// Дальше дата используется как есть, без перевода в пояса станций.
 func ParseDateRequest(ts *timestamppb.Timestamp) time.Time {
-	return ParseTimestampToTime(ts)
+	if ts == nil {
+		return time.Time{}
+	}
+	year, month, day := ts.AsTime().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,113 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+)
+
+func TestMapTrainRouteToPb(t *testing.T) {
+	departure := time.Date(2025, 4, 14, 0, 12, 0, 0, time.UTC)
+	route := domain.TrainRoute{
+		TrainNumber:     "752А",
+		TrainNumber2:    "752*А",
+		TrainName:       "Ласточка",
+		Duration:        4*time.Hour + 5*time.Minute,
+		Brand:           "САПСАН",
+		Carrier:         domain.Carrier{ID: "ДОСС", Name: "АО ФПК"},
+		Firm:            true,
+		ElReg:           true,
+		VarPrice:        true,
+		DeferredPayment: true,
+		CarNumeration:   domain.Tail,
+		SaleDepth:       120,
+		Departure:       departure,
+		Arrival:         departure.Add(4*time.Hour + 5*time.Minute),
+	}
+
+	pbRoute := MapTrainRouteToPb(route)
+	require.Equal(t, "752*А", pbRoute.TrainNumber2)
+	require.Equal(t, "Ласточка", pbRoute.TrainName)
+	require.Equal(t, 245*time.Minute, pbRoute.Duration.AsDuration())
+	require.Equal(t, "САПСАН", pbRoute.Brand)
+	require.Equal(t, "ДОСС", pbRoute.Carrier.Id)
+	require.Equal(t, "АО ФПК", pbRoute.Carrier.Name)
+	require.True(t, pbRoute.Firm)
+	require.True(t, pbRoute.ElReg)
+	require.True(t, pbRoute.VarPrice)
+	require.True(t, pbRoute.DeferredPayment)
+	require.Equal(t, pb.CarNumeration_CAR_NUMERATION_TAIL, pbRoute.CarNumeration)
+	require.Equal(t, int32(120), pbRoute.SaleDepth)
+	require.Nil(t, pbRoute.OriginDeparture, "unknown origin departure is not sent")
+}
+
+func TestMapTrainRoutesToPbGroups(t *testing.T) {
+	moscow := domain.Station{Name: "МОСКВА", Code: 2000000, Level: domain.CityLevel}
+	from := domain.Station{Name: "САНКТ-ПЕТЕРБУРГ-ГЛАВН.", Code: 2004001}
+	vostochny := domain.Station{Name: "МОСКВА ВК ВОСТОЧНЫЙ", Code: 2001025}
+	oktyabrskaya := domain.Station{Name: "МОСКВА ОКТЯБРЬСКАЯ", Code: 2006004}
+	resp := MapTrainRoutesToPb([]domain.TrainRoute{
+		{TrainNumber: "119А", From: from, To: vostochny, ToCity: moscow},
+		{TrainNumber: "755А", From: from, To: oktyabrskaya, ToCity: moscow},
+		{TrainNumber: "021А", From: from, To: vostochny, ToCity: moscow},
+		{TrainNumber: "001А", From: from, To: oktyabrskaya},
+	})
+
+	require.Len(t, resp.Routes, 4)
+	require.True(t, resp.Routes[0].ToCity.City)
+	require.Nil(t, resp.Routes[3].ToCity, "route from the requested station has no city")
+	require.Nil(t, resp.Routes[0].FromCity)
+
+	// Группы по паре станций в порядке первого появления
+	require.Len(t, resp.Groups, 2)
+	require.Equal(t, int32(2001025), resp.Groups[0].To.Code)
+	require.Equal(t, []int32{0, 2}, resp.Groups[0].RouteIndexes)
+	require.Equal(t, int32(2004001), resp.Groups[1].From.Code)
+	require.Equal(t, int32(2006004), resp.Groups[1].To.Code)
+	require.Equal(t, []int32{1, 3}, resp.Groups[1].RouteIndexes)
+
+	require.Empty(t, MapTrainRoutesToPb(nil).Groups)
+}
+
+func TestMapSchemaDriftToPb(t *testing.T) {
+	seen := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+	resp := MapSchemaDriftToPb([]domain.SchemaDriftEvent{{
+		Provider:      "rzd",
+		Endpoint:      "routes",
+		FirstSeen:     seen,
+		LastSeen:      seen.Add(time.Minute),
+		Count:         3,
+		UnknownFields: []string{"tp[].list[].cars[].newField"},
+		MissingFields: []string{"tp[].list[].timeInWay"},
+		ChangedFields: []domain.SchemaFieldChange{{Path: "tp[].list[].cars[].tariff", Reason: `expected number, got string "по запросу"`}},
+		Sample:        `{"result":"OK"`,
+	}})
+
+	require.Len(t, resp.Events, 1)
+	event := resp.Events[0]
+	require.Equal(t, "rzd", event.Provider)
+	require.Equal(t, "routes", event.Endpoint)
+	require.Equal(t, seen, event.FirstSeen.AsTime())
+	require.Equal(t, seen.Add(time.Minute), event.LastSeen.AsTime())
+	require.Equal(t, int32(3), event.Count)
+	require.Equal(t, []string{"tp[].list[].cars[].newField"}, event.UnknownFields)
+	require.Equal(t, []string{"tp[].list[].timeInWay"}, event.MissingFields)
+	require.Len(t, event.ChangedFields, 1)
+	require.Equal(t, "tp[].list[].cars[].tariff", event.ChangedFields[0].Path)
+	require.Equal(t, `expected number, got string "по запросу"`, event.ChangedFields[0].Reason)
+	require.Equal(t, `{"result":"OK"`, event.Sample)
+
+	require.Empty(t, MapSchemaDriftToPb(nil).Events)
+}
+
+func TestParseDateRequest(t *testing.T) {
+	// Время и пояс отбрасываются: остаётся календарная дата по UTC
+	date := ParseDateRequest(timestamppb.New(time.Date(2025, 2, 13, 18, 30, 0, 0, time.UTC)))
+	require.Equal(t, time.Date(2025, 2, 13, 0, 0, 0, 0, time.UTC), date)
+	require.True(t, ParseDateRequest(nil).IsZero())
+}
@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,18 +24,453 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Система кодирования станций (domain.StationCodeType). Коды ЕСР и UIC переводятся в Экспресс-3
+// по таблице соответствия; код ЕСР в числовых полях передаётся с контрольной цифрой (060073 - как 60073).
+type StationCodeType int32
+
+const (
+	StationCodeType_STATION_CODE_TYPE_EXPRESS3 StationCodeType = 0 // Экспресс-3, например 2006004
+	StationCodeType_STATION_CODE_TYPE_ESR      StationCodeType = 1 // ЕСР, например 060073
+	StationCodeType_STATION_CODE_TYPE_UIC      StationCodeType = 2 // UIC
+)
+
+// Enum value maps for StationCodeType.
+var (
+	StationCodeType_name = map[int32]string{
+		0: "STATION_CODE_TYPE_EXPRESS3",
+		1: "STATION_CODE_TYPE_ESR",
+		2: "STATION_CODE_TYPE_UIC",
+	}
+	StationCodeType_value = map[string]int32{
+		"STATION_CODE_TYPE_EXPRESS3": 0,
+		"STATION_CODE_TYPE_ESR":      1,
+		"STATION_CODE_TYPE_UIC":      2,
+	}
+)
+
+func (x StationCodeType) Enum() *StationCodeType {
+	p := new(StationCodeType)
+	*p = x
+	return p
+}
+
+func (x StationCodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StationCodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[0].Descriptor()
+}
+
+func (StationCodeType) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[0]
+}
+
+func (x StationCodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StationCodeType.Descriptor instead.
+func (StationCodeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Направление поездки (domain.Direction)
+type Direction int32
+
+const (
+	Direction_DIRECTION_ONE_WAY Direction = 0 // Только в одну сторону
+	Direction_DIRECTION_RETURN  Direction = 1 // Туда и обратно
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "DIRECTION_ONE_WAY",
+		1: "DIRECTION_RETURN",
+	}
+	Direction_value = map[string]int32{
+		"DIRECTION_ONE_WAY": 0,
+		"DIRECTION_RETURN":  1,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
+}
+
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Direction) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[1]
+}
+
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Тип поезда для поиска (domain.TrainSearchType)
+type TrainSearchType int32
+
+const (
+	TrainSearchType_TRAIN_SEARCH_TYPE_UNSPECIFIED TrainSearchType = 0 // Не указан, трактуется как TRAIN_SEARCH_TYPE_ALL
+	TrainSearchType_TRAIN_SEARCH_TYPE_ALL         TrainSearchType = 1 // Поезда и электрички
+	TrainSearchType_TRAIN_SEARCH_TYPE_TRAINS      TrainSearchType = 2 // Только поезда
+	TrainSearchType_TRAIN_SEARCH_TYPE_ELECTRICS   TrainSearchType = 3 // Только электрички
+)
+
+// Enum value maps for TrainSearchType.
+var (
+	TrainSearchType_name = map[int32]string{
+		0: "TRAIN_SEARCH_TYPE_UNSPECIFIED",
+		1: "TRAIN_SEARCH_TYPE_ALL",
+		2: "TRAIN_SEARCH_TYPE_TRAINS",
+		3: "TRAIN_SEARCH_TYPE_ELECTRICS",
+	}
+	TrainSearchType_value = map[string]int32{
+		"TRAIN_SEARCH_TYPE_UNSPECIFIED": 0,
+		"TRAIN_SEARCH_TYPE_ALL":         1,
+		"TRAIN_SEARCH_TYPE_TRAINS":      2,
+		"TRAIN_SEARCH_TYPE_ELECTRICS":   3,
+	}
+)
+
+func (x TrainSearchType) Enum() *TrainSearchType {
+	p := new(TrainSearchType)
+	*p = x
+	return p
+}
+
+func (x TrainSearchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrainSearchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TrainSearchType) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[2]
+}
+
+func (x TrainSearchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrainSearchType.Descriptor instead.
+func (TrainSearchType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Тип мест в вагоне (domain.CarSeatType)
+type CarSeatType int32
+
+const (
+	CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED CarSeatType = 0
+	CarSeatType_CAR_SEAT_TYPE_PLATZ       CarSeatType = 1 // Плацкарт
+	CarSeatType_CAR_SEAT_TYPE_GENERAL     CarSeatType = 2 // Общий
+	CarSeatType_CAR_SEAT_TYPE_SIDE        CarSeatType = 3 // Сидячий
+	CarSeatType_CAR_SEAT_TYPE_COUPE       CarSeatType = 4 // Купе
+	CarSeatType_CAR_SEAT_TYPE_SOFT        CarSeatType = 5 // Мягкий
+	CarSeatType_CAR_SEAT_TYPE_LUX         CarSeatType = 6 // Люкс (СВ)
+)
+
+// Enum value maps for CarSeatType.
+var (
+	CarSeatType_name = map[int32]string{
+		0: "CAR_SEAT_TYPE_UNSPECIFIED",
+		1: "CAR_SEAT_TYPE_PLATZ",
+		2: "CAR_SEAT_TYPE_GENERAL",
+		3: "CAR_SEAT_TYPE_SIDE",
+		4: "CAR_SEAT_TYPE_COUPE",
+		5: "CAR_SEAT_TYPE_SOFT",
+		6: "CAR_SEAT_TYPE_LUX",
+	}
+	CarSeatType_value = map[string]int32{
+		"CAR_SEAT_TYPE_UNSPECIFIED": 0,
+		"CAR_SEAT_TYPE_PLATZ":       1,
+		"CAR_SEAT_TYPE_GENERAL":     2,
+		"CAR_SEAT_TYPE_SIDE":        3,
+		"CAR_SEAT_TYPE_COUPE":       4,
+		"CAR_SEAT_TYPE_SOFT":        5,
+		"CAR_SEAT_TYPE_LUX":         6,
+	}
+)
+
+func (x CarSeatType) Enum() *CarSeatType {
+	p := new(CarSeatType)
+	*p = x
+	return p
+}
+
+func (x CarSeatType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarSeatType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[3].Descriptor()
+}
+
+func (CarSeatType) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[3]
+}
+
+func (x CarSeatType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarSeatType.Descriptor instead.
+func (CarSeatType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{3}
+}
+
+// Нумерация вагонов (domain.CarNumeration)
+type CarNumeration int32
+
+const (
+	CarNumeration_CAR_NUMERATION_HEAD    CarNumeration = 0 // С головы поезда
+	CarNumeration_CAR_NUMERATION_TAIL    CarNumeration = 1 // С хвоста поезда
+	CarNumeration_CAR_NUMERATION_UNKNOWN CarNumeration = 2 // Неизвестно
+)
+
+// Enum value maps for CarNumeration.
+var (
+	CarNumeration_name = map[int32]string{
+		0: "CAR_NUMERATION_HEAD",
+		1: "CAR_NUMERATION_TAIL",
+		2: "CAR_NUMERATION_UNKNOWN",
+	}
+	CarNumeration_value = map[string]int32{
+		"CAR_NUMERATION_HEAD":    0,
+		"CAR_NUMERATION_TAIL":    1,
+		"CAR_NUMERATION_UNKNOWN": 2,
+	}
+)
+
+func (x CarNumeration) Enum() *CarNumeration {
+	p := new(CarNumeration)
+	*p = x
+	return p
+}
+
+func (x CarNumeration) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarNumeration) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[4].Descriptor()
+}
+
+func (CarNumeration) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[4]
+}
+
+func (x CarNumeration) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarNumeration.Descriptor instead.
+func (CarNumeration) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{4}
+}
+
+// Категория поезда. Значения 0 и 1 совпадают с прежними кодами trainType (поезд/электричка)
+type TrainCategory int32
+
+const (
+	TrainCategory_TRAIN_CATEGORY_LONG_DISTANCE    TrainCategory = 0 // Поезд дальнего следования
+	TrainCategory_TRAIN_CATEGORY_SUBURBAN         TrainCategory = 1 // Пригородный поезд (электричка)
+	TrainCategory_TRAIN_CATEGORY_HIGH_SPEED       TrainCategory = 2 // Скоростной или высокоскоростной поезд
+	TrainCategory_TRAIN_CATEGORY_EXPRESS_SUBURBAN TrainCategory = 3 // Пригородный экспресс
+	TrainCategory_TRAIN_CATEGORY_BUS              TrainCategory = 4 // Автобус
+	TrainCategory_TRAIN_CATEGORY_FERRY            TrainCategory = 5 // Паром
+)
+
+// Enum value maps for TrainCategory.
+var (
+	TrainCategory_name = map[int32]string{
+		0: "TRAIN_CATEGORY_LONG_DISTANCE",
+		1: "TRAIN_CATEGORY_SUBURBAN",
+		2: "TRAIN_CATEGORY_HIGH_SPEED",
+		3: "TRAIN_CATEGORY_EXPRESS_SUBURBAN",
+		4: "TRAIN_CATEGORY_BUS",
+		5: "TRAIN_CATEGORY_FERRY",
+	}
+	TrainCategory_value = map[string]int32{
+		"TRAIN_CATEGORY_LONG_DISTANCE":    0,
+		"TRAIN_CATEGORY_SUBURBAN":         1,
+		"TRAIN_CATEGORY_HIGH_SPEED":       2,
+		"TRAIN_CATEGORY_EXPRESS_SUBURBAN": 3,
+		"TRAIN_CATEGORY_BUS":              4,
+		"TRAIN_CATEGORY_FERRY":            5,
+	}
+)
+
+func (x TrainCategory) Enum() *TrainCategory {
+	p := new(TrainCategory)
+	*p = x
+	return p
+}
+
+func (x TrainCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrainCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[5].Descriptor()
+}
+
+func (TrainCategory) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[5]
+}
+
+func (x TrainCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrainCategory.Descriptor instead.
+func (TrainCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{5}
+}
+
+// Почему предложен альтернативный поезд (domain.AlternativeReason)
+type AlternativeReason int32
+
+const (
+	AlternativeReason_ALTERNATIVE_REASON_UNSPECIFIED   AlternativeReason = 0
+	AlternativeReason_ALTERNATIVE_REASON_ADJACENT_DATE AlternativeReason = 1 // Соседняя дата отправления
+	AlternativeReason_ALTERNATIVE_REASON_CITY_STATION  AlternativeReason = 2 // Другая станция того же города
+	AlternativeReason_ALTERNATIVE_REASON_WITH_CHANGE   AlternativeReason = 3 // Маршрут с пересадкой
+)
+
+// Enum value maps for AlternativeReason.
+var (
+	AlternativeReason_name = map[int32]string{
+		0: "ALTERNATIVE_REASON_UNSPECIFIED",
+		1: "ALTERNATIVE_REASON_ADJACENT_DATE",
+		2: "ALTERNATIVE_REASON_CITY_STATION",
+		3: "ALTERNATIVE_REASON_WITH_CHANGE",
+	}
+	AlternativeReason_value = map[string]int32{
+		"ALTERNATIVE_REASON_UNSPECIFIED":   0,
+		"ALTERNATIVE_REASON_ADJACENT_DATE": 1,
+		"ALTERNATIVE_REASON_CITY_STATION":  2,
+		"ALTERNATIVE_REASON_WITH_CHANGE":   3,
+	}
+)
+
+func (x AlternativeReason) Enum() *AlternativeReason {
+	p := new(AlternativeReason)
+	*p = x
+	return p
+}
+
+func (x AlternativeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlternativeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[6].Descriptor()
+}
+
+func (AlternativeReason) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[6]
+}
+
+func (x AlternativeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlternativeReason.Descriptor instead.
+func (AlternativeReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Вид записи о типе вагона (domain.CarDataType). Ценовые ступени сидячих мест детализируют сводку
+// по типу: их свободные места уже учтены в ней
+type CarDataType int32
+
+const (
+	CarDataType_CAR_DATA_TYPE_UNSPECIFIED CarDataType = 0
+	CarDataType_CAR_DATA_TYPE_SUMMARY     CarDataType = 1 // Сводка по вагонам типа
+	CarDataType_CAR_DATA_TYPE_SEAT_TIER   CarDataType = 2 // Ценовая ступень мест сидячих вагонов
+)
+
+// Enum value maps for CarDataType.
+var (
+	CarDataType_name = map[int32]string{
+		0: "CAR_DATA_TYPE_UNSPECIFIED",
+		1: "CAR_DATA_TYPE_SUMMARY",
+		2: "CAR_DATA_TYPE_SEAT_TIER",
+	}
+	CarDataType_value = map[string]int32{
+		"CAR_DATA_TYPE_UNSPECIFIED": 0,
+		"CAR_DATA_TYPE_SUMMARY":     1,
+		"CAR_DATA_TYPE_SEAT_TIER":   2,
+	}
+)
+
+func (x CarDataType) Enum() *CarDataType {
+	p := new(CarDataType)
+	*p = x
+	return p
+}
+
+func (x CarDataType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarDataType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rzd_rzd_service_proto_enumTypes[7].Descriptor()
+}
+
+func (CarDataType) Type() protoreflect.EnumType {
+	return &file_proto_rzd_rzd_service_proto_enumTypes[7]
+}
+
+func (x CarDataType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarDataType.Descriptor instead.
+func (CarDataType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{7}
+}
+
 // Запрос для получения маршрутов
 type GetTrainRoutesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromCode      int32                  `protobuf:"varint,1,opt,name=fromCode,proto3" json:"fromCode,omitempty"`     // Код станции отправления
-	ToCode        int32                  `protobuf:"varint,2,opt,name=toCode,proto3" json:"toCode,omitempty"`         // Код станции прибытия
-	Direction     int32                  `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`   // 0 – OneWay, 1 – Return
-	TrainType     int32                  `protobuf:"varint,4,opt,name=trainType,proto3" json:"trainType,omitempty"`   // 1 – AllTrains, 2 – Trains, 3 – Electrics
-	CheckSeats    bool                   `protobuf:"varint,5,opt,name=checkSeats,proto3" json:"checkSeats,omitempty"` // Проверять наличие мест
-	FromDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fromDate,proto3" json:"fromDate,omitempty"`      // Дата отправления в формате "DD.MM.YYYY"
-	WithChange    bool                   `protobuf:"varint,7,opt,name=withChange,proto3" json:"withChange,omitempty"` // Флаг пересадок
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromCode        int32                  `protobuf:"varint,1,opt,name=fromCode,proto3" json:"fromCode,omitempty"`                                   // Код станции отправления
+	ToCode          int32                  `protobuf:"varint,2,opt,name=toCode,proto3" json:"toCode,omitempty"`                                       // Код станции прибытия
+	Direction       Direction              `protobuf:"varint,3,opt,name=direction,proto3,enum=rzd.Direction" json:"direction,omitempty"`              // Направление
+	TrainType       TrainSearchType        `protobuf:"varint,4,opt,name=trainType,proto3,enum=rzd.TrainSearchType" json:"trainType,omitempty"`        // Тип поезда для поиска
+	CheckSeats      bool                   `protobuf:"varint,5,opt,name=checkSeats,proto3" json:"checkSeats,omitempty"`                               // Проверять наличие мест
+	FromDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fromDate,proto3" json:"fromDate,omitempty"`                                    // Дата отправления (обязательна, не в прошлом)
+	WithChange      bool                   `protobuf:"varint,7,opt,name=withChange,proto3" json:"withChange,omitempty"`                               // Флаг пересадок
+	Categories      []TrainCategory        `protobuf:"varint,8,rep,packed,name=categories,proto3,enum=rzd.TrainCategory" json:"categories,omitempty"` // Фильтр по категориям поездов; пустой - все категории
+	Lang            string                 `protobuf:"bytes,9,opt,name=lang,proto3" json:"lang,omitempty"`                                            // Язык ответа (ru, en); пустой - язык по умолчанию
+	FromStation     string                 `protobuf:"bytes,10,opt,name=fromStation,proto3" json:"fromStation,omitempty"`                             // Название (или код) станции отправления вместо fromCode
+	ToStation       string                 `protobuf:"bytes,11,opt,name=toStation,proto3" json:"toStation,omitempty"`                                 // Название (или код) станции прибытия вместо toCode
+	CodeType        StationCodeType        `protobuf:"varint,12,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"`         // Система кодов fromCode и toCode
+	Alternatives    bool                   `protobuf:"varint,13,opt,name=alternatives,proto3" json:"alternatives,omitempty"`                          // Режим альтернатив: если поездов с местами нужных типов нет, предложить другие
+	SeatTypes       []CarSeatType          `protobuf:"varint,14,rep,packed,name=seatTypes,proto3,enum=rzd.CarSeatType" json:"seatTypes,omitempty"`    // Нужные типы мест для режима альтернатив; пустой - любые
+	AlternativeDays int32                  `protobuf:"varint,15,opt,name=alternativeDays,proto3" json:"alternativeDays,omitempty"`                    // На сколько дней раньше и позже искать (не больше 3); 0 - 2
+	MaxAlternatives int32                  `protobuf:"varint,16,opt,name=maxAlternatives,proto3" json:"maxAlternatives,omitempty"`                    // Сколько альтернатив вернуть (не больше 50); 0 - 10
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetTrainRoutesRequest) Reset() {
@@ -44,101 +480,2573 @@ func (x *GetTrainRoutesRequest) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrainRoutesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTrainRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainRoutesRequest) ProtoMessage() {}
+
+func (x *GetTrainRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainRoutesRequest.ProtoReflect.Descriptor instead.
+func (*GetTrainRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTrainRoutesRequest) GetFromCode() int32 {
+	if x != nil {
+		return x.FromCode
+	}
+	return 0
+}
+
+func (x *GetTrainRoutesRequest) GetToCode() int32 {
+	if x != nil {
+		return x.ToCode
+	}
+	return 0
+}
+
+func (x *GetTrainRoutesRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_DIRECTION_ONE_WAY
+}
+
+func (x *GetTrainRoutesRequest) GetTrainType() TrainSearchType {
+	if x != nil {
+		return x.TrainType
+	}
+	return TrainSearchType_TRAIN_SEARCH_TYPE_UNSPECIFIED
+}
+
+func (x *GetTrainRoutesRequest) GetCheckSeats() bool {
+	if x != nil {
+		return x.CheckSeats
+	}
+	return false
+}
+
+func (x *GetTrainRoutesRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetTrainRoutesRequest) GetWithChange() bool {
+	if x != nil {
+		return x.WithChange
+	}
+	return false
+}
+
+func (x *GetTrainRoutesRequest) GetCategories() []TrainCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetTrainRoutesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *GetTrainRoutesRequest) GetFromStation() string {
+	if x != nil {
+		return x.FromStation
+	}
+	return ""
+}
+
+func (x *GetTrainRoutesRequest) GetToStation() string {
+	if x != nil {
+		return x.ToStation
+	}
+	return ""
+}
+
+func (x *GetTrainRoutesRequest) GetCodeType() StationCodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
+}
+
+func (x *GetTrainRoutesRequest) GetAlternatives() bool {
+	if x != nil {
+		return x.Alternatives
+	}
+	return false
+}
+
+func (x *GetTrainRoutesRequest) GetSeatTypes() []CarSeatType {
+	if x != nil {
+		return x.SeatTypes
+	}
+	return nil
+}
+
+func (x *GetTrainRoutesRequest) GetAlternativeDays() int32 {
+	if x != nil {
+		return x.AlternativeDays
+	}
+	return 0
+}
+
+func (x *GetTrainRoutesRequest) GetMaxAlternatives() int32 {
+	if x != nil {
+		return x.MaxAlternatives
+	}
+	return 0
+}
+
+// Ответ с маршрутами
+type GetTrainRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*TrainRoute          `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Groups        []*StationGroup        `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`             // Маршруты, сгруппированные по станциям отправления и прибытия
+	Alternatives  []*RouteAlternative    `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"` // Режим альтернатив: только если routes пуст, от лучшей к худшей
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrainRoutesResponse) Reset() {
+	*x = GetTrainRoutesResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainRoutesResponse) ProtoMessage() {}
+
+func (x *GetTrainRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainRoutesResponse.ProtoReflect.Descriptor instead.
+func (*GetTrainRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTrainRoutesResponse) GetRoutes() []*TrainRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *GetTrainRoutesResponse) GetGroups() []*StationGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetTrainRoutesResponse) GetAlternatives() []*RouteAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+// Альтернативный поезд
+type RouteAlternative struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *TrainRoute            `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Reason        AlternativeReason      `protobuf:"varint,2,opt,name=reason,proto3,enum=rzd.AlternativeReason" json:"reason,omitempty"`
+	DaysShift     int32                  `protobuf:"varint,3,opt,name=daysShift,proto3" json:"daysShift,omitempty"` // Сдвиг даты относительно запрошенной, дни (отрицательный - раньше)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteAlternative) Reset() {
+	*x = RouteAlternative{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteAlternative) ProtoMessage() {}
+
+func (x *RouteAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteAlternative.ProtoReflect.Descriptor instead.
+func (*RouteAlternative) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RouteAlternative) GetRoute() *TrainRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteAlternative) GetReason() AlternativeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AlternativeReason_ALTERNATIVE_REASON_UNSPECIFIED
+}
+
+func (x *RouteAlternative) GetDaysShift() int32 {
+	if x != nil {
+		return x.DaysShift
+	}
+	return 0
+}
+
+// Маршруты между одной парой станций (например, от Ленинградского вокзала при запросе города Москва)
+type StationGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *Station               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *Station               `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	RouteIndexes  []int32                `protobuf:"varint,3,rep,packed,name=routeIndexes,proto3" json:"routeIndexes,omitempty"` // Индексы маршрутов в routes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StationGroup) Reset() {
+	*x = StationGroup{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StationGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationGroup) ProtoMessage() {}
+
+func (x *StationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationGroup.ProtoReflect.Descriptor instead.
+func (*StationGroup) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StationGroup) GetFrom() *Station {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StationGroup) GetTo() *Station {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StationGroup) GetRouteIndexes() []int32 {
+	if x != nil {
+		return x.RouteIndexes
+	}
+	return nil
+}
+
+// Модель маршрута
+type TrainRoute struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber     string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	TrainType       TrainCategory          `protobuf:"varint,2,opt,name=trainType,proto3,enum=rzd.TrainCategory" json:"trainType,omitempty"`
+	Departure       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	From            *Station               `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To              *Station               `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	CarTypes        []*CarriageType        `protobuf:"bytes,7,rep,name=carTypes,proto3" json:"carTypes,omitempty"`
+	Duration        *durationpb.Duration   `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`                                    // Время в пути
+	Brand           string                 `protobuf:"bytes,9,opt,name=brand,proto3" json:"brand,omitempty"`                                          // Бренд поезда, например "САПСАН"
+	Carrier         *Carrier               `protobuf:"bytes,10,opt,name=carrier,proto3" json:"carrier,omitempty"`                                     // Перевозчик
+	TrainName       string                 `protobuf:"bytes,11,opt,name=trainName,proto3" json:"trainName,omitempty"`                                 // Собственное название поезда
+	TrainNumber2    string                 `protobuf:"bytes,12,opt,name=trainNumber2,proto3" json:"trainNumber2,omitempty"`                           // Второй (отображаемый) номер поезда
+	Firm            bool                   `protobuf:"varint,13,opt,name=firm,proto3" json:"firm,omitempty"`                                          // Фирменный поезд
+	ElReg           bool                   `protobuf:"varint,14,opt,name=elReg,proto3" json:"elReg,omitempty"`                                        // Электронная регистрация
+	VarPrice        bool                   `protobuf:"varint,15,opt,name=varPrice,proto3" json:"varPrice,omitempty"`                                  // Динамическое ценообразование
+	DeferredPayment bool                   `protobuf:"varint,16,opt,name=deferredPayment,proto3" json:"deferredPayment,omitempty"`                    // Отложенная оплата
+	CarNumeration   CarNumeration          `protobuf:"varint,17,opt,name=carNumeration,proto3,enum=rzd.CarNumeration" json:"carNumeration,omitempty"` // Нумерация вагонов
+	OriginDeparture *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=originDeparture,proto3" json:"originDeparture,omitempty"`                     // Отправление с начальной станции маршрута
+	SaleDepth       int32                  `protobuf:"varint,19,opt,name=saleDepth,proto3" json:"saleDepth,omitempty"`                                // Глубина продажи в сутках
+	Cars            []*Car                 `protobuf:"bytes,20,rep,name=cars,proto3" json:"cars,omitempty"`                                           // Конкретные вагоны (если запрошены)
+	FromCity        *Station               `protobuf:"bytes,21,opt,name=fromCity,proto3" json:"fromCity,omitempty"`                                   // Город из запроса, если поезд идёт от одной из его станций
+	ToCity          *Station               `protobuf:"bytes,22,opt,name=toCity,proto3" json:"toCity,omitempty"`                                       // Город из запроса, если поезд идёт до одной из его станций
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TrainRoute) Reset() {
+	*x = TrainRoute{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainRoute) ProtoMessage() {}
+
+func (x *TrainRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainRoute.ProtoReflect.Descriptor instead.
+func (*TrainRoute) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TrainRoute) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *TrainRoute) GetTrainType() TrainCategory {
+	if x != nil {
+		return x.TrainType
+	}
+	return TrainCategory_TRAIN_CATEGORY_LONG_DISTANCE
+}
+
+func (x *TrainRoute) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetFrom() *Station {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetTo() *Station {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetCarTypes() []*CarriageType {
+	if x != nil {
+		return x.CarTypes
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *TrainRoute) GetCarrier() *Carrier {
+	if x != nil {
+		return x.Carrier
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetTrainName() string {
+	if x != nil {
+		return x.TrainName
+	}
+	return ""
+}
+
+func (x *TrainRoute) GetTrainNumber2() string {
+	if x != nil {
+		return x.TrainNumber2
+	}
+	return ""
+}
+
+func (x *TrainRoute) GetFirm() bool {
+	if x != nil {
+		return x.Firm
+	}
+	return false
+}
+
+func (x *TrainRoute) GetElReg() bool {
+	if x != nil {
+		return x.ElReg
+	}
+	return false
+}
+
+func (x *TrainRoute) GetVarPrice() bool {
+	if x != nil {
+		return x.VarPrice
+	}
+	return false
+}
+
+func (x *TrainRoute) GetDeferredPayment() bool {
+	if x != nil {
+		return x.DeferredPayment
+	}
+	return false
+}
+
+func (x *TrainRoute) GetCarNumeration() CarNumeration {
+	if x != nil {
+		return x.CarNumeration
+	}
+	return CarNumeration_CAR_NUMERATION_HEAD
+}
+
+func (x *TrainRoute) GetOriginDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginDeparture
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetSaleDepth() int32 {
+	if x != nil {
+		return x.SaleDepth
+	}
+	return 0
+}
+
+func (x *TrainRoute) GetCars() []*Car {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetFromCity() *Station {
+	if x != nil {
+		return x.FromCity
+	}
+	return nil
+}
+
+func (x *TrainRoute) GetToCity() *Station {
+	if x != nil {
+		return x.ToCity
+	}
+	return nil
+}
+
+// Станция
+type Station struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	RouteName     string                 `protobuf:"bytes,3,opt,name=routeName,proto3" json:"routeName,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`      // (0-5)
+	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`      // (0-5)
+	TimeZone      string                 `protobuf:"bytes,6,opt,name=timeZone,proto3" json:"timeZone,omitempty"` // Часовой пояс станции (IANA), например "Asia/Yekaterinburg"
+	City          bool                   `protobuf:"varint,7,opt,name=city,proto3" json:"city,omitempty"`        // Город, объединяющий несколько станций (level 5)
+	Location      *GeoPoint              `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"` // Координаты; не заданы, если станции нет в геоданных
+	Region        string                 `protobuf:"bytes,9,opt,name=region,proto3" json:"region,omitempty"`     // Регион
+	EsrCode       string                 `protobuf:"bytes,10,opt,name=esrCode,proto3" json:"esrCode,omitempty"`  // Код ЕСР
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Station) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *Station) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Station) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Station) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Station) GetCity() bool {
+	if x != nil {
+		return x.City
+	}
+	return false
+}
+
+func (x *Station) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Station) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Station) GetEsrCode() string {
+	if x != nil {
+		return x.EsrCode
+	}
+	return ""
+}
+
+// Географические координаты (WGS 84), градусы
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+// Тип вагона (агрегированные данные)
+type CarriageType struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           CarSeatType            `protobuf:"varint,1,opt,name=type,proto3,enum=rzd.CarSeatType" json:"type,omitempty"`         // Тип мест в вагоне
+	TypeShortLabel string                 `protobuf:"bytes,2,opt,name=typeShortLabel,proto3" json:"typeShortLabel,omitempty"`           // Краткое наименование
+	TypeLabel      string                 `protobuf:"bytes,3,opt,name=typeLabel,proto3" json:"typeLabel,omitempty"`                     // Полное наименование
+	Class          string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`                             // Класс вагона (например, "2Ш")
+	Tariff         int32                  `protobuf:"varint,5,opt,name=tariff,proto3" json:"tariff,omitempty"`                          // Стоимость билета
+	TariffExtra    int32                  `protobuf:"varint,6,opt,name=tariffExtra,proto3" json:"tariffExtra,omitempty"`                // Дополнительный тариф
+	FreeSeats      int32                  `protobuf:"varint,7,opt,name=freeSeats,proto3" json:"freeSeats,omitempty"`                    // Свободных мест
+	Disabled       bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`                      // Специальные места для инвалидов
+	DataType       CarDataType            `protobuf:"varint,9,opt,name=dataType,proto3,enum=rzd.CarDataType" json:"dataType,omitempty"` // Сводка по типу вагона или ценовая ступень
+	BonusPoints    int32                  `protobuf:"varint,10,opt,name=bonusPoints,proto3" json:"bonusPoints,omitempty"`               // Баллы «РЖД Бонус» за билет; 0 - неизвестно
+	LastSeats      bool                   `protobuf:"varint,11,opt,name=lastSeats,proto3" json:"lastSeats,omitempty"`                   // Осталось мало мест
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CarriageType) Reset() {
+	*x = CarriageType{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarriageType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarriageType) ProtoMessage() {}
+
+func (x *CarriageType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarriageType.ProtoReflect.Descriptor instead.
+func (*CarriageType) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CarriageType) GetType() CarSeatType {
+	if x != nil {
+		return x.Type
+	}
+	return CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED
+}
+
+func (x *CarriageType) GetTypeShortLabel() string {
+	if x != nil {
+		return x.TypeShortLabel
+	}
+	return ""
+}
+
+func (x *CarriageType) GetTypeLabel() string {
+	if x != nil {
+		return x.TypeLabel
+	}
+	return ""
+}
+
+func (x *CarriageType) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *CarriageType) GetTariff() int32 {
+	if x != nil {
+		return x.Tariff
+	}
+	return 0
+}
+
+func (x *CarriageType) GetTariffExtra() int32 {
+	if x != nil {
+		return x.TariffExtra
+	}
+	return 0
+}
+
+func (x *CarriageType) GetFreeSeats() int32 {
+	if x != nil {
+		return x.FreeSeats
+	}
+	return 0
+}
+
+func (x *CarriageType) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *CarriageType) GetDataType() CarDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return CarDataType_CAR_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *CarriageType) GetBonusPoints() int32 {
+	if x != nil {
+		return x.BonusPoints
+	}
+	return 0
+}
+
+func (x *CarriageType) GetLastSeats() bool {
+	if x != nil {
+		return x.LastSeats
+	}
+	return false
+}
+
+// Запрос для получения информации о вагонах
+type GetTrainCarriagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`                      // Номер поезда (обязателен)
+	Direction     Direction              `protobuf:"varint,2,opt,name=direction,proto3,enum=rzd.Direction" json:"direction,omitempty"`      // Направление
+	FromCode      int32                  `protobuf:"varint,3,opt,name=fromCode,proto3" json:"fromCode,omitempty"`                           // Код станции отправления
+	FromTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fromTime,proto3" json:"fromTime,omitempty"`                            // Время отправления (обязательно, не в прошлом)
+	ToCode        int32                  `protobuf:"varint,6,opt,name=toCode,proto3" json:"toCode,omitempty"`                               // Код станции прибытия
+	Lang          string                 `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`                                    // Язык ответа (ru, en); пустой - язык по умолчанию
+	FromStation   string                 `protobuf:"bytes,8,opt,name=fromStation,proto3" json:"fromStation,omitempty"`                      // Название (или код) станции отправления вместо fromCode
+	ToStation     string                 `protobuf:"bytes,9,opt,name=toStation,proto3" json:"toStation,omitempty"`                          // Название (или код) станции прибытия вместо toCode
+	CodeType      StationCodeType        `protobuf:"varint,10,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"` // Система кодов fromCode и toCode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrainCarriagesRequest) Reset() {
+	*x = GetTrainCarriagesRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainCarriagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainCarriagesRequest) ProtoMessage() {}
+
+func (x *GetTrainCarriagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainCarriagesRequest.ProtoReflect.Descriptor instead.
+func (*GetTrainCarriagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTrainCarriagesRequest) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *GetTrainCarriagesRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_DIRECTION_ONE_WAY
+}
+
+func (x *GetTrainCarriagesRequest) GetFromCode() int32 {
+	if x != nil {
+		return x.FromCode
+	}
+	return 0
+}
+
+func (x *GetTrainCarriagesRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *GetTrainCarriagesRequest) GetToCode() int32 {
+	if x != nil {
+		return x.ToCode
+	}
+	return 0
+}
+
+func (x *GetTrainCarriagesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *GetTrainCarriagesRequest) GetFromStation() string {
+	if x != nil {
+		return x.FromStation
+	}
+	return ""
+}
+
+func (x *GetTrainCarriagesRequest) GetToStation() string {
+	if x != nil {
+		return x.ToStation
+	}
+	return ""
+}
+
+func (x *GetTrainCarriagesRequest) GetCodeType() StationCodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
+}
+
+// Ответ с информацией о вагонах
+type GetTrainCarriagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Carriages     []*Car                 `protobuf:"bytes,1,rep,name=carriages,proto3" json:"carriages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrainCarriagesResponse) Reset() {
+	*x = GetTrainCarriagesResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainCarriagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainCarriagesResponse) ProtoMessage() {}
+
+func (x *GetTrainCarriagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainCarriagesResponse.ProtoReflect.Descriptor instead.
+func (*GetTrainCarriagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTrainCarriagesResponse) GetCarriages() []*Car {
+	if x != nil {
+		return x.Carriages
+	}
+	return nil
+}
+
+// Запрос маршрутов с вагонами (только в одну сторону, без пересадок)
+type GetRoutesWithCarriagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCode      int32                  `protobuf:"varint,1,opt,name=fromCode,proto3" json:"fromCode,omitempty"`                                   // Код станции отправления
+	ToCode        int32                  `protobuf:"varint,2,opt,name=toCode,proto3" json:"toCode,omitempty"`                                       // Код станции прибытия
+	TrainType     TrainSearchType        `protobuf:"varint,3,opt,name=trainType,proto3,enum=rzd.TrainSearchType" json:"trainType,omitempty"`        // Тип поезда для поиска
+	CheckSeats    bool                   `protobuf:"varint,4,opt,name=checkSeats,proto3" json:"checkSeats,omitempty"`                               // Только поезда со свободными местами
+	FromDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`                                    // Дата отправления (обязательна, не в прошлом)
+	Categories    []TrainCategory        `protobuf:"varint,6,rep,packed,name=categories,proto3,enum=rzd.TrainCategory" json:"categories,omitempty"` // Фильтр по категориям поездов; пустой - все категории
+	Lang          string                 `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`                                            // Язык ответа (ru, en); пустой - язык по умолчанию
+	FromStation   string                 `protobuf:"bytes,8,opt,name=fromStation,proto3" json:"fromStation,omitempty"`                              // Название (или код) станции отправления вместо fromCode
+	ToStation     string                 `protobuf:"bytes,9,opt,name=toStation,proto3" json:"toStation,omitempty"`                                  // Название (или код) станции прибытия вместо toCode
+	CodeType      StationCodeType        `protobuf:"varint,10,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"`         // Система кодов fromCode и toCode
+	TrainNumbers  []string               `protobuf:"bytes,11,rep,name=trainNumbers,proto3" json:"trainNumbers,omitempty"`                           // Поезда, для которых нужны вагоны; пустой - все
+	Concurrency   int32                  `protobuf:"varint,12,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                            // Сколько запросов вагонов выполнять одновременно (не больше 8); 0 - 4
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutesWithCarriagesRequest) Reset() {
+	*x = GetRoutesWithCarriagesRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutesWithCarriagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutesWithCarriagesRequest) ProtoMessage() {}
+
+func (x *GetRoutesWithCarriagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutesWithCarriagesRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutesWithCarriagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetFromCode() int32 {
+	if x != nil {
+		return x.FromCode
+	}
+	return 0
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetToCode() int32 {
+	if x != nil {
+		return x.ToCode
+	}
+	return 0
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetTrainType() TrainSearchType {
+	if x != nil {
+		return x.TrainType
+	}
+	return TrainSearchType_TRAIN_SEARCH_TYPE_UNSPECIFIED
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetCheckSeats() bool {
+	if x != nil {
+		return x.CheckSeats
+	}
+	return false
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetCategories() []TrainCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetFromStation() string {
+	if x != nil {
+		return x.FromStation
+	}
+	return ""
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetToStation() string {
+	if x != nil {
+		return x.ToStation
+	}
+	return ""
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetCodeType() StationCodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetTrainNumbers() []string {
+	if x != nil {
+		return x.TrainNumbers
+	}
+	return nil
+}
+
+func (x *GetRoutesWithCarriagesRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+// Маршруты с вагонами (TrainRoute.cars) и поезда, вагоны которых получить не удалось
+type GetRoutesWithCarriagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*TrainRoute          `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Failures      []*CarriagesFailure    `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutesWithCarriagesResponse) Reset() {
+	*x = GetRoutesWithCarriagesResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutesWithCarriagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutesWithCarriagesResponse) ProtoMessage() {}
+
+func (x *GetRoutesWithCarriagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutesWithCarriagesResponse.ProtoReflect.Descriptor instead.
+func (*GetRoutesWithCarriagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRoutesWithCarriagesResponse) GetRoutes() []*TrainRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *GetRoutesWithCarriagesResponse) GetFailures() []*CarriagesFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Ошибка получения вагонов одного поезда
+type CarriagesFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=departure,proto3" json:"departure,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`      // Код статуса gRPC, с которым завершился бы запрос GetTrainCarriages
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // Описание ошибки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CarriagesFailure) Reset() {
+	*x = CarriagesFailure{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarriagesFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarriagesFailure) ProtoMessage() {}
+
+func (x *CarriagesFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarriagesFailure.ProtoReflect.Descriptor instead.
+func (*CarriagesFailure) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CarriagesFailure) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *CarriagesFailure) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *CarriagesFailure) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CarriagesFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Модель вагона (детальная информация)
+type Car struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CarNumber          string                 `protobuf:"bytes,1,opt,name=carNumber,proto3" json:"carNumber,omitempty"`
+	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                             // Тип вагона (например, "Купе", "Плац", "Люкс")
+	CategoryLabelLocal string                 `protobuf:"bytes,3,opt,name=categoryLabelLocal,proto3" json:"categoryLabelLocal,omitempty"` // Категория вагона (например, "Купе")
+	TypeLabel          string                 `protobuf:"bytes,4,opt,name=typeLabel,proto3" json:"typeLabel,omitempty"`                   // Полное наименование типа
+	CategoryCode       string                 `protobuf:"bytes,5,opt,name=categoryCode,proto3" json:"categoryCode,omitempty"`             // Код категории
+	CarTypeId          int32                  `protobuf:"varint,6,opt,name=carTypeId,proto3" json:"carTypeId,omitempty"`                  // Идентификатор категории
+	CarType            int32                  `protobuf:"varint,7,opt,name=carType,proto3" json:"carType,omitempty"`                      // Тип вагона (код)
+	Letter             string                 `protobuf:"bytes,8,opt,name=letter,proto3" json:"letter,omitempty"`                         // Буква вагона
+	ClassType          string                 `protobuf:"bytes,9,opt,name=classType,proto3" json:"classType,omitempty"`                   // Тип класса (например, "2Ш")
+	Tariff             int32                  `protobuf:"varint,10,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	TariffExtra        int32                  `protobuf:"varint,11,opt,name=tariffExtra,proto3" json:"tariffExtra,omitempty"`
+	Carrier            *Carrier               `protobuf:"bytes,12,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	CarNumeration      CarNumeration          `protobuf:"varint,13,opt,name=carNumeration,proto3,enum=rzd.CarNumeration" json:"carNumeration,omitempty"` // Нумерация вагонов
+	Services           []*Service             `protobuf:"bytes,14,rep,name=services,proto3" json:"services,omitempty"`
+	Seats              []*SeatGroup           `protobuf:"bytes,15,rep,name=seats,proto3" json:"seats,omitempty"`                             // Группы свободных мест (если источник их сообщает)
+	SeatType           CarSeatType            `protobuf:"varint,16,opt,name=seatType,proto3,enum=rzd.CarSeatType" json:"seatType,omitempty"` // Тип мест вагона, одинаковый у всех источников
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Car) Reset() {
+	*x = Car{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Car) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Car) ProtoMessage() {}
+
+func (x *Car) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Car.ProtoReflect.Descriptor instead.
+func (*Car) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Car) GetCarNumber() string {
+	if x != nil {
+		return x.CarNumber
+	}
+	return ""
+}
+
+func (x *Car) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Car) GetCategoryLabelLocal() string {
+	if x != nil {
+		return x.CategoryLabelLocal
+	}
+	return ""
+}
+
+func (x *Car) GetTypeLabel() string {
+	if x != nil {
+		return x.TypeLabel
+	}
+	return ""
+}
+
+func (x *Car) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *Car) GetCarTypeId() int32 {
+	if x != nil {
+		return x.CarTypeId
+	}
+	return 0
+}
+
+func (x *Car) GetCarType() int32 {
+	if x != nil {
+		return x.CarType
+	}
+	return 0
+}
+
+func (x *Car) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *Car) GetClassType() string {
+	if x != nil {
+		return x.ClassType
+	}
+	return ""
+}
+
+func (x *Car) GetTariff() int32 {
+	if x != nil {
+		return x.Tariff
+	}
+	return 0
+}
+
+func (x *Car) GetTariffExtra() int32 {
+	if x != nil {
+		return x.TariffExtra
+	}
+	return 0
+}
+
+func (x *Car) GetCarrier() *Carrier {
+	if x != nil {
+		return x.Carrier
+	}
+	return nil
+}
+
+func (x *Car) GetCarNumeration() CarNumeration {
+	if x != nil {
+		return x.CarNumeration
+	}
+	return CarNumeration_CAR_NUMERATION_HEAD
+}
+
+func (x *Car) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Car) GetSeats() []*SeatGroup {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *Car) GetSeatType() CarSeatType {
+	if x != nil {
+		return x.SeatType
+	}
+	return CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED
+}
+
+// Группа свободных мест одного вида в вагоне
+type SeatGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                // Вид места: "dn" (нижнее), "up" (верхнее) и др.
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`              // Наименование, например "Нижнее"
+	Tariff        int32                  `protobuf:"varint,3,opt,name=tariff,proto3" json:"tariff,omitempty"`           // Стоимость места
+	TariffExtra   int32                  `protobuf:"varint,4,opt,name=tariffExtra,proto3" json:"tariffExtra,omitempty"` // Дополнительный тариф
+	Free          int32                  `protobuf:"varint,5,opt,name=free,proto3" json:"free,omitempty"`               // Свободных мест
+	Places        string                 `protobuf:"bytes,6,opt,name=places,proto3" json:"places,omitempty"`            // Номера мест, например "002,010,030-032"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatGroup) Reset() {
+	*x = SeatGroup{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatGroup) ProtoMessage() {}
+
+func (x *SeatGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatGroup.ProtoReflect.Descriptor instead.
+func (*SeatGroup) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SeatGroup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SeatGroup) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatGroup) GetTariff() int32 {
+	if x != nil {
+		return x.Tariff
+	}
+	return 0
+}
+
+func (x *SeatGroup) GetTariffExtra() int32 {
+	if x != nil {
+		return x.TariffExtra
+	}
+	return 0
+}
+
+func (x *SeatGroup) GetFree() int32 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *SeatGroup) GetPlaces() string {
+	if x != nil {
+		return x.Places
+	}
+	return ""
+}
+
+// Модель услуги
+type Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Service) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Перевозчик
+type Carrier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Carrier) Reset() {
+	*x = Carrier{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Carrier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Carrier) ProtoMessage() {}
+
+func (x *Carrier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Carrier.ProtoReflect.Descriptor instead.
+func (*Carrier) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Carrier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Carrier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Запрос для поиска станций
+type SearchStationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CompactMode   bool                   `protobuf:"varint,2,opt,name=compactMode,proto3" json:"compactMode,omitempty"`
+	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"` // Язык ответа (ru, en); пустой - язык по умолчанию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStationRequest) Reset() {
+	*x = SearchStationRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationRequest) ProtoMessage() {}
+
+func (x *SearchStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationRequest.ProtoReflect.Descriptor instead.
+func (*SearchStationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchStationRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchStationRequest) GetCompactMode() bool {
+	if x != nil {
+		return x.CompactMode
+	}
+	return false
+}
+
+func (x *SearchStationRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Ответ для поиска станций
+type SearchStationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stations      []*Station             `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStationResponse) Reset() {
+	*x = SearchStationResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationResponse) ProtoMessage() {}
+
+func (x *SearchStationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationResponse.ProtoReflect.Descriptor instead.
+func (*SearchStationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchStationResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+// Запрос сводки тарифов по направлению на дату
+type GetFareSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromCode       int32                  `protobuf:"varint,1,opt,name=fromCode,proto3" json:"fromCode,omitempty"`                                   // Код станции отправления
+	ToCode         int32                  `protobuf:"varint,2,opt,name=toCode,proto3" json:"toCode,omitempty"`                                       // Код станции прибытия
+	Date           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                            // Дата отправления (обязательна, не в прошлом)
+	TrainType      TrainSearchType        `protobuf:"varint,4,opt,name=trainType,proto3,enum=rzd.TrainSearchType" json:"trainType,omitempty"`        // Тип поезда для поиска
+	Categories     []TrainCategory        `protobuf:"varint,5,rep,packed,name=categories,proto3,enum=rzd.TrainCategory" json:"categories,omitempty"` // Фильтр по категориям поездов; пустой - все категории
+	WithSeatPrices bool                   `protobuf:"varint,6,opt,name=withSeatPrices,proto3" json:"withSeatPrices,omitempty"`                       // Уточнить цены нижних и верхних мест по списку вагонов каждого поезда
+	Lang           string                 `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`                                            // Язык ответа (ru, en); пустой - язык по умолчанию
+	CodeType       StationCodeType        `protobuf:"varint,8,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"`          // Система кодов fromCode и toCode
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFareSummaryRequest) Reset() {
+	*x = GetFareSummaryRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFareSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFareSummaryRequest) ProtoMessage() {}
+
+func (x *GetFareSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFareSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFareSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetFareSummaryRequest) GetFromCode() int32 {
+	if x != nil {
+		return x.FromCode
+	}
+	return 0
+}
+
+func (x *GetFareSummaryRequest) GetToCode() int32 {
+	if x != nil {
+		return x.ToCode
+	}
+	return 0
+}
+
+func (x *GetFareSummaryRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetFareSummaryRequest) GetTrainType() TrainSearchType {
+	if x != nil {
+		return x.TrainType
+	}
+	return TrainSearchType_TRAIN_SEARCH_TYPE_UNSPECIFIED
+}
+
+func (x *GetFareSummaryRequest) GetCategories() []TrainCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetFareSummaryRequest) GetWithSeatPrices() bool {
+	if x != nil {
+		return x.WithSeatPrices
+	}
+	return false
+}
+
+func (x *GetFareSummaryRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *GetFareSummaryRequest) GetCodeType() StationCodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
+}
+
+// Ответ со сводкой тарифов
+type GetFareSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fares         []*FareSummary         `protobuf:"bytes,1,rep,name=fares,proto3" json:"fares,omitempty"` // По одной записи на тип мест
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFareSummaryResponse) Reset() {
+	*x = GetFareSummaryResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFareSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFareSummaryResponse) ProtoMessage() {}
+
+func (x *GetFareSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFareSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFareSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetFareSummaryResponse) GetFares() []*FareSummary {
+	if x != nil {
+		return x.Fares
+	}
+	return nil
+}
+
+// Сводка тарифов по одному типу мест среди всех поездов направления
+type FareSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               CarSeatType            `protobuf:"varint,1,opt,name=type,proto3,enum=rzd.CarSeatType" json:"type,omitempty"`        // Тип мест
+	MinTariff          int32                  `protobuf:"varint,2,opt,name=minTariff,proto3" json:"minTariff,omitempty"`                   // Минимальный тариф
+	MaxTariff          int32                  `protobuf:"varint,3,opt,name=maxTariff,proto3" json:"maxTariff,omitempty"`                   // Максимальный тариф
+	MinTrains          []*FareTrain           `protobuf:"bytes,4,rep,name=minTrains,proto3" json:"minTrains,omitempty"`                    // Поезда с минимальным тарифом
+	MaxTrains          []*FareTrain           `protobuf:"bytes,5,rep,name=maxTrains,proto3" json:"maxTrains,omitempty"`                    // Поезда с максимальным тарифом
+	FreeSeats          int32                  `protobuf:"varint,6,opt,name=freeSeats,proto3" json:"freeSeats,omitempty"`                   // Свободных мест во всех поездах
+	Trains             int32                  `protobuf:"varint,7,opt,name=trains,proto3" json:"trains,omitempty"`                         // Поездов с местами этого типа
+	LowerSeatMinTariff int32                  `protobuf:"varint,8,opt,name=lowerSeatMinTariff,proto3" json:"lowerSeatMinTariff,omitempty"` // Минимальный тариф нижнего места (0 - неизвестно)
+	UpperSeatMinTariff int32                  `protobuf:"varint,9,opt,name=upperSeatMinTariff,proto3" json:"upperSeatMinTariff,omitempty"` // Минимальный тариф верхнего места (0 - неизвестно)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FareSummary) Reset() {
+	*x = FareSummary{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FareSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareSummary) ProtoMessage() {}
+
+func (x *FareSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareSummary.ProtoReflect.Descriptor instead.
+func (*FareSummary) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FareSummary) GetType() CarSeatType {
+	if x != nil {
+		return x.Type
+	}
+	return CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED
+}
+
+func (x *FareSummary) GetMinTariff() int32 {
+	if x != nil {
+		return x.MinTariff
+	}
+	return 0
+}
+
+func (x *FareSummary) GetMaxTariff() int32 {
+	if x != nil {
+		return x.MaxTariff
+	}
+	return 0
+}
+
+func (x *FareSummary) GetMinTrains() []*FareTrain {
+	if x != nil {
+		return x.MinTrains
+	}
+	return nil
+}
+
+func (x *FareSummary) GetMaxTrains() []*FareTrain {
+	if x != nil {
+		return x.MaxTrains
+	}
+	return nil
+}
+
+func (x *FareSummary) GetFreeSeats() int32 {
+	if x != nil {
+		return x.FreeSeats
+	}
+	return 0
+}
+
+func (x *FareSummary) GetTrains() int32 {
+	if x != nil {
+		return x.Trains
+	}
+	return 0
+}
+
+func (x *FareSummary) GetLowerSeatMinTariff() int32 {
+	if x != nil {
+		return x.LowerSeatMinTariff
+	}
+	return 0
+}
+
+func (x *FareSummary) GetUpperSeatMinTariff() int32 {
+	if x != nil {
+		return x.UpperSeatMinTariff
+	}
+	return 0
+}
+
+// Поезд, предлагающий тариф из сводки
+type FareTrain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=departure,proto3" json:"departure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FareTrain) Reset() {
+	*x = FareTrain{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FareTrain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareTrain) ProtoMessage() {}
+
+func (x *FareTrain) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareTrain.ProtoReflect.Descriptor instead.
+func (*FareTrain) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FareTrain) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *FareTrain) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+// Запрос подбора мест для группы
+type RecommendSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCode      int32                  `protobuf:"varint,1,opt,name=fromCode,proto3" json:"fromCode,omitempty"`                               // Код станции отправления
+	ToCode        int32                  `protobuf:"varint,2,opt,name=toCode,proto3" json:"toCode,omitempty"`                                   // Код станции прибытия
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                        // Дата отправления (обязательна, не в прошлом)
+	TrainNumber   string                 `protobuf:"bytes,4,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`                          // Номер поезда; пустой - все поезда направления
+	SeatTypes     []CarSeatType          `protobuf:"varint,5,rep,packed,name=seatTypes,proto3,enum=rzd.CarSeatType" json:"seatTypes,omitempty"` // Допустимые типы вагонов; пустой - любые
+	PartySize     int32                  `protobuf:"varint,6,opt,name=partySize,proto3" json:"partySize,omitempty"`                             // Количество пассажиров
+	Preferences   *SeatPreferences       `protobuf:"bytes,7,opt,name=preferences,proto3" json:"preferences,omitempty"`                          // Пожелания к местам
+	MaxResults    int32                  `protobuf:"varint,8,opt,name=maxResults,proto3" json:"maxResults,omitempty"`                           // Вариантов на поезд; 0 - по умолчанию (5)
+	Lang          string                 `protobuf:"bytes,9,opt,name=lang,proto3" json:"lang,omitempty"`                                        // Язык ответа (ru, en); пустой - язык по умолчанию
+	CodeType      StationCodeType        `protobuf:"varint,10,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"`     // Система кодов fromCode и toCode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendSeatsRequest) Reset() {
+	*x = RecommendSeatsRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendSeatsRequest) ProtoMessage() {}
+
+func (x *RecommendSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendSeatsRequest.ProtoReflect.Descriptor instead.
+func (*RecommendSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RecommendSeatsRequest) GetFromCode() int32 {
+	if x != nil {
+		return x.FromCode
+	}
+	return 0
+}
+
+func (x *RecommendSeatsRequest) GetToCode() int32 {
+	if x != nil {
+		return x.ToCode
+	}
+	return 0
+}
+
+func (x *RecommendSeatsRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *RecommendSeatsRequest) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *RecommendSeatsRequest) GetSeatTypes() []CarSeatType {
+	if x != nil {
+		return x.SeatTypes
+	}
+	return nil
+}
+
+func (x *RecommendSeatsRequest) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *RecommendSeatsRequest) GetPreferences() *SeatPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *RecommendSeatsRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *RecommendSeatsRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *RecommendSeatsRequest) GetCodeType() StationCodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
+}
+
+// Пожелания к местам группы
+type SeatPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LowerOnly       bool                   `protobuf:"varint,1,opt,name=lowerOnly,proto3" json:"lowerOnly,omitempty"`             // Только нижние места
+	SameCompartment bool                   `protobuf:"varint,2,opt,name=sameCompartment,proto3" json:"sameCompartment,omitempty"` // Все места в одном купе
+	AvoidToilet     bool                   `protobuf:"varint,3,opt,name=avoidToilet,proto3" json:"avoidToilet,omitempty"`         // Не в крайних купе у туалета
+	AvoidSide       bool                   `protobuf:"varint,4,opt,name=avoidSide,proto3" json:"avoidSide,omitempty"`             // Без боковых мест
+	SameCar         bool                   `protobuf:"varint,5,opt,name=sameCar,proto3" json:"sameCar,omitempty"`                 // Все места в одном вагоне
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeatPreferences) Reset() {
+	*x = SeatPreferences{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatPreferences) ProtoMessage() {}
+
+func (x *SeatPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatPreferences.ProtoReflect.Descriptor instead.
+func (*SeatPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SeatPreferences) GetLowerOnly() bool {
+	if x != nil {
+		return x.LowerOnly
+	}
+	return false
+}
+
+func (x *SeatPreferences) GetSameCompartment() bool {
+	if x != nil {
+		return x.SameCompartment
+	}
+	return false
+}
+
+func (x *SeatPreferences) GetAvoidToilet() bool {
+	if x != nil {
+		return x.AvoidToilet
+	}
+	return false
+}
+
+func (x *SeatPreferences) GetAvoidSide() bool {
+	if x != nil {
+		return x.AvoidSide
+	}
+	return false
+}
+
+func (x *SeatPreferences) GetSameCar() bool {
+	if x != nil {
+		return x.SameCar
+	}
+	return false
+}
+
+// Ответ с вариантами размещения по поездам
+type RecommendSeatsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Trains        []*TrainSeatRecommendations `protobuf:"bytes,1,rep,name=trains,proto3" json:"trains,omitempty"` // Только поезда, где группа помещается
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendSeatsResponse) Reset() {
+	*x = RecommendSeatsResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendSeatsResponse) ProtoMessage() {}
+
+func (x *RecommendSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendSeatsResponse.ProtoReflect.Descriptor instead.
+func (*RecommendSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecommendSeatsResponse) GetTrains() []*TrainSeatRecommendations {
+	if x != nil {
+		return x.Trains
+	}
+	return nil
+}
+
+// Варианты размещения группы в одном поезде, от лучшего к худшему
+type TrainSeatRecommendations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=departure,proto3" json:"departure,omitempty"`
+	Combinations  []*SeatCombination     `protobuf:"bytes,3,rep,name=combinations,proto3" json:"combinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrainSeatRecommendations) Reset() {
+	*x = TrainSeatRecommendations{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainSeatRecommendations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainSeatRecommendations) ProtoMessage() {}
+
+func (x *TrainSeatRecommendations) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainSeatRecommendations.ProtoReflect.Descriptor instead.
+func (*TrainSeatRecommendations) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TrainSeatRecommendations) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *TrainSeatRecommendations) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *TrainSeatRecommendations) GetCombinations() []*SeatCombination {
+	if x != nil {
+		return x.Combinations
+	}
+	return nil
+}
+
+// Вариант размещения группы
+type SeatCombination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seats         []*RecommendedSeat     `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	TotalTariff   int32                  `protobuf:"varint,2,opt,name=totalTariff,proto3" json:"totalTariff,omitempty"`   // Суммарная стоимость
+	Compartments  int32                  `protobuf:"varint,3,opt,name=compartments,proto3" json:"compartments,omitempty"` // Сколько купе занимает группа
+	Cars          int32                  `protobuf:"varint,4,opt,name=cars,proto3" json:"cars,omitempty"`                 // В скольких вагонах находятся места
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatCombination) Reset() {
+	*x = SeatCombination{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatCombination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatCombination) ProtoMessage() {}
+
+func (x *SeatCombination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatCombination.ProtoReflect.Descriptor instead.
+func (*SeatCombination) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SeatCombination) GetSeats() []*RecommendedSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *SeatCombination) GetTotalTariff() int32 {
+	if x != nil {
+		return x.TotalTariff
+	}
+	return 0
+}
+
+func (x *SeatCombination) GetCompartments() int32 {
+	if x != nil {
+		return x.Compartments
+	}
+	return 0
+}
+
+func (x *SeatCombination) GetCars() int32 {
+	if x != nil {
+		return x.Cars
+	}
+	return 0
+}
+
+// Место в варианте размещения
+type RecommendedSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarNumber     string                 `protobuf:"bytes,1,opt,name=carNumber,proto3" json:"carNumber,omitempty"`
+	CarType       CarSeatType            `protobuf:"varint,2,opt,name=carType,proto3,enum=rzd.CarSeatType" json:"carType,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`           // Номер места
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`              // Обозначение места от РЖД, например "014С"
+	Compartment   int32                  `protobuf:"varint,5,opt,name=compartment,proto3" json:"compartment,omitempty"` // Номер купе в вагоне
+	Lower         bool                   `protobuf:"varint,6,opt,name=lower,proto3" json:"lower,omitempty"`             // Нижнее место
+	Side          bool                   `protobuf:"varint,7,opt,name=side,proto3" json:"side,omitempty"`               // Боковое место
+	NearToilet    bool                   `protobuf:"varint,8,opt,name=nearToilet,proto3" json:"nearToilet,omitempty"`   // В крайнем купе, рядом с туалетом
+	Tariff        int32                  `protobuf:"varint,9,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendedSeat) Reset() {
+	*x = RecommendedSeat{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendedSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendedSeat) ProtoMessage() {}
+
+func (x *RecommendedSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendedSeat.ProtoReflect.Descriptor instead.
+func (*RecommendedSeat) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecommendedSeat) GetCarNumber() string {
+	if x != nil {
+		return x.CarNumber
+	}
+	return ""
+}
+
+func (x *RecommendedSeat) GetCarType() CarSeatType {
+	if x != nil {
+		return x.CarType
+	}
+	return CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED
+}
+
+func (x *RecommendedSeat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
 }
 
-func (*GetTrainRoutesRequest) ProtoMessage() {}
-
-func (x *GetTrainRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[0]
+func (x *RecommendedSeat) GetLabel() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Label
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetTrainRoutesRequest.ProtoReflect.Descriptor instead.
-func (*GetTrainRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{0}
+func (x *RecommendedSeat) GetCompartment() int32 {
+	if x != nil {
+		return x.Compartment
+	}
+	return 0
 }
 
-func (x *GetTrainRoutesRequest) GetFromCode() int32 {
+func (x *RecommendedSeat) GetLower() bool {
 	if x != nil {
-		return x.FromCode
+		return x.Lower
 	}
-	return 0
+	return false
 }
 
-func (x *GetTrainRoutesRequest) GetToCode() int32 {
+func (x *RecommendedSeat) GetSide() bool {
 	if x != nil {
-		return x.ToCode
+		return x.Side
 	}
-	return 0
+	return false
 }
 
-func (x *GetTrainRoutesRequest) GetDirection() int32 {
+func (x *RecommendedSeat) GetNearToilet() bool {
 	if x != nil {
-		return x.Direction
+		return x.NearToilet
 	}
-	return 0
+	return false
 }
 
-func (x *GetTrainRoutesRequest) GetTrainType() int32 {
+func (x *RecommendedSeat) GetTariff() int32 {
 	if x != nil {
-		return x.TrainType
+		return x.Tariff
 	}
 	return 0
 }
 
-func (x *GetTrainRoutesRequest) GetCheckSeats() bool {
+// Запрос последних расхождений ответов РЖД с контрактом схем
+type GetSchemaDriftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // Эндпоинт РЖД: routes, carriages, suggester, schedule; пустой - все
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`      // Максимальное количество событий; 0 - все сохранённые
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaDriftRequest) Reset() {
+	*x = GetSchemaDriftRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaDriftRequest) ProtoMessage() {}
+
+func (x *GetSchemaDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[29]
 	if x != nil {
-		return x.CheckSeats
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetTrainRoutesRequest) GetFromDate() *timestamppb.Timestamp {
+// Deprecated: Use GetSchemaDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaDriftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSchemaDriftRequest) GetEndpoint() string {
 	if x != nil {
-		return x.FromDate
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTrainRoutesRequest) GetWithChange() bool {
+func (x *GetSchemaDriftRequest) GetLimit() int32 {
 	if x != nil {
-		return x.WithChange
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-// Ответ с маршрутами
-type GetTrainRoutesResponse struct {
+// Ответ с расхождениями, от последнего к первому
+type GetSchemaDriftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Routes        []*TrainRoute          `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Events        []*SchemaDriftEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTrainRoutesResponse) Reset() {
-	*x = GetTrainRoutesResponse{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[1]
+func (x *GetSchemaDriftResponse) Reset() {
+	*x = GetSchemaDriftResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrainRoutesResponse) String() string {
+func (x *GetSchemaDriftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTrainRoutesResponse) ProtoMessage() {}
+func (*GetSchemaDriftResponse) ProtoMessage() {}
 
-func (x *GetTrainRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[1]
+func (x *GetSchemaDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,47 +3057,49 @@ func (x *GetTrainRoutesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTrainRoutesResponse.ProtoReflect.Descriptor instead.
-func (*GetTrainRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetSchemaDriftResponse.ProtoReflect.Descriptor instead.
+func (*GetSchemaDriftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetTrainRoutesResponse) GetRoutes() []*TrainRoute {
+func (x *GetSchemaDriftResponse) GetEvents() []*SchemaDriftEvent {
 	if x != nil {
-		return x.Routes
+		return x.Events
 	}
 	return nil
 }
 
-// Модель маршрута
-type TrainRoute struct {
+// Одинаковые расхождения ответов эндпоинта, объединённые в одно событие
+type SchemaDriftEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
-	TrainType     int32                  `protobuf:"varint,2,opt,name=trainType,proto3" json:"trainType,omitempty"`
-	Departure     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`
-	Arrival       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=arrival,proto3" json:"arrival,omitempty"`
-	From          *Station               `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	To            *Station               `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	CarTypes      []*CarriageType        `protobuf:"bytes,7,rep,name=carTypes,proto3" json:"carTypes,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=firstSeen,proto3" json:"firstSeen,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`                // Количество ответов с такими расхождениями
+	UnknownFields []string               `protobuf:"bytes,6,rep,name=unknownFields,proto3" json:"unknownFields,omitempty"` // Поля ответа, которых нет в схеме
+	MissingFields []string               `protobuf:"bytes,7,rep,name=missingFields,proto3" json:"missingFields,omitempty"` // Обязательные поля, которых нет в ответе
+	ChangedFields []*SchemaFieldChange   `protobuf:"bytes,8,rep,name=changedFields,proto3" json:"changedFields,omitempty"` // Поля, значение которых не соответствует типу
+	Sample        string                 `protobuf:"bytes,9,opt,name=sample,proto3" json:"sample,omitempty"`               // Начало последнего ответа с расхождениями
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrainRoute) Reset() {
-	*x = TrainRoute{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[2]
+func (x *SchemaDriftEvent) Reset() {
+	*x = SchemaDriftEvent{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrainRoute) String() string {
+func (x *SchemaDriftEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrainRoute) ProtoMessage() {}
+func (*SchemaDriftEvent) ProtoMessage() {}
 
-func (x *TrainRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[2]
+func (x *SchemaDriftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,87 +3110,98 @@ func (x *TrainRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrainRoute.ProtoReflect.Descriptor instead.
-func (*TrainRoute) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SchemaDriftEvent.ProtoReflect.Descriptor instead.
+func (*SchemaDriftEvent) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *TrainRoute) GetTrainNumber() string {
+func (x *SchemaDriftEvent) GetProvider() string {
 	if x != nil {
-		return x.TrainNumber
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *TrainRoute) GetTrainType() int32 {
+func (x *SchemaDriftEvent) GetEndpoint() string {
 	if x != nil {
-		return x.TrainType
+		return x.Endpoint
 	}
-	return 0
+	return ""
 }
 
-func (x *TrainRoute) GetDeparture() *timestamppb.Timestamp {
+func (x *SchemaDriftEvent) GetFirstSeen() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Departure
+		return x.FirstSeen
 	}
 	return nil
 }
 
-func (x *TrainRoute) GetArrival() *timestamppb.Timestamp {
+func (x *SchemaDriftEvent) GetLastSeen() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Arrival
+		return x.LastSeen
 	}
 	return nil
 }
 
-func (x *TrainRoute) GetFrom() *Station {
+func (x *SchemaDriftEvent) GetCount() int32 {
 	if x != nil {
-		return x.From
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SchemaDriftEvent) GetUnknownFields() []string {
+	if x != nil {
+		return x.UnknownFields
 	}
 	return nil
 }
 
-func (x *TrainRoute) GetTo() *Station {
+func (x *SchemaDriftEvent) GetMissingFields() []string {
 	if x != nil {
-		return x.To
+		return x.MissingFields
 	}
 	return nil
 }
 
-func (x *TrainRoute) GetCarTypes() []*CarriageType {
+func (x *SchemaDriftEvent) GetChangedFields() []*SchemaFieldChange {
 	if x != nil {
-		return x.CarTypes
+		return x.ChangedFields
 	}
 	return nil
 }
 
-// Станция
-type Station struct {
+func (x *SchemaDriftEvent) GetSample() string {
+	if x != nil {
+		return x.Sample
+	}
+	return ""
+}
+
+// Поле ответа, тип значения которого изменился
+type SchemaFieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	RouteName     string                 `protobuf:"bytes,3,opt,name=routeName,proto3" json:"routeName,omitempty"`
-	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"` // (0-5)
-	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"` // (0-5)
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Например, "tp[].list[].cars[].tariff"
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Station) Reset() {
-	*x = Station{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[3]
+func (x *SchemaFieldChange) Reset() {
+	*x = SchemaFieldChange{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Station) String() string {
+func (x *SchemaFieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Station) ProtoMessage() {}
+func (*SchemaFieldChange) ProtoMessage() {}
 
-func (x *Station) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[3]
+func (x *SchemaFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,76 +3212,50 @@ func (x *Station) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Station.ProtoReflect.Descriptor instead.
-func (*Station) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SchemaFieldChange.ProtoReflect.Descriptor instead.
+func (*SchemaFieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Station) GetName() string {
+func (x *SchemaFieldChange) GetPath() string {
 	if x != nil {
-		return x.Name
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Station) GetCode() int32 {
-	if x != nil {
-		return x.Code
-	}
-	return 0
-}
-
-func (x *Station) GetRouteName() string {
+func (x *SchemaFieldChange) GetReason() string {
 	if x != nil {
-		return x.RouteName
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Station) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-func (x *Station) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-// Тип вагона (агрегированные данные)
-type CarriageType struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Type           int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`                    // Тип места (например, 1 для Platz, 2 для Coupe и пр.)
-	TypeShortLabel string                 `protobuf:"bytes,2,opt,name=typeShortLabel,proto3" json:"typeShortLabel,omitempty"` // Краткое наименование
-	TypeLabel      string                 `protobuf:"bytes,3,opt,name=typeLabel,proto3" json:"typeLabel,omitempty"`           // Полное наименование
-	Class          string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`                   // Класс вагона (например, "2Ш")
-	Tariff         int32                  `protobuf:"varint,5,opt,name=tariff,proto3" json:"tariff,omitempty"`                // Стоимость билета
-	TariffExtra    int32                  `protobuf:"varint,6,opt,name=tariffExtra,proto3" json:"tariffExtra,omitempty"`      // Дополнительный тариф
-	FreeSeats      int32                  `protobuf:"varint,7,opt,name=freeSeats,proto3" json:"freeSeats,omitempty"`          // Свободных мест
-	Disabled       bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`            // Специальные места для инвалидов
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Запрос поиска поезда по номеру
+type FindTrainByNumberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"` // Номер поезда, например "119А" (обязателен)
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`               // Дата отправления с начальной станции маршрута (обязательна)
+	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`               // Язык ответа (ru, en); пустой - язык по умолчанию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CarriageType) Reset() {
-	*x = CarriageType{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[4]
+func (x *FindTrainByNumberRequest) Reset() {
+	*x = FindTrainByNumberRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CarriageType) String() string {
+func (x *FindTrainByNumberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CarriageType) ProtoMessage() {}
+func (*FindTrainByNumberRequest) ProtoMessage() {}
 
-func (x *CarriageType) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[4]
+func (x *FindTrainByNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,94 +3266,112 @@ func (x *CarriageType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CarriageType.ProtoReflect.Descriptor instead.
-func (*CarriageType) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FindTrainByNumberRequest.ProtoReflect.Descriptor instead.
+func (*FindTrainByNumberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CarriageType) GetType() int32 {
+func (x *FindTrainByNumberRequest) GetTrainNumber() string {
 	if x != nil {
-		return x.Type
+		return x.TrainNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *CarriageType) GetTypeShortLabel() string {
+func (x *FindTrainByNumberRequest) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TypeShortLabel
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-func (x *CarriageType) GetTypeLabel() string {
+func (x *FindTrainByNumberRequest) GetLang() string {
 	if x != nil {
-		return x.TypeLabel
+		return x.Lang
 	}
 	return ""
 }
 
-func (x *CarriageType) GetClass() string {
-	if x != nil {
-		return x.Class
-	}
-	return ""
+// Ответ с маршрутом поезда и его остановками
+type FindTrainByNumberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *TrainRoute            `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"` // От начальной до конечной станции маршрута
+	Stops         []*TrainStop           `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"` // Остановки по порядку следования
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CarriageType) GetTariff() int32 {
-	if x != nil {
-		return x.Tariff
-	}
-	return 0
+func (x *FindTrainByNumberResponse) Reset() {
+	*x = FindTrainByNumberResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CarriageType) GetTariffExtra() int32 {
+func (x *FindTrainByNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTrainByNumberResponse) ProtoMessage() {}
+
+func (x *FindTrainByNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[34]
 	if x != nil {
-		return x.TariffExtra
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CarriageType) GetFreeSeats() int32 {
+// Deprecated: Use FindTrainByNumberResponse.ProtoReflect.Descriptor instead.
+func (*FindTrainByNumberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindTrainByNumberResponse) GetRoute() *TrainRoute {
 	if x != nil {
-		return x.FreeSeats
+		return x.Route
 	}
-	return 0
+	return nil
 }
 
-func (x *CarriageType) GetDisabled() bool {
+func (x *FindTrainByNumberResponse) GetStops() []*TrainStop {
 	if x != nil {
-		return x.Disabled
+		return x.Stops
 	}
-	return false
+	return nil
 }
 
-// Запрос для получения информации о вагонах
-type GetTrainCarriagesRequest struct {
+// Остановка поезда на маршруте
+type TrainStop struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TrainNumber   string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
-	Direction     int32                  `protobuf:"varint,2,opt,name=direction,proto3" json:"direction,omitempty"`
-	FromCode      int32                  `protobuf:"varint,3,opt,name=fromCode,proto3" json:"fromCode,omitempty"`
-	FromTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fromTime,proto3" json:"fromTime,omitempty"`
-	ToCode        int32                  `protobuf:"varint,6,opt,name=toCode,proto3" json:"toCode,omitempty"`
+	Station       *Station               `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Arrival       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=arrival,proto3" json:"arrival,omitempty"`          // Прибытие; не задано для начальной станции
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`      // Отправление; не задано для конечной станции
+	StayMinutes   int32                  `protobuf:"varint,4,opt,name=stayMinutes,proto3" json:"stayMinutes,omitempty"` // Стоянка, минуты
+	Distance      int32                  `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`       // Расстояние от начальной станции, км
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTrainCarriagesRequest) Reset() {
-	*x = GetTrainCarriagesRequest{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[5]
+func (x *TrainStop) Reset() {
+	*x = TrainStop{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrainCarriagesRequest) String() string {
+func (x *TrainStop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTrainCarriagesRequest) ProtoMessage() {}
+func (*TrainStop) ProtoMessage() {}
 
-func (x *GetTrainCarriagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[5]
+func (x *TrainStop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,69 +3382,70 @@ func (x *GetTrainCarriagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTrainCarriagesRequest.ProtoReflect.Descriptor instead.
-func (*GetTrainCarriagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TrainStop.ProtoReflect.Descriptor instead.
+func (*TrainStop) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetTrainCarriagesRequest) GetTrainNumber() string {
+func (x *TrainStop) GetStation() *Station {
 	if x != nil {
-		return x.TrainNumber
+		return x.Station
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTrainCarriagesRequest) GetDirection() int32 {
+func (x *TrainStop) GetArrival() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Direction
+		return x.Arrival
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTrainCarriagesRequest) GetFromCode() int32 {
+func (x *TrainStop) GetDeparture() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromCode
+		return x.Departure
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTrainCarriagesRequest) GetFromTime() *timestamppb.Timestamp {
+func (x *TrainStop) GetStayMinutes() int32 {
 	if x != nil {
-		return x.FromTime
+		return x.StayMinutes
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTrainCarriagesRequest) GetToCode() int32 {
+func (x *TrainStop) GetDistance() int32 {
 	if x != nil {
-		return x.ToCode
+		return x.Distance
 	}
 	return 0
 }
 
-// Ответ с информацией о вагонах
-type GetTrainCarriagesResponse struct {
+// Запрос станций города
+type GetCityStationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Carriages     []*Car                 `protobuf:"bytes,1,rep,name=carriages,proto3" json:"carriages,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Название города, например "Москва" (обязательно)
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`   // Язык ответа (ru, en); пустой - язык по умолчанию
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTrainCarriagesResponse) Reset() {
-	*x = GetTrainCarriagesResponse{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[6]
+func (x *GetCityStationsRequest) Reset() {
+	*x = GetCityStationsRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrainCarriagesResponse) String() string {
+func (x *GetCityStationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTrainCarriagesResponse) ProtoMessage() {}
+func (*GetCityStationsRequest) ProtoMessage() {}
 
-func (x *GetTrainCarriagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[6]
+func (x *GetCityStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,54 +3456,49 @@ func (x *GetTrainCarriagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTrainCarriagesResponse.ProtoReflect.Descriptor instead.
-func (*GetTrainCarriagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetCityStationsRequest.ProtoReflect.Descriptor instead.
+func (*GetCityStationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetTrainCarriagesResponse) GetCarriages() []*Car {
+func (x *GetCityStationsRequest) GetQuery() string {
 	if x != nil {
-		return x.Carriages
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-// Модель вагона (детальная информация)
-type Car struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	CarNumber          string                 `protobuf:"bytes,1,opt,name=carNumber,proto3" json:"carNumber,omitempty"`
-	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                             // Тип вагона (например, "Купе", "Плац", "Люкс")
-	CategoryLabelLocal string                 `protobuf:"bytes,3,opt,name=categoryLabelLocal,proto3" json:"categoryLabelLocal,omitempty"` // Категория вагона (например, "Купе")
-	TypeLabel          string                 `protobuf:"bytes,4,opt,name=typeLabel,proto3" json:"typeLabel,omitempty"`                   // Полное наименование типа
-	CategoryCode       string                 `protobuf:"bytes,5,opt,name=categoryCode,proto3" json:"categoryCode,omitempty"`             // Код категории
-	CarTypeId          int32                  `protobuf:"varint,6,opt,name=carTypeId,proto3" json:"carTypeId,omitempty"`                  // Идентификатор категории
-	CarType            int32                  `protobuf:"varint,7,opt,name=carType,proto3" json:"carType,omitempty"`                      // Тип вагона (код)
-	Letter             string                 `protobuf:"bytes,8,opt,name=letter,proto3" json:"letter,omitempty"`                         // Буква вагона
-	ClassType          string                 `protobuf:"bytes,9,opt,name=classType,proto3" json:"classType,omitempty"`                   // Тип класса (например, "2Ш")
-	Tariff             int32                  `protobuf:"varint,10,opt,name=tariff,proto3" json:"tariff,omitempty"`
-	TariffExtra        int32                  `protobuf:"varint,11,opt,name=tariffExtra,proto3" json:"tariffExtra,omitempty"`
-	Carrier            *Carrier               `protobuf:"bytes,12,opt,name=carrier,proto3" json:"carrier,omitempty"`
-	CarNumeration      int32                  `protobuf:"varint,13,opt,name=carNumeration,proto3" json:"carNumeration,omitempty"` // 0 - Head, 1 - Tail, 2 - Unknown
-	Services           []*Service             `protobuf:"bytes,14,rep,name=services,proto3" json:"services,omitempty"`            // Пропущены поля мест – они могут быть добавлены позже
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *GetCityStationsRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
 }
 
-func (x *Car) Reset() {
-	*x = Car{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[7]
+// Город и его станции
+type GetCityStationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          *Station               `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Stations      []*Station             `protobuf:"bytes,2,rep,name=stations,proto3" json:"stations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCityStationsResponse) Reset() {
+	*x = GetCityStationsResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Car) String() string {
+func (x *GetCityStationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Car) ProtoMessage() {}
+func (*GetCityStationsResponse) ProtoMessage() {}
 
-func (x *Car) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[7]
+func (x *GetCityStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,134 +3509,117 @@ func (x *Car) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Car.ProtoReflect.Descriptor instead.
-func (*Car) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Car) GetCarNumber() string {
-	if x != nil {
-		return x.CarNumber
-	}
-	return ""
+// Deprecated: Use GetCityStationsResponse.ProtoReflect.Descriptor instead.
+func (*GetCityStationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Car) GetType() string {
+func (x *GetCityStationsResponse) GetCity() *Station {
 	if x != nil {
-		return x.Type
+		return x.City
 	}
-	return ""
+	return nil
 }
 
-func (x *Car) GetCategoryLabelLocal() string {
+func (x *GetCityStationsResponse) GetStations() []*Station {
 	if x != nil {
-		return x.CategoryLabelLocal
+		return x.Stations
 	}
-	return ""
+	return nil
 }
 
-func (x *Car) GetTypeLabel() string {
-	if x != nil {
-		return x.TypeLabel
-	}
-	return ""
+// Запрос станций рядом с точкой
+type GetNearbyStationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`           // Широта центра поиска
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`           // Долгота центра поиска
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"` // Радиус поиска, км (больше 0, не больше 500)
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`        // Максимальное количество станций (не больше 100); 0 - 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Car) GetCategoryCode() string {
-	if x != nil {
-		return x.CategoryCode
-	}
-	return ""
+func (x *GetNearbyStationsRequest) Reset() {
+	*x = GetNearbyStationsRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Car) GetCarTypeId() int32 {
-	if x != nil {
-		return x.CarTypeId
-	}
-	return 0
+func (x *GetNearbyStationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Car) GetCarType() int32 {
-	if x != nil {
-		return x.CarType
-	}
-	return 0
-}
+func (*GetNearbyStationsRequest) ProtoMessage() {}
 
-func (x *Car) GetLetter() string {
+func (x *GetNearbyStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[38]
 	if x != nil {
-		return x.Letter
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Car) GetClassType() string {
-	if x != nil {
-		return x.ClassType
-	}
-	return ""
+// Deprecated: Use GetNearbyStationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyStationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Car) GetTariff() int32 {
+func (x *GetNearbyStationsRequest) GetLat() float64 {
 	if x != nil {
-		return x.Tariff
+		return x.Lat
 	}
 	return 0
 }
 
-func (x *Car) GetTariffExtra() int32 {
+func (x *GetNearbyStationsRequest) GetLon() float64 {
 	if x != nil {
-		return x.TariffExtra
+		return x.Lon
 	}
 	return 0
 }
 
-func (x *Car) GetCarrier() *Carrier {
-	if x != nil {
-		return x.Carrier
-	}
-	return nil
-}
-
-func (x *Car) GetCarNumeration() int32 {
+func (x *GetNearbyStationsRequest) GetRadiusKm() float64 {
 	if x != nil {
-		return x.CarNumeration
+		return x.RadiusKm
 	}
 	return 0
 }
 
-func (x *Car) GetServices() []*Service {
+func (x *GetNearbyStationsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Services
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Модель услуги
-type Service struct {
+// Ответ со станциями рядом с точкой
+type GetNearbyStationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Stations      []*NearbyStation       `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Service) Reset() {
-	*x = Service{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[8]
+func (x *GetNearbyStationsResponse) Reset() {
+	*x = GetNearbyStationsResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Service) String() string {
+func (x *GetNearbyStationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*GetNearbyStationsResponse) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[8]
+func (x *GetNearbyStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,56 +3630,42 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Service) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Service) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetNearbyStationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyStationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Service) GetDescription() string {
+func (x *GetNearbyStationsResponse) GetStations() []*NearbyStation {
 	if x != nil {
-		return x.Description
+		return x.Stations
 	}
-	return ""
+	return nil
 }
 
-// Перевозчик
-type Carrier struct {
+// Станция и расстояние до неё от центра поиска
+type NearbyStation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Station       *Station               `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distanceKm,proto3" json:"distanceKm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Carrier) Reset() {
-	*x = Carrier{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[9]
+func (x *NearbyStation) Reset() {
+	*x = NearbyStation{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Carrier) String() string {
+func (x *NearbyStation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Carrier) ProtoMessage() {}
+func (*NearbyStation) ProtoMessage() {}
 
-func (x *Carrier) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[9]
+func (x *NearbyStation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,50 +3676,49 @@ func (x *Carrier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Carrier.ProtoReflect.Descriptor instead.
-func (*Carrier) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use NearbyStation.ProtoReflect.Descriptor instead.
+func (*NearbyStation) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Carrier) GetId() string {
+func (x *NearbyStation) GetStation() *Station {
 	if x != nil {
-		return x.Id
+		return x.Station
 	}
-	return ""
+	return nil
 }
 
-func (x *Carrier) GetName() string {
+func (x *NearbyStation) GetDistanceKm() float64 {
 	if x != nil {
-		return x.Name
+		return x.DistanceKm
 	}
-	return ""
+	return 0
 }
 
-// Запрос для поиска станций
-type SearchStationRequest struct {
+// Запрос кодов станции
+type LookupStationCodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	CompactMode   bool                   `protobuf:"varint,2,opt,name=compactMode,proto3" json:"compactMode,omitempty"`
-	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
+	CodeType      StationCodeType        `protobuf:"varint,1,opt,name=codeType,proto3,enum=rzd.StationCodeType" json:"codeType,omitempty"` // Система, в которой задан код
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                   // Код станции (обязателен); код ЕСР - пять цифр или шесть с контрольной
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStationRequest) Reset() {
-	*x = SearchStationRequest{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[10]
+func (x *LookupStationCodesRequest) Reset() {
+	*x = LookupStationCodesRequest{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStationRequest) String() string {
+func (x *LookupStationCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStationRequest) ProtoMessage() {}
+func (*LookupStationCodesRequest) ProtoMessage() {}
 
-func (x *SearchStationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[10]
+func (x *LookupStationCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,55 +3729,51 @@ func (x *SearchStationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStationRequest.ProtoReflect.Descriptor instead.
-func (*SearchStationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *SearchStationRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+// Deprecated: Use LookupStationCodesRequest.ProtoReflect.Descriptor instead.
+func (*LookupStationCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SearchStationRequest) GetCompactMode() bool {
+func (x *LookupStationCodesRequest) GetCodeType() StationCodeType {
 	if x != nil {
-		return x.CompactMode
+		return x.CodeType
 	}
-	return false
+	return StationCodeType_STATION_CODE_TYPE_EXPRESS3
 }
 
-func (x *SearchStationRequest) GetLang() string {
+func (x *LookupStationCodesRequest) GetCode() string {
 	if x != nil {
-		return x.Lang
+		return x.Code
 	}
 	return ""
 }
 
-// Ответ для поиска станций
-type SearchStationResponse struct {
+// Коды станции в разных системах
+type LookupStationCodesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stations      []*Station             `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+	Express3Code  int32                  `protobuf:"varint,1,opt,name=express3Code,proto3" json:"express3Code,omitempty"` // Код Экспресс-3
+	EsrCode       string                 `protobuf:"bytes,2,opt,name=esrCode,proto3" json:"esrCode,omitempty"`            // Код ЕСР; пустой - неизвестен
+	UicCode       string                 `protobuf:"bytes,3,opt,name=uicCode,proto3" json:"uicCode,omitempty"`            // Код UIC; пустой - неизвестен
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                  // Название из таблицы соответствия
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStationResponse) Reset() {
-	*x = SearchStationResponse{}
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[11]
+func (x *LookupStationCodesResponse) Reset() {
+	*x = LookupStationCodesResponse{}
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStationResponse) String() string {
+func (x *LookupStationCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStationResponse) ProtoMessage() {}
+func (*LookupStationCodesResponse) ProtoMessage() {}
 
-func (x *SearchStationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rzd_rzd_service_proto_msgTypes[11]
+func (x *LookupStationCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rzd_rzd_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,16 +3784,37 @@ func (x *SearchStationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStationResponse.ProtoReflect.Descriptor instead.
-func (*SearchStationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LookupStationCodesResponse.ProtoReflect.Descriptor instead.
+func (*LookupStationCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_rzd_rzd_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SearchStationResponse) GetStations() []*Station {
+func (x *LookupStationCodesResponse) GetExpress3Code() int32 {
 	if x != nil {
-		return x.Stations
+		return x.Express3Code
 	}
-	return nil
+	return 0
+}
+
+func (x *LookupStationCodesResponse) GetEsrCode() string {
+	if x != nil {
+		return x.EsrCode
+	}
+	return ""
+}
+
+func (x *LookupStationCodesResponse) GetUicCode() string {
+	if x != nil {
+		return x.UicCode
+	}
+	return ""
+}
+
+func (x *LookupStationCodesResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
 var File_proto_rzd_rzd_service_proto protoreflect.FileDescriptor
@@ -928,153 +3822,682 @@ var File_proto_rzd_rzd_service_proto protoreflect.FileDescriptor
 var file_proto_rzd_rzd_service_proto_rawDesc = string([]byte{
 	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x7a, 0x64, 0x2f, 0x72, 0x7a, 0x64, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x72,
-	0x7a, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x7a, 0x64, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x6f, 0x74, 0x6f, 0x22, 0x87, 0x05, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e,
 	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x43,
 	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a,
-	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f,
-	0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69,
-	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x27, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0a, 0x54, 0x72, 0x61,
-	0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77,
+	0x69, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x77, 0x69, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x61, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x73, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xa7, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x0c,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x7a,
+	0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x73, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x61, 0x79, 0x73, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x22, 0x72, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0xf2, 0x06, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63,
+	0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x69,
+	0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x52, 0x65, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x65, 0x6c, 0x52, 0x65, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x0d, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x4e,
+	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x43, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x43, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x6f, 0x43, 0x69, 0x74, 0x79, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x43, 0x69, 0x74, 0x79, 0x22, 0x88, 0x02, 0x0a, 0x07, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x73, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x73,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2e, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x65,
+	0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x79, 0x70, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6f,
+	0x6e, 0x75, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
 	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x43, 0x61, 0x72, 0x52, 0x09, 0x63, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x22, 0xdf,
+	0x03, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x32, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x7c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x9c,
+	0x01, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb1, 0x04,
+	0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66,
+	0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x61, 0x72,
+	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x53,
+	0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x9b, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66,
+	0x66, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0x4f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x2d, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x62, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x61, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
-	0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x61,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x43, 0x6f, 0x64,
-	0x65, 0x22, 0x43, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72,
-	0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x09, 0x63, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x09, 0x63, 0x61, 0x72,
-	0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x22, 0xc9, 0x03, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2e, 0x0a, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22,
-	0x0a, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x69,
-	0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74,
-	0x61, 0x72, 0x69, 0x66, 0x66, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x61,
-	0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a,
-	0x64, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69,
-	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x4e, 0x75,
-	0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x22, 0x4f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x40, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x66, 0x61, 0x72, 0x65, 0x73, 0x22, 0xe1, 0x02, 0x0a,
+	0x0b, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x43, 0x61, 0x72, 0x53, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72,
+	0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66,
+	0x72, 0x65, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x69, 0x6e,
+	0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x12, 0x2e, 0x0a, 0x12, 0x75, 0x70, 0x70, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x69, 0x6e,
+	0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x75, 0x70,
+	0x70, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x22, 0x67, 0x0a, 0x09, 0x46, 0x61, 0x72, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x22, 0x89, 0x03, 0x0a, 0x15, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x74, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x73, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x61, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x54, 0x6f, 0x69, 0x6c, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x54, 0x6f, 0x69,
+	0x6c, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x53, 0x69, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x53, 0x69, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x65, 0x43, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x65, 0x43, 0x61, 0x72, 0x22, 0x4f, 0x0a, 0x16, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xb0, 0x01, 0x0a,
+	0x18, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x7a,
+	0x64, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x97, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66,
+	0x66, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73, 0x22, 0x8d, 0x02, 0x0a, 0x0f, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x63,
+	0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72,
+	0x7a, 0x64, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07,
+	0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x69, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x69, 0x6c, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x69, 0x6c, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf4, 0x02,
+	0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3c,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x22, 0x68, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x69, 0x6e, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x05,
+	0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x7a,
+	0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x05, 0x73, 0x74, 0x6f,
+	0x70, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x26, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xf3, 0x01, 0x0a, 0x0a, 0x52, 0x7a,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x7a, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43,
-	0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x20, 0x5a, 0x1e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x38,
+	0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x79,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x22, 0x65, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x43, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x70, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x57, 0x0a, 0x0d, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22, 0x61, 0x0a, 0x19, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x63, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x88, 0x01, 0x0a,
+	0x1a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x33, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x33, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x73, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x73, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x69, 0x63,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x69, 0x63, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x67, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x33, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x53, 0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x43, 0x10, 0x02,
+	0x2a, 0x38, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a,
+	0x11, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x57,
+	0x41, 0x59, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x2a, 0x8e, 0x01, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x1d, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43,
+	0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52,
+	0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x03, 0x2a, 0xc0, 0x01, 0x0a, 0x0b,
+	0x43, 0x61, 0x72, 0x53, 0x65, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x43,
+	0x41, 0x52, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41,
+	0x52, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54,
+	0x5a, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x49, 0x44, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x45,
+	0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x50, 0x45, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x52, 0x5f, 0x53,
+	0x45, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x55, 0x58, 0x10, 0x06, 0x2a, 0x5d,
+	0x0a, 0x0d, 0x43, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x13, 0x43, 0x41, 0x52, 0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x52, 0x5f,
+	0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x49, 0x4c, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x52, 0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0xc4, 0x01,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x53, 0x55, 0x42, 0x55, 0x52, 0x42, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59,
+	0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x55, 0x52, 0x42, 0x41, 0x4e,
+	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x42, 0x55, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52,
+	0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x45, 0x52,
+	0x52, 0x59, 0x10, 0x05, 0x2a, 0xa6, 0x01, 0x0a, 0x11, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x4c,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x4a, 0x41, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x49, 0x54, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x4c, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x2a, 0x64, 0x0a,
+	0x0b, 0x43, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x43, 0x41, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x41, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d,
+	0x4d, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x52, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x49, 0x45,
+	0x52, 0x10, 0x02, 0x32, 0x84, 0x07, 0x0a, 0x0a, 0x52, 0x7a, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x7a,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x43,
+	0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x43, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1a,
+	0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x7a, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x11, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1d, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61,
+	0x69, 0x6e, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69,
+	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x61, 0x72, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x7a, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61,
+	0x72, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x7a, 0x64, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1089,46 +4512,170 @@ func file_proto_rzd_rzd_service_proto_rawDescGZIP() []byte {
 	return file_proto_rzd_rzd_service_proto_rawDescData
 }
 
-var file_proto_rzd_rzd_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_rzd_rzd_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_rzd_rzd_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_proto_rzd_rzd_service_proto_goTypes = []any{
-	(*GetTrainRoutesRequest)(nil),     // 0: rzd.GetTrainRoutesRequest
-	(*GetTrainRoutesResponse)(nil),    // 1: rzd.GetTrainRoutesResponse
-	(*TrainRoute)(nil),                // 2: rzd.TrainRoute
-	(*Station)(nil),                   // 3: rzd.Station
-	(*CarriageType)(nil),              // 4: rzd.CarriageType
-	(*GetTrainCarriagesRequest)(nil),  // 5: rzd.GetTrainCarriagesRequest
-	(*GetTrainCarriagesResponse)(nil), // 6: rzd.GetTrainCarriagesResponse
-	(*Car)(nil),                       // 7: rzd.Car
-	(*Service)(nil),                   // 8: rzd.Service
-	(*Carrier)(nil),                   // 9: rzd.Carrier
-	(*SearchStationRequest)(nil),      // 10: rzd.SearchStationRequest
-	(*SearchStationResponse)(nil),     // 11: rzd.SearchStationResponse
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(StationCodeType)(0),                   // 0: rzd.StationCodeType
+	(Direction)(0),                         // 1: rzd.Direction
+	(TrainSearchType)(0),                   // 2: rzd.TrainSearchType
+	(CarSeatType)(0),                       // 3: rzd.CarSeatType
+	(CarNumeration)(0),                     // 4: rzd.CarNumeration
+	(TrainCategory)(0),                     // 5: rzd.TrainCategory
+	(AlternativeReason)(0),                 // 6: rzd.AlternativeReason
+	(CarDataType)(0),                       // 7: rzd.CarDataType
+	(*GetTrainRoutesRequest)(nil),          // 8: rzd.GetTrainRoutesRequest
+	(*GetTrainRoutesResponse)(nil),         // 9: rzd.GetTrainRoutesResponse
+	(*RouteAlternative)(nil),               // 10: rzd.RouteAlternative
+	(*StationGroup)(nil),                   // 11: rzd.StationGroup
+	(*TrainRoute)(nil),                     // 12: rzd.TrainRoute
+	(*Station)(nil),                        // 13: rzd.Station
+	(*GeoPoint)(nil),                       // 14: rzd.GeoPoint
+	(*CarriageType)(nil),                   // 15: rzd.CarriageType
+	(*GetTrainCarriagesRequest)(nil),       // 16: rzd.GetTrainCarriagesRequest
+	(*GetTrainCarriagesResponse)(nil),      // 17: rzd.GetTrainCarriagesResponse
+	(*GetRoutesWithCarriagesRequest)(nil),  // 18: rzd.GetRoutesWithCarriagesRequest
+	(*GetRoutesWithCarriagesResponse)(nil), // 19: rzd.GetRoutesWithCarriagesResponse
+	(*CarriagesFailure)(nil),               // 20: rzd.CarriagesFailure
+	(*Car)(nil),                            // 21: rzd.Car
+	(*SeatGroup)(nil),                      // 22: rzd.SeatGroup
+	(*Service)(nil),                        // 23: rzd.Service
+	(*Carrier)(nil),                        // 24: rzd.Carrier
+	(*SearchStationRequest)(nil),           // 25: rzd.SearchStationRequest
+	(*SearchStationResponse)(nil),          // 26: rzd.SearchStationResponse
+	(*GetFareSummaryRequest)(nil),          // 27: rzd.GetFareSummaryRequest
+	(*GetFareSummaryResponse)(nil),         // 28: rzd.GetFareSummaryResponse
+	(*FareSummary)(nil),                    // 29: rzd.FareSummary
+	(*FareTrain)(nil),                      // 30: rzd.FareTrain
+	(*RecommendSeatsRequest)(nil),          // 31: rzd.RecommendSeatsRequest
+	(*SeatPreferences)(nil),                // 32: rzd.SeatPreferences
+	(*RecommendSeatsResponse)(nil),         // 33: rzd.RecommendSeatsResponse
+	(*TrainSeatRecommendations)(nil),       // 34: rzd.TrainSeatRecommendations
+	(*SeatCombination)(nil),                // 35: rzd.SeatCombination
+	(*RecommendedSeat)(nil),                // 36: rzd.RecommendedSeat
+	(*GetSchemaDriftRequest)(nil),          // 37: rzd.GetSchemaDriftRequest
+	(*GetSchemaDriftResponse)(nil),         // 38: rzd.GetSchemaDriftResponse
+	(*SchemaDriftEvent)(nil),               // 39: rzd.SchemaDriftEvent
+	(*SchemaFieldChange)(nil),              // 40: rzd.SchemaFieldChange
+	(*FindTrainByNumberRequest)(nil),       // 41: rzd.FindTrainByNumberRequest
+	(*FindTrainByNumberResponse)(nil),      // 42: rzd.FindTrainByNumberResponse
+	(*TrainStop)(nil),                      // 43: rzd.TrainStop
+	(*GetCityStationsRequest)(nil),         // 44: rzd.GetCityStationsRequest
+	(*GetCityStationsResponse)(nil),        // 45: rzd.GetCityStationsResponse
+	(*GetNearbyStationsRequest)(nil),       // 46: rzd.GetNearbyStationsRequest
+	(*GetNearbyStationsResponse)(nil),      // 47: rzd.GetNearbyStationsResponse
+	(*NearbyStation)(nil),                  // 48: rzd.NearbyStation
+	(*LookupStationCodesRequest)(nil),      // 49: rzd.LookupStationCodesRequest
+	(*LookupStationCodesResponse)(nil),     // 50: rzd.LookupStationCodesResponse
+	(*timestamppb.Timestamp)(nil),          // 51: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 52: google.protobuf.Duration
 }
 var file_proto_rzd_rzd_service_proto_depIdxs = []int32{
-	12, // 0: rzd.GetTrainRoutesRequest.fromDate:type_name -> google.protobuf.Timestamp
-	2,  // 1: rzd.GetTrainRoutesResponse.routes:type_name -> rzd.TrainRoute
-	12, // 2: rzd.TrainRoute.departure:type_name -> google.protobuf.Timestamp
-	12, // 3: rzd.TrainRoute.arrival:type_name -> google.protobuf.Timestamp
-	3,  // 4: rzd.TrainRoute.from:type_name -> rzd.Station
-	3,  // 5: rzd.TrainRoute.to:type_name -> rzd.Station
-	4,  // 6: rzd.TrainRoute.carTypes:type_name -> rzd.CarriageType
-	12, // 7: rzd.GetTrainCarriagesRequest.fromTime:type_name -> google.protobuf.Timestamp
-	7,  // 8: rzd.GetTrainCarriagesResponse.carriages:type_name -> rzd.Car
-	9,  // 9: rzd.Car.carrier:type_name -> rzd.Carrier
-	8,  // 10: rzd.Car.services:type_name -> rzd.Service
-	3,  // 11: rzd.SearchStationResponse.stations:type_name -> rzd.Station
-	0,  // 12: rzd.RzdService.GetTrainRoutes:input_type -> rzd.GetTrainRoutesRequest
-	5,  // 13: rzd.RzdService.GetTrainCarriages:input_type -> rzd.GetTrainCarriagesRequest
-	10, // 14: rzd.RzdService.SearchStation:input_type -> rzd.SearchStationRequest
-	1,  // 15: rzd.RzdService.GetTrainRoutes:output_type -> rzd.GetTrainRoutesResponse
-	6,  // 16: rzd.RzdService.GetTrainCarriages:output_type -> rzd.GetTrainCarriagesResponse
-	11, // 17: rzd.RzdService.SearchStation:output_type -> rzd.SearchStationResponse
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	1,  // 0: rzd.GetTrainRoutesRequest.direction:type_name -> rzd.Direction
+	2,  // 1: rzd.GetTrainRoutesRequest.trainType:type_name -> rzd.TrainSearchType
+	51, // 2: rzd.GetTrainRoutesRequest.fromDate:type_name -> google.protobuf.Timestamp
+	5,  // 3: rzd.GetTrainRoutesRequest.categories:type_name -> rzd.TrainCategory
+	0,  // 4: rzd.GetTrainRoutesRequest.codeType:type_name -> rzd.StationCodeType
+	3,  // 5: rzd.GetTrainRoutesRequest.seatTypes:type_name -> rzd.CarSeatType
+	12, // 6: rzd.GetTrainRoutesResponse.routes:type_name -> rzd.TrainRoute
+	11, // 7: rzd.GetTrainRoutesResponse.groups:type_name -> rzd.StationGroup
+	10, // 8: rzd.GetTrainRoutesResponse.alternatives:type_name -> rzd.RouteAlternative
+	12, // 9: rzd.RouteAlternative.route:type_name -> rzd.TrainRoute
+	6,  // 10: rzd.RouteAlternative.reason:type_name -> rzd.AlternativeReason
+	13, // 11: rzd.StationGroup.from:type_name -> rzd.Station
+	13, // 12: rzd.StationGroup.to:type_name -> rzd.Station
+	5,  // 13: rzd.TrainRoute.trainType:type_name -> rzd.TrainCategory
+	51, // 14: rzd.TrainRoute.departure:type_name -> google.protobuf.Timestamp
+	51, // 15: rzd.TrainRoute.arrival:type_name -> google.protobuf.Timestamp
+	13, // 16: rzd.TrainRoute.from:type_name -> rzd.Station
+	13, // 17: rzd.TrainRoute.to:type_name -> rzd.Station
+	15, // 18: rzd.TrainRoute.carTypes:type_name -> rzd.CarriageType
+	52, // 19: rzd.TrainRoute.duration:type_name -> google.protobuf.Duration
+	24, // 20: rzd.TrainRoute.carrier:type_name -> rzd.Carrier
+	4,  // 21: rzd.TrainRoute.carNumeration:type_name -> rzd.CarNumeration
+	51, // 22: rzd.TrainRoute.originDeparture:type_name -> google.protobuf.Timestamp
+	21, // 23: rzd.TrainRoute.cars:type_name -> rzd.Car
+	13, // 24: rzd.TrainRoute.fromCity:type_name -> rzd.Station
+	13, // 25: rzd.TrainRoute.toCity:type_name -> rzd.Station
+	14, // 26: rzd.Station.location:type_name -> rzd.GeoPoint
+	3,  // 27: rzd.CarriageType.type:type_name -> rzd.CarSeatType
+	7,  // 28: rzd.CarriageType.dataType:type_name -> rzd.CarDataType
+	1,  // 29: rzd.GetTrainCarriagesRequest.direction:type_name -> rzd.Direction
+	51, // 30: rzd.GetTrainCarriagesRequest.fromTime:type_name -> google.protobuf.Timestamp
+	0,  // 31: rzd.GetTrainCarriagesRequest.codeType:type_name -> rzd.StationCodeType
+	21, // 32: rzd.GetTrainCarriagesResponse.carriages:type_name -> rzd.Car
+	2,  // 33: rzd.GetRoutesWithCarriagesRequest.trainType:type_name -> rzd.TrainSearchType
+	51, // 34: rzd.GetRoutesWithCarriagesRequest.fromDate:type_name -> google.protobuf.Timestamp
+	5,  // 35: rzd.GetRoutesWithCarriagesRequest.categories:type_name -> rzd.TrainCategory
+	0,  // 36: rzd.GetRoutesWithCarriagesRequest.codeType:type_name -> rzd.StationCodeType
+	12, // 37: rzd.GetRoutesWithCarriagesResponse.routes:type_name -> rzd.TrainRoute
+	20, // 38: rzd.GetRoutesWithCarriagesResponse.failures:type_name -> rzd.CarriagesFailure
+	51, // 39: rzd.CarriagesFailure.departure:type_name -> google.protobuf.Timestamp
+	24, // 40: rzd.Car.carrier:type_name -> rzd.Carrier
+	4,  // 41: rzd.Car.carNumeration:type_name -> rzd.CarNumeration
+	23, // 42: rzd.Car.services:type_name -> rzd.Service
+	22, // 43: rzd.Car.seats:type_name -> rzd.SeatGroup
+	3,  // 44: rzd.Car.seatType:type_name -> rzd.CarSeatType
+	13, // 45: rzd.SearchStationResponse.stations:type_name -> rzd.Station
+	51, // 46: rzd.GetFareSummaryRequest.date:type_name -> google.protobuf.Timestamp
+	2,  // 47: rzd.GetFareSummaryRequest.trainType:type_name -> rzd.TrainSearchType
+	5,  // 48: rzd.GetFareSummaryRequest.categories:type_name -> rzd.TrainCategory
+	0,  // 49: rzd.GetFareSummaryRequest.codeType:type_name -> rzd.StationCodeType
+	29, // 50: rzd.GetFareSummaryResponse.fares:type_name -> rzd.FareSummary
+	3,  // 51: rzd.FareSummary.type:type_name -> rzd.CarSeatType
+	30, // 52: rzd.FareSummary.minTrains:type_name -> rzd.FareTrain
+	30, // 53: rzd.FareSummary.maxTrains:type_name -> rzd.FareTrain
+	51, // 54: rzd.FareTrain.departure:type_name -> google.protobuf.Timestamp
+	51, // 55: rzd.RecommendSeatsRequest.date:type_name -> google.protobuf.Timestamp
+	3,  // 56: rzd.RecommendSeatsRequest.seatTypes:type_name -> rzd.CarSeatType
+	32, // 57: rzd.RecommendSeatsRequest.preferences:type_name -> rzd.SeatPreferences
+	0,  // 58: rzd.RecommendSeatsRequest.codeType:type_name -> rzd.StationCodeType
+	34, // 59: rzd.RecommendSeatsResponse.trains:type_name -> rzd.TrainSeatRecommendations
+	51, // 60: rzd.TrainSeatRecommendations.departure:type_name -> google.protobuf.Timestamp
+	35, // 61: rzd.TrainSeatRecommendations.combinations:type_name -> rzd.SeatCombination
+	36, // 62: rzd.SeatCombination.seats:type_name -> rzd.RecommendedSeat
+	3,  // 63: rzd.RecommendedSeat.carType:type_name -> rzd.CarSeatType
+	39, // 64: rzd.GetSchemaDriftResponse.events:type_name -> rzd.SchemaDriftEvent
+	51, // 65: rzd.SchemaDriftEvent.firstSeen:type_name -> google.protobuf.Timestamp
+	51, // 66: rzd.SchemaDriftEvent.lastSeen:type_name -> google.protobuf.Timestamp
+	40, // 67: rzd.SchemaDriftEvent.changedFields:type_name -> rzd.SchemaFieldChange
+	51, // 68: rzd.FindTrainByNumberRequest.date:type_name -> google.protobuf.Timestamp
+	12, // 69: rzd.FindTrainByNumberResponse.route:type_name -> rzd.TrainRoute
+	43, // 70: rzd.FindTrainByNumberResponse.stops:type_name -> rzd.TrainStop
+	13, // 71: rzd.TrainStop.station:type_name -> rzd.Station
+	51, // 72: rzd.TrainStop.arrival:type_name -> google.protobuf.Timestamp
+	51, // 73: rzd.TrainStop.departure:type_name -> google.protobuf.Timestamp
+	13, // 74: rzd.GetCityStationsResponse.city:type_name -> rzd.Station
+	13, // 75: rzd.GetCityStationsResponse.stations:type_name -> rzd.Station
+	48, // 76: rzd.GetNearbyStationsResponse.stations:type_name -> rzd.NearbyStation
+	13, // 77: rzd.NearbyStation.station:type_name -> rzd.Station
+	0,  // 78: rzd.LookupStationCodesRequest.codeType:type_name -> rzd.StationCodeType
+	8,  // 79: rzd.RzdService.GetTrainRoutes:input_type -> rzd.GetTrainRoutesRequest
+	16, // 80: rzd.RzdService.GetTrainCarriages:input_type -> rzd.GetTrainCarriagesRequest
+	18, // 81: rzd.RzdService.GetRoutesWithCarriages:input_type -> rzd.GetRoutesWithCarriagesRequest
+	25, // 82: rzd.RzdService.SearchStation:input_type -> rzd.SearchStationRequest
+	27, // 83: rzd.RzdService.GetFareSummary:input_type -> rzd.GetFareSummaryRequest
+	31, // 84: rzd.RzdService.RecommendSeats:input_type -> rzd.RecommendSeatsRequest
+	37, // 85: rzd.RzdService.GetSchemaDrift:input_type -> rzd.GetSchemaDriftRequest
+	41, // 86: rzd.RzdService.FindTrainByNumber:input_type -> rzd.FindTrainByNumberRequest
+	44, // 87: rzd.RzdService.GetCityStations:input_type -> rzd.GetCityStationsRequest
+	46, // 88: rzd.RzdService.GetNearbyStations:input_type -> rzd.GetNearbyStationsRequest
+	49, // 89: rzd.RzdService.LookupStationCodes:input_type -> rzd.LookupStationCodesRequest
+	9,  // 90: rzd.RzdService.GetTrainRoutes:output_type -> rzd.GetTrainRoutesResponse
+	17, // 91: rzd.RzdService.GetTrainCarriages:output_type -> rzd.GetTrainCarriagesResponse
+	19, // 92: rzd.RzdService.GetRoutesWithCarriages:output_type -> rzd.GetRoutesWithCarriagesResponse
+	26, // 93: rzd.RzdService.SearchStation:output_type -> rzd.SearchStationResponse
+	28, // 94: rzd.RzdService.GetFareSummary:output_type -> rzd.GetFareSummaryResponse
+	33, // 95: rzd.RzdService.RecommendSeats:output_type -> rzd.RecommendSeatsResponse
+	38, // 96: rzd.RzdService.GetSchemaDrift:output_type -> rzd.GetSchemaDriftResponse
+	42, // 97: rzd.RzdService.FindTrainByNumber:output_type -> rzd.FindTrainByNumberResponse
+	45, // 98: rzd.RzdService.GetCityStations:output_type -> rzd.GetCityStationsResponse
+	47, // 99: rzd.RzdService.GetNearbyStations:output_type -> rzd.GetNearbyStationsResponse
+	50, // 100: rzd.RzdService.LookupStationCodes:output_type -> rzd.LookupStationCodesResponse
+	90, // [90:101] is the sub-list for method output_type
+	79, // [79:90] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_proto_rzd_rzd_service_proto_init() }
@@ -1141,13 +4688,14 @@ func file_proto_rzd_rzd_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_rzd_rzd_service_proto_rawDesc), len(file_proto_rzd_rzd_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      8,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_rzd_rzd_service_proto_goTypes,
 		DependencyIndexes: file_proto_rzd_rzd_service_proto_depIdxs,
+		EnumInfos:         file_proto_rzd_rzd_service_proto_enumTypes,
 		MessageInfos:      file_proto_rzd_rzd_service_proto_msgTypes,
 	}.Build()
 	File_proto_rzd_rzd_service_proto = out.File
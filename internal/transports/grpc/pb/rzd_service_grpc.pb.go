@@ -20,9 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RzdService_GetTrainRoutes_FullMethodName    = "/rzd.RzdService/GetTrainRoutes"
-	RzdService_GetTrainCarriages_FullMethodName = "/rzd.RzdService/GetTrainCarriages"
-	RzdService_SearchStation_FullMethodName     = "/rzd.RzdService/SearchStation"
+	RzdService_GetTrainRoutes_FullMethodName         = "/rzd.RzdService/GetTrainRoutes"
+	RzdService_GetTrainCarriages_FullMethodName      = "/rzd.RzdService/GetTrainCarriages"
+	RzdService_GetRoutesWithCarriages_FullMethodName = "/rzd.RzdService/GetRoutesWithCarriages"
+	RzdService_SearchStation_FullMethodName          = "/rzd.RzdService/SearchStation"
+	RzdService_GetFareSummary_FullMethodName         = "/rzd.RzdService/GetFareSummary"
+	RzdService_RecommendSeats_FullMethodName         = "/rzd.RzdService/RecommendSeats"
+	RzdService_GetSchemaDrift_FullMethodName         = "/rzd.RzdService/GetSchemaDrift"
+	RzdService_FindTrainByNumber_FullMethodName      = "/rzd.RzdService/FindTrainByNumber"
+	RzdService_GetCityStations_FullMethodName        = "/rzd.RzdService/GetCityStations"
+	RzdService_GetNearbyStations_FullMethodName      = "/rzd.RzdService/GetNearbyStations"
+	RzdService_LookupStationCodes_FullMethodName     = "/rzd.RzdService/LookupStationCodes"
 )
 
 // RzdServiceClient is the client API for RzdService service.
@@ -35,8 +43,24 @@ type RzdServiceClient interface {
 	GetTrainRoutes(ctx context.Context, in *GetTrainRoutesRequest, opts ...grpc.CallOption) (*GetTrainRoutesResponse, error)
 	// Получение информации о вагонах поезда
 	GetTrainCarriages(ctx context.Context, in *GetTrainCarriagesRequest, opts ...grpc.CallOption) (*GetTrainCarriagesResponse, error)
+	// Маршруты поездов вместе с вагонами каждого поезда; ошибки по отдельным поездам не прерывают запрос
+	GetRoutesWithCarriages(ctx context.Context, in *GetRoutesWithCarriagesRequest, opts ...grpc.CallOption) (*GetRoutesWithCarriagesResponse, error)
 	// Поиск станций по части названия
 	SearchStation(ctx context.Context, in *SearchStationRequest, opts ...grpc.CallOption) (*SearchStationResponse, error)
+	// Сводка минимальных и максимальных тарифов по типам мест на направлении
+	GetFareSummary(ctx context.Context, in *GetFareSummaryRequest, opts ...grpc.CallOption) (*GetFareSummaryResponse, error)
+	// Подбор мест для группы пассажиров с учётом пожеланий
+	RecommendSeats(ctx context.Context, in *RecommendSeatsRequest, opts ...grpc.CallOption) (*RecommendSeatsResponse, error)
+	// Диагностика: последние расхождения ответов РЖД с контрактом схем
+	GetSchemaDrift(ctx context.Context, in *GetSchemaDriftRequest, opts ...grpc.CallOption) (*GetSchemaDriftResponse, error)
+	// Поиск поезда по номеру: маршрут от начальной до конечной станции и остановки
+	FindTrainByNumber(ctx context.Context, in *FindTrainByNumberRequest, opts ...grpc.CallOption) (*FindTrainByNumberResponse, error)
+	// Город по названию и станции, которые он объединяет
+	GetCityStations(ctx context.Context, in *GetCityStationsRequest, opts ...grpc.CallOption) (*GetCityStationsResponse, error)
+	// Станции рядом с точкой (по загруженным геоданным), от ближайшей к дальней
+	GetNearbyStations(ctx context.Context, in *GetNearbyStationsRequest, opts ...grpc.CallOption) (*GetNearbyStationsResponse, error)
+	// Коды станции в системах Экспресс-3, ЕСР и UIC по коду в одной из них
+	LookupStationCodes(ctx context.Context, in *LookupStationCodesRequest, opts ...grpc.CallOption) (*LookupStationCodesResponse, error)
 }
 
 type rzdServiceClient struct {
@@ -67,6 +91,16 @@ func (c *rzdServiceClient) GetTrainCarriages(ctx context.Context, in *GetTrainCa
 	return out, nil
 }
 
+func (c *rzdServiceClient) GetRoutesWithCarriages(ctx context.Context, in *GetRoutesWithCarriagesRequest, opts ...grpc.CallOption) (*GetRoutesWithCarriagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoutesWithCarriagesResponse)
+	err := c.cc.Invoke(ctx, RzdService_GetRoutesWithCarriages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rzdServiceClient) SearchStation(ctx context.Context, in *SearchStationRequest, opts ...grpc.CallOption) (*SearchStationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchStationResponse)
@@ -77,6 +111,76 @@ func (c *rzdServiceClient) SearchStation(ctx context.Context, in *SearchStationR
 	return out, nil
 }
 
+func (c *rzdServiceClient) GetFareSummary(ctx context.Context, in *GetFareSummaryRequest, opts ...grpc.CallOption) (*GetFareSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFareSummaryResponse)
+	err := c.cc.Invoke(ctx, RzdService_GetFareSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) RecommendSeats(ctx context.Context, in *RecommendSeatsRequest, opts ...grpc.CallOption) (*RecommendSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendSeatsResponse)
+	err := c.cc.Invoke(ctx, RzdService_RecommendSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) GetSchemaDrift(ctx context.Context, in *GetSchemaDriftRequest, opts ...grpc.CallOption) (*GetSchemaDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchemaDriftResponse)
+	err := c.cc.Invoke(ctx, RzdService_GetSchemaDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) FindTrainByNumber(ctx context.Context, in *FindTrainByNumberRequest, opts ...grpc.CallOption) (*FindTrainByNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindTrainByNumberResponse)
+	err := c.cc.Invoke(ctx, RzdService_FindTrainByNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) GetCityStations(ctx context.Context, in *GetCityStationsRequest, opts ...grpc.CallOption) (*GetCityStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCityStationsResponse)
+	err := c.cc.Invoke(ctx, RzdService_GetCityStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) GetNearbyStations(ctx context.Context, in *GetNearbyStationsRequest, opts ...grpc.CallOption) (*GetNearbyStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearbyStationsResponse)
+	err := c.cc.Invoke(ctx, RzdService_GetNearbyStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rzdServiceClient) LookupStationCodes(ctx context.Context, in *LookupStationCodesRequest, opts ...grpc.CallOption) (*LookupStationCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupStationCodesResponse)
+	err := c.cc.Invoke(ctx, RzdService_LookupStationCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RzdServiceServer is the server API for RzdService service.
 // All implementations must embed UnimplementedRzdServiceServer
 // for forward compatibility.
@@ -87,8 +191,24 @@ type RzdServiceServer interface {
 	GetTrainRoutes(context.Context, *GetTrainRoutesRequest) (*GetTrainRoutesResponse, error)
 	// Получение информации о вагонах поезда
 	GetTrainCarriages(context.Context, *GetTrainCarriagesRequest) (*GetTrainCarriagesResponse, error)
+	// Маршруты поездов вместе с вагонами каждого поезда; ошибки по отдельным поездам не прерывают запрос
+	GetRoutesWithCarriages(context.Context, *GetRoutesWithCarriagesRequest) (*GetRoutesWithCarriagesResponse, error)
 	// Поиск станций по части названия
 	SearchStation(context.Context, *SearchStationRequest) (*SearchStationResponse, error)
+	// Сводка минимальных и максимальных тарифов по типам мест на направлении
+	GetFareSummary(context.Context, *GetFareSummaryRequest) (*GetFareSummaryResponse, error)
+	// Подбор мест для группы пассажиров с учётом пожеланий
+	RecommendSeats(context.Context, *RecommendSeatsRequest) (*RecommendSeatsResponse, error)
+	// Диагностика: последние расхождения ответов РЖД с контрактом схем
+	GetSchemaDrift(context.Context, *GetSchemaDriftRequest) (*GetSchemaDriftResponse, error)
+	// Поиск поезда по номеру: маршрут от начальной до конечной станции и остановки
+	FindTrainByNumber(context.Context, *FindTrainByNumberRequest) (*FindTrainByNumberResponse, error)
+	// Город по названию и станции, которые он объединяет
+	GetCityStations(context.Context, *GetCityStationsRequest) (*GetCityStationsResponse, error)
+	// Станции рядом с точкой (по загруженным геоданным), от ближайшей к дальней
+	GetNearbyStations(context.Context, *GetNearbyStationsRequest) (*GetNearbyStationsResponse, error)
+	// Коды станции в системах Экспресс-3, ЕСР и UIC по коду в одной из них
+	LookupStationCodes(context.Context, *LookupStationCodesRequest) (*LookupStationCodesResponse, error)
 	mustEmbedUnimplementedRzdServiceServer()
 }
 
@@ -105,9 +225,33 @@ func (UnimplementedRzdServiceServer) GetTrainRoutes(context.Context, *GetTrainRo
 func (UnimplementedRzdServiceServer) GetTrainCarriages(context.Context, *GetTrainCarriagesRequest) (*GetTrainCarriagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTrainCarriages not implemented")
 }
+func (UnimplementedRzdServiceServer) GetRoutesWithCarriages(context.Context, *GetRoutesWithCarriagesRequest) (*GetRoutesWithCarriagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutesWithCarriages not implemented")
+}
 func (UnimplementedRzdServiceServer) SearchStation(context.Context, *SearchStationRequest) (*SearchStationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchStation not implemented")
 }
+func (UnimplementedRzdServiceServer) GetFareSummary(context.Context, *GetFareSummaryRequest) (*GetFareSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFareSummary not implemented")
+}
+func (UnimplementedRzdServiceServer) RecommendSeats(context.Context, *RecommendSeatsRequest) (*RecommendSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendSeats not implemented")
+}
+func (UnimplementedRzdServiceServer) GetSchemaDrift(context.Context, *GetSchemaDriftRequest) (*GetSchemaDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaDrift not implemented")
+}
+func (UnimplementedRzdServiceServer) FindTrainByNumber(context.Context, *FindTrainByNumberRequest) (*FindTrainByNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTrainByNumber not implemented")
+}
+func (UnimplementedRzdServiceServer) GetCityStations(context.Context, *GetCityStationsRequest) (*GetCityStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCityStations not implemented")
+}
+func (UnimplementedRzdServiceServer) GetNearbyStations(context.Context, *GetNearbyStationsRequest) (*GetNearbyStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyStations not implemented")
+}
+func (UnimplementedRzdServiceServer) LookupStationCodes(context.Context, *LookupStationCodesRequest) (*LookupStationCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupStationCodes not implemented")
+}
 func (UnimplementedRzdServiceServer) mustEmbedUnimplementedRzdServiceServer() {}
 func (UnimplementedRzdServiceServer) testEmbeddedByValue()                    {}
 
@@ -165,6 +309,24 @@ func _RzdService_GetTrainCarriages_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RzdService_GetRoutesWithCarriages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutesWithCarriagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).GetRoutesWithCarriages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_GetRoutesWithCarriages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).GetRoutesWithCarriages(ctx, req.(*GetRoutesWithCarriagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RzdService_SearchStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchStationRequest)
 	if err := dec(in); err != nil {
@@ -183,6 +345,132 @@ func _RzdService_SearchStation_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RzdService_GetFareSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFareSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).GetFareSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_GetFareSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).GetFareSummary(ctx, req.(*GetFareSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_RecommendSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).RecommendSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_RecommendSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).RecommendSeats(ctx, req.(*RecommendSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_GetSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).GetSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_GetSchemaDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).GetSchemaDrift(ctx, req.(*GetSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_FindTrainByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTrainByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).FindTrainByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_FindTrainByNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).FindTrainByNumber(ctx, req.(*FindTrainByNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_GetCityStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).GetCityStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_GetCityStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).GetCityStations(ctx, req.(*GetCityStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_GetNearbyStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).GetNearbyStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_GetNearbyStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).GetNearbyStations(ctx, req.(*GetNearbyStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RzdService_LookupStationCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupStationCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RzdServiceServer).LookupStationCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RzdService_LookupStationCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RzdServiceServer).LookupStationCodes(ctx, req.(*LookupStationCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RzdService_ServiceDesc is the grpc.ServiceDesc for RzdService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -198,10 +486,42 @@ var RzdService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrainCarriages",
 			Handler:    _RzdService_GetTrainCarriages_Handler,
 		},
+		{
+			MethodName: "GetRoutesWithCarriages",
+			Handler:    _RzdService_GetRoutesWithCarriages_Handler,
+		},
 		{
 			MethodName: "SearchStation",
 			Handler:    _RzdService_SearchStation_Handler,
 		},
+		{
+			MethodName: "GetFareSummary",
+			Handler:    _RzdService_GetFareSummary_Handler,
+		},
+		{
+			MethodName: "RecommendSeats",
+			Handler:    _RzdService_RecommendSeats_Handler,
+		},
+		{
+			MethodName: "GetSchemaDrift",
+			Handler:    _RzdService_GetSchemaDrift_Handler,
+		},
+		{
+			MethodName: "FindTrainByNumber",
+			Handler:    _RzdService_FindTrainByNumber_Handler,
+		},
+		{
+			MethodName: "GetCityStations",
+			Handler:    _RzdService_GetCityStations_Handler,
+		},
+		{
+			MethodName: "GetNearbyStations",
+			Handler:    _RzdService_GetNearbyStations_Handler,
+		},
+		{
+			MethodName: "LookupStationCodes",
+			Handler:    _RzdService_LookupStationCodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rzd/rzd_service.proto",
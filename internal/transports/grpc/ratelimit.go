@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
+)
+
+// rateLimiterIdleTTL время, после которого корзина неактивного клиента удаляется
+const rateLimiterIdleTTL = 10 * time.Minute
+
+// RateLimiter ограничивает частоту вызовов отдельно для каждого клиента (token bucket).
+// С общим хранилищем состояния лимит клиента действует суммарно по всем репликам.
+type RateLimiter struct {
+	mutex     sync.Mutex
+	rps       float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+	shared    *state.Limiter
+}
+
+// tokenBucket корзина токенов одного клиента
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// NewRateLimiter создаёт ограничитель на rps вызовов в секунду с допустимым всплеском burst.
+// При rps <= 0 ограничение отключено, пока не будет включено через SetLimit.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	limiter := &RateLimiter{
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+	limiter.SetLimit(rps, burst)
+	return limiter
+}
+
+// SetLimit меняет ограничение на лету. Накопленные клиентами токены сохраняются,
+// но не превышают новый burst.
+func (l *RateLimiter) SetLimit(rps float64, burst int) {
+	if burst < 1 {
+		burst = int(math.Ceil(rps))
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.rps = rps
+	l.burst = float64(burst)
+}
+
+// Share переносит корзины клиентов в общее хранилище реплик.
+// Если хранилище недоступно, действуют локальные корзины.
+func (l *RateLimiter) Share(store state.Store) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.shared = state.NewLimiter(store, "ratelimit:")
+}
+
+// Allow списывает токен клиента и сообщает, разрешён ли вызов.
+// ctx ограничивает обращение к общему хранилищу.
+func (l *RateLimiter) Allow(ctx context.Context, client string) bool {
+	l.mutex.Lock()
+	rps, burst, shared := l.rps, l.burst, l.shared
+	l.mutex.Unlock()
+	if rps <= 0 {
+		return true
+	}
+	if shared != nil {
+		allowed, _, err := shared.Allow(ctx, client, rps, int(burst))
+		if err == nil {
+			return allowed
+		}
+		log.Printf("Failed to check shared rate limit, using local one: %v", err)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[client]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastSeen: now}
+		l.buckets[client] = bucket
+	}
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.lastSeen).Seconds()*l.rps)
+	bucket.lastSeen = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// sweep удаляет корзины давно неактивных клиентов, чтобы карта не росла бесконечно
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimiterIdleTTL {
+		return
+	}
+	l.lastSweep = now
+	for client, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > rateLimiterIdleTTL {
+			delete(l.buckets, client)
+		}
+	}
+}
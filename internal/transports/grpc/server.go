@@ -5,10 +5,17 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sync/atomic"
 
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/state"
 	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 // Server реализует pb.RzdServiceServer.
@@ -25,7 +32,7 @@ func NewGRPCServer(endpoints Endpoints) *Server {
 func (s *Server) GetTrainRoutes(ctx context.Context, req *pb.GetTrainRoutesRequest) (*pb.GetTrainRoutesResponse, error) {
 	response, err := s.endpoints.GetTrainRoutes(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	resp, ok := response.(*pb.GetTrainRoutesResponse)
 	if !ok {
@@ -37,7 +44,7 @@ func (s *Server) GetTrainRoutes(ctx context.Context, req *pb.GetTrainRoutesReque
 func (s *Server) GetTrainCarriages(ctx context.Context, req *pb.GetTrainCarriagesRequest) (*pb.GetTrainCarriagesResponse, error) {
 	response, err := s.endpoints.GetTrainCarriages(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	resp, ok := response.(*pb.GetTrainCarriagesResponse)
 	if !ok {
@@ -49,7 +56,7 @@ func (s *Server) GetTrainCarriages(ctx context.Context, req *pb.GetTrainCarriage
 func (s *Server) SearchStation(ctx context.Context, req *pb.SearchStationRequest) (*pb.SearchStationResponse, error) {
 	response, err := s.endpoints.SearchStation(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	resp, ok := response.(*pb.SearchStationResponse)
 	if !ok {
@@ -58,14 +65,180 @@ func (s *Server) SearchStation(ctx context.Context, req *pb.SearchStationRequest
 	return resp, nil
 }
 
-// StartGRPCServer запускает gRPC-сервер и возвращает grpc.Server для управления его остановкой.
-func StartGRPCServer(addr string, srv *Server) (*grpc.Server, net.Listener, error) {
-	listener, err := net.Listen("tcp", addr)
+func (s *Server) GetFareSummary(ctx context.Context, req *pb.GetFareSummaryRequest) (*pb.GetFareSummaryResponse, error) {
+	response, err := s.endpoints.GetFareSummary(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.GetFareSummaryResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) RecommendSeats(ctx context.Context, req *pb.RecommendSeatsRequest) (*pb.RecommendSeatsResponse, error) {
+	response, err := s.endpoints.RecommendSeats(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.RecommendSeatsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetSchemaDrift(ctx context.Context, req *pb.GetSchemaDriftRequest) (*pb.GetSchemaDriftResponse, error) {
+	response, err := s.endpoints.GetSchemaDrift(ctx, req)
 	if err != nil {
-		return nil, nil, err
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.GetSchemaDriftResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
 	}
-	grpcServer := grpc.NewServer()
+	return resp, nil
+}
+
+func (s *Server) FindTrainByNumber(ctx context.Context, req *pb.FindTrainByNumberRequest) (*pb.FindTrainByNumberResponse, error) {
+	response, err := s.endpoints.FindTrainByNumber(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.FindTrainByNumberResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetCityStations(ctx context.Context, req *pb.GetCityStationsRequest) (*pb.GetCityStationsResponse, error) {
+	response, err := s.endpoints.GetCityStations(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.GetCityStationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetNearbyStations(ctx context.Context, req *pb.GetNearbyStationsRequest) (*pb.GetNearbyStationsResponse, error) {
+	response, err := s.endpoints.GetNearbyStations(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.GetNearbyStationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) LookupStationCodes(ctx context.Context, req *pb.LookupStationCodesRequest) (*pb.LookupStationCodesResponse, error) {
+	response, err := s.endpoints.LookupStationCodes(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.LookupStationCodesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetRoutesWithCarriages(ctx context.Context, req *pb.GetRoutesWithCarriagesRequest) (*pb.GetRoutesWithCarriagesResponse, error) {
+	response, err := s.endpoints.GetRoutesWithCarriages(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, ok := response.(*pb.GetRoutesWithCarriagesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", response)
+	}
+	return resp, nil
+}
+
+// Instance запущенный по конфигурации gRPC-сервер. Health управляется RunHealthMonitor,
+// Listener передаётся в Server.Serve.
+type Instance struct {
+	Server   *grpc.Server
+	Health   *health.Server
+	Listener net.Listener
+
+	logRequests *atomic.Bool
+	limiter     *RateLimiter
+}
+
+// StartGRPCServer создаёт gRPC-сервер по конфигурации и открывает listener.
+// Если задано общее хранилище shared, лимиты частоты вызовов действуют суммарно по всем репликам.
+func StartGRPCServer(cfg *config.GRPC, srv *Server, shared state.Store) (*Instance, error) {
+	instance := &Instance{
+		logRequests: &atomic.Bool{},
+		limiter:     NewRateLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst),
+	}
+	if shared != nil {
+		instance.limiter.Share(shared)
+	}
+	instance.logRequests.Store(cfg.LogRequests)
+
+	opts, err := instance.serverOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", ":"+cfg.Port)
+	if err != nil {
+		return nil, err
+	}
+	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterRzdServiceServer(grpcServer, srv)
-	log.Printf("gRPC server listening on %s", addr)
-	return grpcServer, listener, nil
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if cfg.Reflection {
+		reflection.Register(grpcServer)
+	}
+	log.Printf("gRPC server listening on :%s", cfg.Port)
+
+	instance.Server = grpcServer
+	instance.Health = healthServer
+	instance.Listener = listener
+	return instance, nil
+}
+
+// ApplyConfig применяет на лету логирование вызовов и лимиты частоты.
+// Порт, TLS, аутентификация и рефлексия меняются только перезапуском.
+func (i *Instance) ApplyConfig(cfg *config.GRPC) {
+	i.logRequests.Store(cfg.LogRequests)
+	i.limiter.SetLimit(cfg.RateLimit.RPS, cfg.RateLimit.Burst)
+}
+
+// serverOptions собирает TLS, трассировку и цепочку интерсепторов: recovery -> logging -> аутентификация и лимиты
+func (i *Instance) serverOptions(cfg *config.GRPC) ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+
+	creds, err := newTLSCredentials(&cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gRPC TLS configuration: %w", err)
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	auth, err := NewAuthenticator(&cfg.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gRPC auth configuration: %w", err)
+	}
+
+	// Трассировка продолжает входящий контекст (W3C traceparent) и создаёт спан на каждый вызов.
+	// Логирование и лимиты устанавливаются всегда, чтобы их можно было включить без перезапуска
+	opts = append(opts,
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(UnaryRecovery(), UnaryLogging(i.logRequests), UnaryAdmission(auth, i.limiter)),
+		grpc.ChainStreamInterceptor(StreamRecovery(), StreamLogging(i.logRequests), StreamAdmission(auth, i.limiter)),
+	)
+	return opts, nil
 }
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/pkg/config"
+)
+
+// newTLSCredentials создаёт серверные TLS-креды по конфигурации.
+// Возвращает nil, если TLS не настроен. При заданном ClientCAFile требует клиентский сертификат (mTLS).
+func newTLSCredentials(cfg *config.GRPCTLS) (credentials.TransportCredentials, error) {
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, errors.New("client CA is set but server certificate is not")
+		}
+		return nil, nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("both TLS certificate and key files are required")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("client CA file contains no certificates")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
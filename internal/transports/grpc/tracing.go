@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/infrastructure/tracing"
+)
+
+var tracer = tracing.Tracer("transports/grpc")
+
+// EndpointTracing оборачивает эндпоинт go-kit в спан "endpoint.<name>".
+// Родительский спан вызова gRPC создаёт otelgrpc по входящему контексту трассировки.
+func EndpointTracing(name string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			ctx, span := tracer.Start(ctx, "endpoint."+name)
+			defer func() { tracing.End(span, err) }()
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+)
+
+// pastTolerance допустимое отставание даты отправления от текущего момента.
+// Клиент может передать полночь своей даты в любом часовом поясе, поэтому "сегодня"
+// считается с запасом в сутки.
+const pastTolerance = 24 * time.Hour
+
+// maxPartySize наибольшая группа для подбора мест: два соседних купе
+const maxPartySize = 8
+
+// Ограничения режима альтернатив запроса маршрутов
+const (
+	maxAlternativeDays = 3
+	maxAlternatives    = 50
+)
+
+// maxCarriagesConcurrency наибольшее число одновременных запросов вагонов в GetRoutesWithCarriages
+const maxCarriagesConcurrency = 8
+
+// Ограничения поиска станций рядом с точкой
+const (
+	maxNearbyRadiusKm = 500
+	maxNearbyLimit    = 100
+)
+
+// supportedLanguages языки, на которых РЖД отдаёт ответы
+var supportedLanguages = map[string]bool{"ru": true, "en": true}
+
+// normalizeLanguage приводит код языка из запроса к виду, ожидаемому РЖД
+func normalizeLanguage(lang string) string {
+	return strings.ToLower(strings.TrimSpace(lang))
+}
+
+// fieldViolations накапливает ошибки валидации полей запроса
+type fieldViolations []*errdetails.BadRequest_FieldViolation
+
+func (v *fieldViolations) add(field, description string) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// err возвращает InvalidArgument с деталями BadRequest или nil, если нарушений нет
+func (v fieldViolations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(v))
+	for _, violation := range v {
+		fields = append(fields, violation.Field)
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid request: %s", strings.Join(fields, ", ")))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// validateGetTrainRoutesRequest проверяет запрос поиска маршрутов
+func validateGetTrainRoutesRequest(req *pb.GetTrainRoutesRequest, now time.Time) error {
+	var v fieldViolations
+	validateSegment(&v, req.FromCode, req.ToCode, req.FromStation, req.ToStation)
+	validateCodeType(&v, req.CodeType)
+	validateDirection(&v, req.Direction)
+	validateTrainFilter(&v, req.TrainType, req.Categories)
+	validateDeparture(&v, "fromDate", req.FromDate, now)
+	validateLanguage(&v, req.Lang)
+	validateSeatTypes(&v, req.SeatTypes)
+	if req.AlternativeDays < 0 || req.AlternativeDays > maxAlternativeDays {
+		v.add("alternativeDays", fmt.Sprintf("must be between 0 and %d", maxAlternativeDays))
+	}
+	if req.MaxAlternatives < 0 || req.MaxAlternatives > maxAlternatives {
+		v.add("maxAlternatives", fmt.Sprintf("must be between 0 and %d", maxAlternatives))
+	}
+	return v.err()
+}
+
+// validateGetRoutesWithCarriagesRequest проверяет запрос маршрутов с вагонами
+func validateGetRoutesWithCarriagesRequest(req *pb.GetRoutesWithCarriagesRequest, now time.Time) error {
+	var v fieldViolations
+	validateSegment(&v, req.FromCode, req.ToCode, req.FromStation, req.ToStation)
+	validateCodeType(&v, req.CodeType)
+	validateTrainFilter(&v, req.TrainType, req.Categories)
+	validateDeparture(&v, "fromDate", req.FromDate, now)
+	validateLanguage(&v, req.Lang)
+	for i, number := range req.TrainNumbers {
+		if strings.TrimSpace(number) == "" {
+			v.add(fmt.Sprintf("trainNumbers[%d]", i), "train number must not be empty")
+		}
+	}
+	if req.Concurrency < 0 || req.Concurrency > maxCarriagesConcurrency {
+		v.add("concurrency", fmt.Sprintf("must be between 0 and %d", maxCarriagesConcurrency))
+	}
+	return v.err()
+}
+
+// validateGetFareSummaryRequest проверяет запрос сводки тарифов
+func validateGetFareSummaryRequest(req *pb.GetFareSummaryRequest, now time.Time) error {
+	var v fieldViolations
+	validateStations(&v, req.FromCode, req.ToCode)
+	validateCodeType(&v, req.CodeType)
+	validateTrainFilter(&v, req.TrainType, req.Categories)
+	validateDeparture(&v, "date", req.Date, now)
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+// validateGetTrainCarriagesRequest проверяет запрос списка вагонов
+func validateGetTrainCarriagesRequest(req *pb.GetTrainCarriagesRequest, now time.Time) error {
+	var v fieldViolations
+	if strings.TrimSpace(req.TrainNumber) == "" {
+		v.add("trainNumber", "train number is required")
+	}
+	validateSegment(&v, req.FromCode, req.ToCode, req.FromStation, req.ToStation)
+	validateCodeType(&v, req.CodeType)
+	validateDirection(&v, req.Direction)
+	validateDeparture(&v, "fromTime", req.FromTime, now)
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+// validateFindTrainByNumberRequest проверяет запрос поиска поезда по номеру
+func validateFindTrainByNumberRequest(req *pb.FindTrainByNumberRequest, now time.Time) error {
+	var v fieldViolations
+	if strings.TrimSpace(req.TrainNumber) == "" {
+		v.add("trainNumber", "train number is required")
+	}
+	validateDeparture(&v, "date", req.Date, now)
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+// validateSearchStationRequest проверяет запрос поиска станций
+func validateSearchStationRequest(req *pb.SearchStationRequest) error {
+	var v fieldViolations
+	if strings.TrimSpace(req.Query) == "" {
+		v.add("query", "query is required")
+	}
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+// validateGetCityStationsRequest проверяет запрос станций города
+func validateGetCityStationsRequest(req *pb.GetCityStationsRequest) error {
+	var v fieldViolations
+	if strings.TrimSpace(req.Query) == "" {
+		v.add("query", "query is required")
+	}
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+// validateGetNearbyStationsRequest проверяет запрос станций рядом с точкой
+func validateGetNearbyStationsRequest(req *pb.GetNearbyStationsRequest) error {
+	var v fieldViolations
+	if req.Lat < -90 || req.Lat > 90 {
+		v.add("lat", "latitude must be between -90 and 90")
+	}
+	if req.Lon < -180 || req.Lon > 180 {
+		v.add("lon", "longitude must be between -180 and 180")
+	}
+	if req.RadiusKm <= 0 || req.RadiusKm > maxNearbyRadiusKm {
+		v.add("radiusKm", fmt.Sprintf("radius must be positive and at most %d km", maxNearbyRadiusKm))
+	}
+	if req.Limit < 0 || req.Limit > maxNearbyLimit {
+		v.add("limit", fmt.Sprintf("must be between 0 and %d", maxNearbyLimit))
+	}
+	return v.err()
+}
+
+// validateGetSchemaDriftRequest проверяет запрос расхождений с контрактом
+func validateGetSchemaDriftRequest(req *pb.GetSchemaDriftRequest) error {
+	var v fieldViolations
+	if req.Limit < 0 {
+		v.add("limit", "must not be negative")
+	}
+	return v.err()
+}
+
+func validateStations(v *fieldViolations, fromCode, toCode int32) {
+	if fromCode <= 0 {
+		v.add("fromCode", "station code must be positive")
+	}
+	if toCode <= 0 {
+		v.add("toCode", "station code must be positive")
+	}
+	if fromCode > 0 && fromCode == toCode {
+		v.add("toCode", "arrival station must differ from departure station")
+	}
+}
+
+// validateSegment проверяет станции отправления и прибытия: каждая задаётся кодом или названием
+func validateSegment(v *fieldViolations, fromCode, toCode int32, fromStation, toStation string) {
+	if strings.TrimSpace(fromStation) == "" && strings.TrimSpace(toStation) == "" {
+		validateStations(v, fromCode, toCode)
+		return
+	}
+	if fromCode < 0 || (fromCode == 0 && strings.TrimSpace(fromStation) == "") {
+		v.add("fromCode", "station code must be positive or fromStation must be set")
+	}
+	if toCode < 0 || (toCode == 0 && strings.TrimSpace(toStation) == "") {
+		v.add("toCode", "station code must be positive or toStation must be set")
+	}
+}
+
+func validateDirection(v *fieldViolations, direction pb.Direction) {
+	if _, ok := pb.Direction_name[int32(direction)]; !ok {
+		v.add("direction", fmt.Sprintf("unknown direction %d", direction))
+	}
+}
+
+func validateCodeType(v *fieldViolations, codeType pb.StationCodeType) {
+	if _, ok := pb.StationCodeType_name[int32(codeType)]; !ok {
+		v.add("codeType", fmt.Sprintf("unknown station code type %d", codeType))
+	}
+}
+
+// validateLookupStationCodesRequest проверяет запрос кодов станции
+func validateLookupStationCodesRequest(req *pb.LookupStationCodesRequest) error {
+	var v fieldViolations
+	validateCodeType(&v, req.CodeType)
+	if strings.TrimSpace(req.Code) == "" {
+		v.add("code", "station code is required")
+	}
+	return v.err()
+}
+
+// validateRecommendSeatsRequest проверяет запрос подбора мест
+func validateRecommendSeatsRequest(req *pb.RecommendSeatsRequest, now time.Time) error {
+	var v fieldViolations
+	validateStations(&v, req.FromCode, req.ToCode)
+	validateCodeType(&v, req.CodeType)
+	validateDeparture(&v, "date", req.Date, now)
+	if req.PartySize < 1 || req.PartySize > maxPartySize {
+		v.add("partySize", fmt.Sprintf("party size must be between 1 and %d", maxPartySize))
+	}
+	if req.MaxResults < 0 {
+		v.add("maxResults", "must not be negative")
+	}
+	validateSeatTypes(&v, req.SeatTypes)
+	validateLanguage(&v, req.Lang)
+	return v.err()
+}
+
+func validateSeatTypes(v *fieldViolations, seatTypes []pb.CarSeatType) {
+	for i, seatType := range seatTypes {
+		if _, ok := pb.CarSeatType_name[int32(seatType)]; !ok || seatType == pb.CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED {
+			v.add(fmt.Sprintf("seatTypes[%d]", i), fmt.Sprintf("unknown car seat type %d", seatType))
+		}
+	}
+}
+
+func validateTrainFilter(v *fieldViolations, trainType pb.TrainSearchType, categories []pb.TrainCategory) {
+	if _, ok := pb.TrainSearchType_name[int32(trainType)]; !ok {
+		v.add("trainType", fmt.Sprintf("unknown train search type %d", trainType))
+	}
+	for i, category := range categories {
+		if _, ok := pb.TrainCategory_name[int32(category)]; !ok {
+			v.add(fmt.Sprintf("categories[%d]", i), fmt.Sprintf("unknown train category %d", category))
+		}
+	}
+}
+
+func validateDeparture(v *fieldViolations, field string, ts *timestamppb.Timestamp, now time.Time) {
+	if ts == nil {
+		v.add(field, "departure date is required")
+		return
+	}
+	if err := ts.CheckValid(); err != nil {
+		v.add(field, err.Error())
+		return
+	}
+	if ts.AsTime().Before(now.Add(-pastTolerance)) {
+		v.add(field, "departure date is in the past")
+	}
+}
+
+func validateLanguage(v *fieldViolations, lang string) {
+	if lang = normalizeLanguage(lang); lang != "" && !supportedLanguages[lang] {
+		v.add("lang", fmt.Sprintf("unsupported language %q", lang))
+	}
+}
@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/domain"
+	"github.com/Chaika-Team/ChaikaRzdScraper/internal/transports/grpc/pb"
+)
+
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateGetTrainRoutesRequest(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+
+	valid := &pb.GetTrainRoutesRequest{
+		FromCode:  2004000,
+		ToCode:    2000000,
+		TrainType: pb.TrainSearchType_TRAIN_SEARCH_TYPE_ALL,
+		FromDate:  timestamppb.New(now.Add(48 * time.Hour)),
+		Lang:      "EN",
+	}
+	require.NoError(t, validateGetTrainRoutesRequest(valid, now))
+
+	invalid := &pb.GetTrainRoutesRequest{
+		FromCode:   2004000,
+		ToCode:     2004000,
+		Direction:  pb.Direction(7),
+		TrainType:  pb.TrainSearchType(9),
+		Categories: []pb.TrainCategory{pb.TrainCategory_TRAIN_CATEGORY_SUBURBAN, pb.TrainCategory(42)},
+		CodeType:   pb.StationCodeType(5),
+	}
+	err := validateGetTrainRoutesRequest(invalid, now)
+	require.ElementsMatch(t, []string{"toCode", "codeType", "direction", "trainType", "categories[1]", "fromDate"}, violatedFields(t, err))
+
+	past := &pb.GetTrainRoutesRequest{FromCode: 2004000, ToCode: 2000000, FromDate: timestamppb.New(now.AddDate(0, 0, -2))}
+	require.Equal(t, []string{"fromDate"}, violatedFields(t, validateGetTrainRoutesRequest(past, now)))
+}
+
+func TestValidateGetTrainCarriagesRequest(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+
+	req := &pb.GetTrainCarriagesRequest{
+		TrainNumber: "  ",
+		FromCode:    2004000,
+		ToCode:      0,
+		FromTime:    timestamppb.New(now.Add(time.Hour)),
+		Lang:        "de",
+	}
+	err := validateGetTrainCarriagesRequest(req, now)
+	require.ElementsMatch(t, []string{"trainNumber", "toCode", "lang"}, violatedFields(t, err))
+
+	// Станцию можно задать названием вместо кода
+	byName := &pb.GetTrainCarriagesRequest{
+		TrainNumber: "119А",
+		FromStation: "Москва",
+		ToCode:      2004000,
+		FromTime:    timestamppb.New(now),
+	}
+	require.NoError(t, validateGetTrainCarriagesRequest(byName, now))
+	byName.ToCode = 0
+	require.Equal(t, []string{"toCode"}, violatedFields(t, validateGetTrainCarriagesRequest(byName, now)))
+}
+
+func TestValidateGetRoutesWithCarriagesRequest(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+
+	req := &pb.GetRoutesWithCarriagesRequest{
+		FromCode:     2004000,
+		ToCode:       2000000,
+		FromDate:     timestamppb.New(now.Add(48 * time.Hour)),
+		TrainNumbers: []string{"119А"},
+		Concurrency:  maxCarriagesConcurrency,
+	}
+	require.NoError(t, validateGetRoutesWithCarriagesRequest(req, now))
+
+	req.TrainNumbers = append(req.TrainNumbers, " ")
+	req.Concurrency = maxCarriagesConcurrency + 1
+	err := validateGetRoutesWithCarriagesRequest(req, now)
+	require.ElementsMatch(t, []string{"trainNumbers[1]", "concurrency"}, violatedFields(t, err))
+}
+
+func TestValidateRecommendSeatsRequest(t *testing.T) {
+	now := time.Date(2025, 2, 13, 12, 0, 0, 0, time.UTC)
+
+	req := &pb.RecommendSeatsRequest{
+		FromCode:   2004000,
+		ToCode:     2000000,
+		Date:       timestamppb.New(now),
+		PartySize:  9,
+		MaxResults: -1,
+		SeatTypes:  []pb.CarSeatType{pb.CarSeatType_CAR_SEAT_TYPE_COUPE, pb.CarSeatType_CAR_SEAT_TYPE_UNSPECIFIED},
+	}
+	err := validateRecommendSeatsRequest(req, now)
+	require.ElementsMatch(t, []string{"partySize", "maxResults", "seatTypes[1]"}, violatedFields(t, err))
+}
+
+func TestValidateGetSchemaDriftRequest(t *testing.T) {
+	require.NoError(t, validateGetSchemaDriftRequest(&pb.GetSchemaDriftRequest{}))
+	require.NoError(t, validateGetSchemaDriftRequest(&pb.GetSchemaDriftRequest{Endpoint: "routes", Limit: 10}))
+	require.Equal(t, []string{"limit"}, violatedFields(t, validateGetSchemaDriftRequest(&pb.GetSchemaDriftRequest{Limit: -1})))
+}
+
+func TestToStatusErrorAmbiguousStation(t *testing.T) {
+	err := toStatusError(fmt.Errorf("resolve: %w", &domain.AmbiguousStationError{
+		Field: "to",
+		Query: "МОСКВА",
+		Candidates: []domain.Station{
+			{Name: "МОСКВА КУРСКАЯ", Code: 2000001},
+			{Name: "МОСКВА ЯРОСЛАВСКАЯ", Code: 2000002},
+		},
+	}))
+	require.Equal(t, []string{"toStation", "toStation"}, violatedFields(t, err))
+	require.Contains(t, status.Convert(err).Message(), "МОСКВА КУРСКАЯ (2000001)")
+
+	require.Equal(t, codes.NotFound, status.Code(toStatusError(fmt.Errorf("from: %w", domain.ErrStationNotFound))))
+}
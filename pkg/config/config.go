@@ -2,48 +2,192 @@
 package config
 
 import (
-	"fmt"
-
-	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 // Config содержит полное конфигурацию приложения.
 type Config struct {
-	RZD  RZD  `yaml:"RZD" env:"RZD"`
-	GRPC GRPC `yaml:"GRPC" env:"GRPC"`
+	RZD     RZD     `yaml:"RZD" env-prefix:"RZD_"`
+	GRPC    GRPC    `yaml:"GRPC" env-prefix:"GRPC_"`
+	Sinks   Sinks   `yaml:"SINKS" env-prefix:"SINKS_"`
+	Metrics Metrics `yaml:"METRICS" env-prefix:"METRICS_"`
+	Tracing Tracing `yaml:"TRACING" env-prefix:"TRACING_"`
+	State   State   `yaml:"STATE" env-prefix:"STATE_"`
 }
 
 // RZD содержит конфигурацию для клиента RZD.
+// Переменные окружения секции начинаются с RZD_, например RZD_TIMEOUT или RZD_BREAKER_OPEN_TIMEOUT.
+// Все интервалы задаются длительностями с единицами измерения, например "30s", "2s", "5m".
 type RZD struct {
-	Language    string `yaml:"LANGUAGE" env:"LANGUAGE,default=ru, description=Language of the response"`
-	Timeout     int    `yaml:"TIMEOUT" env:"TIMEOUT,default=2000, description=Timeout of retries in milliseconds"`
-	MaxRetries  int    `yaml:"MAX_RETRIES" env:"MAX_RETRIES,default=10, description=Maximum number of retries"`
-	RIDLifetime int    `yaml:"RID_LIFETIME" env:"RID_LIFETIME,default=300000, description=The lifetime of RID in milliseconds"`
-	Proxy       string `yaml:"PROXY" env:"PROXY"`
-	UserAgent   string `yaml:"USER_AGENT" env:"USER_AGENT,default=Mozilla/5.0 (compatible; RzdClient/1.0)"`
-	BasePath    string `yaml:"BASE_PATH" env:"BASE_PATH,default=https://pass.rzd.ru/"`
-	DebugMode   bool   `yaml:"DEBUG_MODE" env:"DEBUG_MODE,default=false"`
+	Language    string        `yaml:"LANGUAGE" env:"LANGUAGE" env-default:"ru" env-description:"Language of the response"`
+	Timeout     time.Duration `yaml:"TIMEOUT" env:"TIMEOUT" env-default:"30s" env-description:"Timeout of a single HTTP request"`
+	RetryDelay  time.Duration `yaml:"RETRY_DELAY" env:"RETRY_DELAY" env-default:"2s" env-description:"Delay between retries"`
+	MaxRetries  int           `yaml:"MAX_RETRIES" env:"MAX_RETRIES" env-default:"10" env-description:"Maximum number of retries"`
+	RIDLifetime time.Duration `yaml:"RID_LIFETIME" env:"RID_LIFETIME" env-default:"5m" env-description:"The lifetime of RID"`
+	Proxy       string        `yaml:"PROXY" env:"PROXY"`
+	UserAgent   string        `yaml:"USER_AGENT" env:"USER_AGENT" env-default:"Mozilla/5.0 (compatible; RzdClient/1.0)"`
+	BasePath    string        `yaml:"BASE_PATH" env:"BASE_PATH" env-default:"https://pass.rzd.ru/"`
+	DebugMode   bool          `yaml:"DEBUG_MODE" env:"DEBUG_MODE" env-description:"Dump upstream responses to the log"`
+	// Источник данных: legacy (timetable/public), json (ticket.rzd.ru) или fallback (legacy, при ошибке - json)
+	Provider     string `yaml:"PROVIDER" env:"PROVIDER" env-default:"legacy"`
+	JSONBasePath string `yaml:"JSON_BASE_PATH" env:"JSON_BASE_PATH" env-default:"https://ticket.rzd.ru/"`
+	// Файл с дополнительными часовыми поясами станций: строки "код,IANA пояс" или "префикс*,IANA пояс"
+	TimeZonesFile string `yaml:"TIMEZONES_FILE" env:"TIMEZONES_FILE"`
+	// CSV с координатами станций (колонки code, esr, name, lat, lon, region, timezone); пустой - без геоданных
+	GeoDataFile string `yaml:"GEODATA_FILE" env:"GEODATA_FILE"`
+	// CSV соответствия кодов станций (колонки express3, esr, uic, name; см. cmd/rzd-codes); пустой - только коды Экспресс-3
+	StationCodesFile string     `yaml:"STATION_CODES_FILE" env:"STATION_CODES_FILE"`
+	Breaker          RZDBreaker `yaml:"BREAKER" env-prefix:"BREAKER_"`
+	Budget           RZDBudget  `yaml:"BUDGET" env-prefix:"BUDGET_"`
+	// Разбор ответов legacy API: lenient (расхождения со схемой логируются) или strict (расхождения - ошибка)
+	DecodeMode string `yaml:"DECODE_MODE" env:"DECODE_MODE" env-default:"lenient"`
+}
+
+// RZDBreaker содержит параметры предохранителей эндпоинтов РЖД (маршруты, вагоны, подсказки станций).
+// После FailureThreshold неудачных попыток подряд эндпоинт отключается на OpenTimeout,
+// запросы к нему сразу завершаются ошибкой Unavailable или получают сохранённый ответ не старше StaleTTL.
+type RZDBreaker struct {
+	FailureThreshold int           `yaml:"FAILURE_THRESHOLD" env:"FAILURE_THRESHOLD" env-default:"5"`
+	OpenTimeout      time.Duration `yaml:"OPEN_TIMEOUT" env:"OPEN_TIMEOUT" env-default:"30s"`
+	StaleTTL         time.Duration `yaml:"STALE_TTL" env:"STALE_TTL" env-default:"1h"` // Отрицательное значение отключает сохранённые ответы
+}
+
+// RZDBudget ограничивает частоту запросов к РЖД (включая повторы) с учётом всех реплик,
+// подключённых к общему хранилищу STATE. Сверх лимита запрос ждёт начала следующего окна.
+// RPS = 0 отключает ограничение.
+type RZDBudget struct {
+	RPS   float64 `yaml:"RPS" env:"RPS"`
+	Burst int     `yaml:"BURST" env:"BURST" env-default:"5"`
+}
+
+// Metrics содержит параметры HTTP-эндпоинта метрик Prometheus (/metrics).
+// Пустой адрес отключает эндпоинт.
+type Metrics struct {
+	Addr string `yaml:"ADDR" env:"ADDR"` // Например, :9090
+}
+
+// Tracing содержит параметры трассировки OpenTelemetry с экспортом по OTLP/gRPC.
+// Пустой адрес коллектора отключает экспорт спанов.
+type Tracing struct {
+	Endpoint    string        `yaml:"ENDPOINT" env:"ENDPOINT"` // host:port коллектора, например localhost:4317
+	Insecure    bool          `yaml:"INSECURE" env:"INSECURE"` // Без TLS
+	ServiceName string        `yaml:"SERVICE_NAME" env:"SERVICE_NAME" env-default:"rzd-scraper"`
+	SampleRatio float64       `yaml:"SAMPLE_RATIO" env:"SAMPLE_RATIO" env-default:"1"` // Доля трассируемых запросов без входящего контекста
+	Timeout     time.Duration `yaml:"TIMEOUT" env:"TIMEOUT" env-default:"10s"`         // Таймаут экспорта
 }
 
+// State содержит параметры хранилища общего состояния реплик: RID и cookie сессии РЖД,
+// сохранённые ответы, лимиты частоты. Пустой адрес - состояние хранится в памяти процесса.
+type State struct {
+	Addr    string        `yaml:"ADDR" env:"ADDR"` // redis://[user:password@]host:6379[/db]
+	Prefix  string        `yaml:"PREFIX" env:"PREFIX" env-default:"rzd-scraper:"`
+	Timeout time.Duration `yaml:"TIMEOUT" env:"TIMEOUT" env-default:"2s"` // Таймаут соединения и команды
+}
+
+// Источники данных РЖД (RZD.Provider)
+const (
+	ProviderLegacy   = "legacy"
+	ProviderJSON     = "json"
+	ProviderFallback = "fallback"
+)
+
+// Режимы разбора ответов РЖД (RZD.DecodeMode)
+const (
+	DecodeLenient = "lenient"
+	DecodeStrict  = "strict"
+)
+
 // GRPC содержит конфигурацию для gRPC сервера.
+// Переменные окружения секции начинаются с GRPC_, например GRPC_PORT или GRPC_TLS_CERT_FILE.
 type GRPC struct {
-	Port string `yaml:"PORT" env:"PORT,default=50051"`
+	Port        string        `yaml:"PORT" env:"PORT" env-default:"50051"`
+	Reflection  bool          `yaml:"REFLECTION" env:"REFLECTION"`     // Серверная рефлексия (для grpcurl)
+	LogRequests bool          `yaml:"LOG_REQUESTS" env:"LOG_REQUESTS"` // Логирование каждого вызова
+	TLS         GRPCTLS       `yaml:"TLS" env-prefix:"TLS_"`
+	Auth        GRPCAuth      `yaml:"AUTH" env-prefix:"AUTH_"`
+	RateLimit   GRPCRateLimit `yaml:"RATE_LIMIT" env-prefix:"RATE_LIMIT_"`
+	Health      GRPCHealth    `yaml:"HEALTH" env-prefix:"HEALTH_"`
+}
+
+// GRPCTLS содержит параметры TLS. TLS включается, если заданы сертификат и ключ,
+// mTLS - если дополнительно задан CA клиентских сертификатов.
+type GRPCTLS struct {
+	CertFile     string `yaml:"CERT_FILE" env:"CERT_FILE"`
+	KeyFile      string `yaml:"KEY_FILE" env:"KEY_FILE"`
+	ClientCAFile string `yaml:"CLIENT_CA_FILE" env:"CLIENT_CA_FILE"`
+}
+
+// GRPCAuth содержит параметры аутентификации клиентов.
+// Mode: "" (без аутентификации), "apikey" (заголовок x-api-key) или "jwt" (Bearer токен HS256).
+type GRPCAuth struct {
+	Mode        string   `yaml:"MODE" env:"MODE"`
+	APIKeys     []string `yaml:"API_KEYS" env:"API_KEYS" env-separator:","`
+	JWTSecret   string   `yaml:"JWT_SECRET" env:"JWT_SECRET"`
+	JWTIssuer   string   `yaml:"JWT_ISSUER" env:"JWT_ISSUER"`     // Пустой - не проверяется
+	JWTAudience string   `yaml:"JWT_AUDIENCE" env:"JWT_AUDIENCE"` // Пустой - не проверяется
+}
+
+// GRPCRateLimit ограничивает частоту вызовов для каждого клиента
+// (по API-ключу, subject токена или IP-адресу). RPS = 0 отключает ограничение.
+type GRPCRateLimit struct {
+	RPS   float64 `yaml:"RPS" env:"RPS"`
+	Burst int     `yaml:"BURST" env:"BURST" env-default:"10"`
+}
+
+// GRPCHealth содержит параметры проверки доступности РЖД для grpc.health.v1.
+type GRPCHealth struct {
+	Interval time.Duration `yaml:"INTERVAL" env:"INTERVAL" env-default:"30s"`
+	Timeout  time.Duration `yaml:"TIMEOUT" env:"TIMEOUT" env-default:"5s"`
+}
+
+// Sinks содержит конфигурацию экспорта полученных данных во внешние системы.
+// Получатель включается, если задан его адрес (URL или PATH).
+type Sinks struct {
+	Buffer  int         `yaml:"BUFFER" env:"BUFFER" env-default:"1000"` // Размер очереди событий каждого получателя
+	NATS    NATSSink    `yaml:"NATS" env-prefix:"NATS_"`
+	Kafka   KafkaSink   `yaml:"KAFKA" env-prefix:"KAFKA_"`
+	Webhook WebhookSink `yaml:"WEBHOOK" env-prefix:"WEBHOOK_"`
+	File    FileSink    `yaml:"FILE" env-prefix:"FILE_"`
+	Jobs    []SinkJob   `yaml:"JOBS"` // Периодические задания сбора маршрутов (только из YAML)
+}
+
+// NATSSink содержит параметры публикации событий в NATS.
+type NATSSink struct {
+	URL           string `yaml:"URL" env:"URL"` // Например, nats://localhost:4222
+	SubjectPrefix string `yaml:"SUBJECT_PREFIX" env:"SUBJECT_PREFIX" env-default:"rzd"`
+}
+
+// KafkaSink содержит параметры публикации событий в Kafka.
+type KafkaSink struct {
+	Brokers     string `yaml:"BROKERS" env:"BROKERS"` // Через запятую, например kafka-1:9092,kafka-2:9092
+	TopicPrefix string `yaml:"TOPIC_PREFIX" env:"TOPIC_PREFIX" env-default:"rzd"`
+}
+
+// WebhookSink содержит параметры отправки событий на HTTP-вебхук.
+type WebhookSink struct {
+	URL        string        `yaml:"URL" env:"URL"`
+	Secret     string        `yaml:"SECRET" env:"SECRET"` // Секрет HMAC-подписи
+	MaxRetries int           `yaml:"MAX_RETRIES" env:"MAX_RETRIES" env-default:"5"`
+	Backoff    time.Duration `yaml:"BACKOFF" env:"BACKOFF" env-default:"500ms"`
+	Timeout    time.Duration `yaml:"TIMEOUT" env:"TIMEOUT" env-default:"10s"`
+}
+
+// FileSink содержит параметры записи событий в NDJSON файл.
+type FileSink struct {
+	Path string `yaml:"PATH" env:"PATH"`
+}
+
+// SinkJob описывает периодический сбор маршрутов между двумя станциями.
+type SinkJob struct {
+	FromCode  int           `yaml:"FROM_CODE"`
+	ToCode    int           `yaml:"TO_CODE"`
+	TrainType int           `yaml:"TRAIN_TYPE"` // 1 – AllTrains, 2 – Trains, 3 – Electrics
+	Days      int           `yaml:"DAYS"`       // Сколько дней, начиная с сегодняшнего, опрашивать
+	Interval  time.Duration `yaml:"INTERVAL"`   // Период запуска, например 30m
 }
 
-// LoadConfig загружает конфигурацию из файла (если передан путь) или из переменных окружения.
-// При наличии файла, его значения будут приоритетными.
+// LoadConfig загружает конфигурацию: значения по умолчанию, затем файл (если передан путь),
+// затем переменные окружения. Результат проверяется Validate.
 func LoadConfig(configPath string) (*Config, error) {
-	cfg := &Config{}
-	if configPath != "" {
-		// Если указан файл, считываем конфигурацию из него.
-		if err := cleanenv.ReadConfig(configPath, cfg); err != nil {
-			return nil, fmt.Errorf("failed to load configuration from file: %v", err)
-		}
-	} else {
-		// Иначе считываем из переменных окружения.
-		if err := cleanenv.ReadEnv(cfg); err != nil {
-			return nil, fmt.Errorf("failed to load configuration from environment: %v", err)
-		}
-	}
-	return cfg, nil
+	return Load(Options{Path: configPath})
 }
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadLayers(t *testing.T) {
+	path := writeConfig(t, `
+RZD:
+  TIMEOUT: 10s
+  MAX_RETRIES: 3
+GRPC:
+  PORT: "6000"
+  RATE_LIMIT:
+    RPS: 1
+`)
+
+	// Файл переопределяет значения по умолчанию
+	cfg, err := Load(Options{Path: path})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, cfg.RZD.Timeout)
+	require.Equal(t, 2*time.Second, cfg.RZD.RetryDelay)
+	require.Equal(t, 5*time.Minute, cfg.RZD.RIDLifetime)
+	require.Equal(t, "6000", cfg.GRPC.Port)
+
+	// Окружение переопределяет файл, флаги - окружение
+	t.Setenv("RZD_MAX_RETRIES", "5")
+	t.Setenv("GRPC_RATE_LIMIT_RPS", "2")
+	cfg, err = Load(Options{Path: path, Overrides: []string{"GRPC.RATE_LIMIT.RPS=7.5", "rzd.retry_delay=250ms"}})
+	require.NoError(t, err)
+	require.Equal(t, 5, cfg.RZD.MaxRetries)
+	require.Equal(t, 7.5, cfg.GRPC.RateLimit.RPS)
+	require.Equal(t, 250*time.Millisecond, cfg.RZD.RetryDelay)
+
+	// Все переменные секции GRPC начинаются с GRPC_
+	t.Setenv("GRPC_PORT", "7000")
+	t.Setenv("GRPC_REFLECTION", "true")
+	t.Setenv("GRPC_LOG_REQUESTS", "true")
+	t.Setenv("GRPC_TLS_CERT_FILE", "/etc/rzd/tls.crt")
+	t.Setenv("GRPC_TLS_KEY_FILE", "/etc/rzd/tls.key")
+	t.Setenv("GRPC_AUTH_MODE", "apikey")
+	t.Setenv("GRPC_AUTH_API_KEYS", "key-1,key-2")
+	t.Setenv("GRPC_HEALTH_INTERVAL", "1m")
+	cfg, err = Load(Options{Path: path})
+	require.NoError(t, err)
+	require.Equal(t, "7000", cfg.GRPC.Port)
+	require.True(t, cfg.GRPC.Reflection)
+	require.True(t, cfg.GRPC.LogRequests)
+	require.Equal(t, "/etc/rzd/tls.crt", cfg.GRPC.TLS.CertFile)
+	require.Equal(t, []string{"key-1", "key-2"}, cfg.GRPC.Auth.APIKeys)
+	require.Equal(t, time.Minute, cfg.GRPC.Health.Interval)
+
+	// Переменные секции RZD начинаются с RZD_: общие переменные вроде LANGUAGE (локаль GNU) не читаются
+	t.Setenv("LANGUAGE", "en_US:en")
+	t.Setenv("TIMEOUT", "5")
+	t.Setenv("RZD_LANGUAGE", "en")
+	t.Setenv("RZD_RID_LIFETIME", "10m")
+	t.Setenv("RZD_BREAKER_FAILURE_THRESHOLD", "9")
+	cfg, err = Load(Options{Path: path})
+	require.NoError(t, err)
+	require.Equal(t, "en", cfg.RZD.Language)
+	require.Equal(t, 10*time.Second, cfg.RZD.Timeout)
+	require.Equal(t, 10*time.Minute, cfg.RZD.RIDLifetime)
+	require.Equal(t, 9, cfg.RZD.Breaker.FailureThreshold)
+
+	_, err = Load(Options{Path: path, Overrides: []string{"RZD.UNKNOWN=1"}})
+	require.ErrorContains(t, err, `unknown key "UNKNOWN"`)
+	_, err = Load(Options{Path: path, Overrides: []string{"RZD.TIMEOUT"}})
+	require.ErrorContains(t, err, "must have form")
+}
+
+func TestLoadValidation(t *testing.T) {
+	path := writeConfig(t, `
+RZD:
+  LANGUAGE: de
+  TIMEOUT: -5s
+  PROVIDER: grpc
+  DECODE_MODE: loose
+GRPC:
+  PORT: "70000"
+  TLS:
+    CERT_FILE: server.crt
+TRACING:
+  ENDPOINT: collector
+  SAMPLE_RATIO: 2
+STATE:
+  ADDR: localhost:6379
+`)
+
+	_, err := Load(Options{Path: path})
+	require.Error(t, err)
+	for _, message := range []string{
+		`RZD.LANGUAGE: unsupported language "de"`,
+		"RZD.TIMEOUT: must be positive",
+		`RZD.PROVIDER: unknown provider "grpc"`,
+		`RZD.DECODE_MODE: unknown mode "loose"`,
+		`GRPC.PORT: invalid port "70000"`,
+		"GRPC.TLS: both CERT_FILE and KEY_FILE are required",
+		"TRACING.ENDPOINT: address collector: missing port in address",
+		"TRACING.SAMPLE_RATIO: must be between 0 and 1",
+		`STATE.ADDR: "localhost:6379" is not a redis:// URL`,
+	} {
+		require.ErrorContains(t, err, message)
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	path := writeConfig(t, "RZD:\n  TIMEOUT: 10s\n")
+	opts := Options{Path: path}
+	initial, err := Load(opts)
+	require.NoError(t, err)
+
+	watcher := NewWatcher(opts, initial)
+	var reloaded *Config
+	watcher.OnReload(func(cfg *Config) { reloaded = cfg })
+
+	require.NoError(t, os.WriteFile(path, []byte("RZD:\n  TIMEOUT: 20s\n"), 0o600))
+	require.NoError(t, watcher.Reload())
+	require.NotNil(t, reloaded)
+	require.Equal(t, 20*time.Second, reloaded.RZD.Timeout)
+	require.Same(t, reloaded, watcher.Current())
+
+	// Некорректная конфигурация отклоняется, действующая сохраняется
+	require.NoError(t, os.WriteFile(path, []byte("RZD:\n  TIMEOUT: -1s\n"), 0o600))
+	require.Error(t, watcher.Reload())
+	require.Same(t, reloaded, watcher.Current())
+}
@@ -0,0 +1,126 @@
+// pkg/config/load.go
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// Options источники конфигурации. Приоритет по возрастанию:
+// значения по умолчанию, файл Path, переменные окружения, Overrides.
+type Options struct {
+	Path      string   // Путь к YAML файлу; пустой - только окружение
+	Overrides []string // Значения из флагов командной строки в виде "SECTION.KEY=value", например "RZD.TIMEOUT=10s"
+}
+
+// Load загружает конфигурацию по слоям и проверяет её
+func Load(opts Options) (*Config, error) {
+	cfg := &Config{}
+	if opts.Path != "" {
+		// cleanenv читает файл, затем переменные окружения; значения по умолчанию
+		// подставляются только в поля, не заданные ни файлом, ни окружением
+		if err := cleanenv.ReadConfig(opts.Path, cfg); err != nil {
+			return nil, fmt.Errorf("failed to load configuration from file: %v", err)
+		}
+	} else {
+		if err := cleanenv.ReadEnv(cfg); err != nil {
+			return nil, fmt.Errorf("failed to load configuration from environment: %v", err)
+		}
+	}
+	for _, override := range opts.Overrides {
+		if err := applyOverride(cfg, override); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// applyOverride устанавливает поле по пути из YAML-ключей, например "GRPC.RATE_LIMIT.RPS=5"
+func applyOverride(cfg *Config, override string) error {
+	path, value, ok := strings.Cut(override, "=")
+	if !ok {
+		return fmt.Errorf("override %q must have form SECTION.KEY=value", override)
+	}
+	field := reflect.ValueOf(cfg).Elem()
+	for _, key := range strings.Split(strings.TrimSpace(path), ".") {
+		next, found := fieldByYAMLKey(field, key)
+		if !found {
+			return fmt.Errorf("override %q: unknown key %q", override, key)
+		}
+		field = next
+	}
+	if err := setValue(field, value); err != nil {
+		return fmt.Errorf("override %q: %w", override, err)
+	}
+	return nil
+}
+
+func fieldByYAMLKey(v reflect.Value, key string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Tag.Get("yaml"), key) {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setValue(field reflect.Value, value string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported list type %s", field.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
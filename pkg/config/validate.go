@@ -0,0 +1,165 @@
+// pkg/config/validate.go
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки сразу
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	// RZD
+	switch c.RZD.Language {
+	case "ru", "en":
+	default:
+		add("RZD.LANGUAGE: unsupported language %q", c.RZD.Language)
+	}
+	if c.RZD.Timeout <= 0 {
+		add("RZD.TIMEOUT: must be positive")
+	}
+	if c.RZD.RetryDelay < 0 {
+		add("RZD.RETRY_DELAY: must not be negative")
+	}
+	if c.RZD.MaxRetries < 1 {
+		add("RZD.MAX_RETRIES: must be at least 1")
+	}
+	if c.RZD.RIDLifetime <= 0 {
+		add("RZD.RID_LIFETIME: must be positive")
+	}
+	if err := validateURL(c.RZD.BasePath); err != nil {
+		add("RZD.BASE_PATH: %v", err)
+	}
+	if c.RZD.Proxy != "" {
+		if err := validateURL(c.RZD.Proxy); err != nil {
+			add("RZD.PROXY: %v", err)
+		}
+	}
+	if c.RZD.Breaker.FailureThreshold < 1 {
+		add("RZD.BREAKER.FAILURE_THRESHOLD: must be at least 1")
+	}
+	if c.RZD.Breaker.OpenTimeout <= 0 {
+		add("RZD.BREAKER.OPEN_TIMEOUT: must be positive")
+	}
+	if c.RZD.Budget.RPS < 0 {
+		add("RZD.BUDGET.RPS: must not be negative")
+	}
+	if c.RZD.Budget.Burst < 0 {
+		add("RZD.BUDGET.BURST: must not be negative")
+	}
+	switch c.RZD.DecodeMode {
+	case "", DecodeLenient, DecodeStrict:
+	default:
+		add("RZD.DECODE_MODE: unknown mode %q", c.RZD.DecodeMode)
+	}
+	switch c.RZD.Provider {
+	case "", ProviderLegacy:
+	case ProviderJSON, ProviderFallback:
+		if err := validateURL(c.RZD.JSONBasePath); err != nil {
+			add("RZD.JSON_BASE_PATH: %v", err)
+		}
+	default:
+		add("RZD.PROVIDER: unknown provider %q", c.RZD.Provider)
+	}
+
+	// GRPC
+	if port, err := strconv.Atoi(c.GRPC.Port); err != nil || port < 1 || port > 65535 {
+		add("GRPC.PORT: invalid port %q", c.GRPC.Port)
+	}
+	if (c.GRPC.TLS.CertFile == "") != (c.GRPC.TLS.KeyFile == "") {
+		add("GRPC.TLS: both CERT_FILE and KEY_FILE are required")
+	}
+	if c.GRPC.TLS.ClientCAFile != "" && c.GRPC.TLS.CertFile == "" {
+		add("GRPC.TLS.CLIENT_CA_FILE: requires server certificate")
+	}
+	switch strings.ToLower(c.GRPC.Auth.Mode) {
+	case "":
+	case "apikey":
+		if len(c.GRPC.Auth.APIKeys) == 0 {
+			add("GRPC.AUTH.API_KEYS: required for apikey mode")
+		}
+	case "jwt":
+		if c.GRPC.Auth.JWTSecret == "" {
+			add("GRPC.AUTH.JWT_SECRET: required for jwt mode")
+		}
+	default:
+		add("GRPC.AUTH.MODE: unknown mode %q", c.GRPC.Auth.Mode)
+	}
+	if c.GRPC.RateLimit.RPS < 0 {
+		add("GRPC.RATE_LIMIT.RPS: must not be negative")
+	}
+	if c.GRPC.RateLimit.Burst < 0 {
+		add("GRPC.RATE_LIMIT.BURST: must not be negative")
+	}
+	if c.GRPC.Health.Interval <= 0 {
+		add("GRPC.HEALTH.INTERVAL: must be positive")
+	}
+	if c.GRPC.Health.Timeout <= 0 {
+		add("GRPC.HEALTH.TIMEOUT: must be positive")
+	}
+
+	// SINKS
+	if c.Sinks.Buffer < 1 {
+		add("SINKS.BUFFER: must be at least 1")
+	}
+	for i, job := range c.Sinks.Jobs {
+		if job.FromCode <= 0 || job.ToCode <= 0 {
+			add("SINKS.JOBS[%d]: station codes are required", i)
+		}
+		if job.Interval <= 0 {
+			add("SINKS.JOBS[%d].INTERVAL: must be positive", i)
+		}
+	}
+
+	// METRICS
+	if c.Metrics.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.Addr); err != nil {
+			add("METRICS.ADDR: %v", err)
+		}
+	}
+
+	// TRACING
+	if c.Tracing.Endpoint != "" {
+		if _, _, err := net.SplitHostPort(c.Tracing.Endpoint); err != nil {
+			add("TRACING.ENDPOINT: %v", err)
+		}
+		if c.Tracing.Timeout <= 0 {
+			add("TRACING.TIMEOUT: must be positive")
+		}
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		add("TRACING.SAMPLE_RATIO: must be between 0 and 1")
+	}
+
+	// STATE
+	if c.State.Addr != "" {
+		if u, err := url.Parse(c.State.Addr); err != nil {
+			add("STATE.ADDR: %v", err)
+		} else if u.Scheme != "redis" || u.Host == "" {
+			add("STATE.ADDR: %q is not a redis:// URL", c.State.Addr)
+		}
+		if c.State.Timeout <= 0 {
+			add("STATE.TIMEOUT: must be positive")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("%q is not an absolute URL", raw)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// pkg/config/watcher.go
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Watcher перечитывает конфигурацию по SIGHUP и при изменении файла и передаёт новую
+// версию подписчикам. Некорректная конфигурация отклоняется, текущая остаётся в силе.
+//
+// На лету применяются лимиты частоты вызовов, прокси, таймауты и повторы, логирование,
+// время жизни RID и параметры предохранителей. Остальные параметры (порт, TLS, аутентификация, источник данных,
+// экспорт) требуют перезапуска: при их изменении в лог пишется предупреждение.
+type Watcher struct {
+	opts     Options
+	mutex    sync.Mutex
+	current  *Config
+	handlers []func(*Config)
+}
+
+// NewWatcher создаёт Watcher для уже загруженной конфигурации
+func NewWatcher(opts Options, initial *Config) *Watcher {
+	return &Watcher{opts: opts, current: initial}
+}
+
+// Current возвращает действующую конфигурацию
+func (w *Watcher) Current() *Config {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.current
+}
+
+// OnReload регистрирует обработчик, вызываемый после успешной перезагрузки
+func (w *Watcher) OnReload(handler func(*Config)) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.handlers = append(w.handlers, handler)
+}
+
+// Reload перечитывает конфигурацию из всех источников и уведомляет подписчиков
+func (w *Watcher) Reload() error {
+	cfg, err := Load(w.opts)
+	if err != nil {
+		return err
+	}
+
+	w.mutex.Lock()
+	previous := w.current
+	w.current = cfg
+	handlers := append([]func(*Config){}, w.handlers...)
+	w.mutex.Unlock()
+
+	for _, section := range restartRequired(previous, cfg) {
+		log.Printf("Configuration %s changed, restart is required to apply it", section)
+	}
+	for _, handler := range handlers {
+		handler(cfg)
+	}
+	log.Println("Configuration reloaded")
+	return nil
+}
+
+// Run обрабатывает SIGHUP и, если pollInterval > 0 и задан файл, проверяет время его
+// изменения с этим периодом. Блокируется до отмены контекста.
+func (w *Watcher) Run(ctx context.Context, pollInterval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var poll <-chan time.Time
+	var lastModified time.Time
+	if pollInterval > 0 && w.opts.Path != "" {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+		lastModified = modTime(w.opts.Path)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Println("SIGHUP received, reloading configuration")
+		case <-poll:
+			modified := modTime(w.opts.Path)
+			if modified.Equal(lastModified) {
+				continue
+			}
+			lastModified = modified
+			log.Printf("Configuration file %s changed, reloading", w.opts.Path)
+		}
+		if err := w.Reload(); err != nil {
+			log.Printf("Configuration reload rejected: %v", err)
+		}
+	}
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// restartRequired возвращает разделы, изменения которых нельзя применить без перезапуска
+func restartRequired(previous, next *Config) []string {
+	if previous == nil {
+		return nil
+	}
+	checks := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+	}{
+		{"GRPC.PORT", previous.GRPC.Port, next.GRPC.Port},
+		{"GRPC.TLS", previous.GRPC.TLS, next.GRPC.TLS},
+		{"GRPC.AUTH", previous.GRPC.Auth, next.GRPC.Auth},
+		{"GRPC.REFLECTION", previous.GRPC.Reflection, next.GRPC.Reflection},
+		{"GRPC.HEALTH", previous.GRPC.Health, next.GRPC.Health},
+		{"RZD.LANGUAGE", previous.RZD.Language, next.RZD.Language},
+		{"RZD.BASE_PATH", previous.RZD.BasePath, next.RZD.BasePath},
+		{"RZD.PROVIDER", previous.RZD.Provider, next.RZD.Provider},
+		{"RZD.JSON_BASE_PATH", previous.RZD.JSONBasePath, next.RZD.JSONBasePath},
+		{"RZD.TIMEZONES_FILE", previous.RZD.TimeZonesFile, next.RZD.TimeZonesFile},
+		{"RZD.GEODATA_FILE", previous.RZD.GeoDataFile, next.RZD.GeoDataFile},
+		{"RZD.STATION_CODES_FILE", previous.RZD.StationCodesFile, next.RZD.StationCodesFile},
+		{"SINKS", previous.Sinks, next.Sinks},
+		{"METRICS", previous.Metrics, next.Metrics},
+		{"TRACING", previous.Tracing, next.Tracing},
+		{"STATE", previous.State, next.State},
+	}
+	var changed []string
+	for _, check := range checks {
+		if !reflect.DeepEqual(check.before, check.after) {
+			changed = append(changed, check.name)
+		}
+	}
+	return changed
+}